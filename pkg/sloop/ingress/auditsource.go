@@ -0,0 +1,356 @@
+/*
+ * Copyright (c) 2021, salesforce.com, inc.
+ * All rights reserved.
+ * SPDX-License-Identifier: BSD-3-Clause
+ * For full license text, see LICENSE.txt file in the repo root or https://opensource.org/licenses/BSD-3-Clause
+ */
+
+package ingress
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/salesforce/sloop/pkg/sloop/common"
+	"github.com/salesforce/sloop/pkg/sloop/store/typed"
+)
+
+const defaultAuditPollInterval = time.Second
+
+var metricIngressAuditEvents = promauto.NewCounterVec(prometheus.CounterOpts{Name: "sloop_ingress_audit_events"}, []string{"result"})
+
+// The parts of an audit.k8s.io/v1 Event we need.  The apiserver module is not a dependency, so it is not imported
+type auditEvent struct {
+	Stage     string `json:"stage"`
+	Verb      string `json:"verb"`
+	ObjectRef *struct {
+		Subresource string `json:"subresource"`
+	} `json:"objectRef"`
+	ResponseStatus *struct {
+		Code int `json:"code"`
+	} `json:"responseStatus"`
+	ResponseObject json.RawMessage `json:"responseObject"`
+	StageTimestamp time.Time       `json:"stageTimestamp"`
+}
+
+// What the webhook backend of the apiserver posts
+type auditEventList struct {
+	Items []auditEvent `json:"items"`
+}
+
+// Turns an audit event into the watch result it stands for.  Only completed writes with the object in the response
+// qualify, which needs the RequestResponse audit level.  Returns why the event was skipped when there is no record
+func auditEventToWatchResult(event *auditEvent) (*typed.KubeWatchResult, string) {
+	if event.Stage != "ResponseComplete" {
+		return nil, "stage"
+	}
+	var watchType typed.KubeWatchResult_WatchType
+	switch event.Verb {
+	case "create":
+		watchType = typed.KubeWatchResult_ADD
+	case "update", "patch":
+		watchType = typed.KubeWatchResult_UPDATE
+	case "delete":
+		watchType = typed.KubeWatchResult_DELETE
+	default:
+		return nil, "verb"
+	}
+	// Other subresources like scale or binding respond with a different kind
+	if event.ObjectRef != nil && event.ObjectRef.Subresource != "" && event.ObjectRef.Subresource != "status" {
+		return nil, "subresource"
+	}
+	if event.ResponseStatus != nil && event.ResponseStatus.Code >= 300 {
+		return nil, "failed"
+	}
+	if len(event.ResponseObject) == 0 {
+		return nil, "noobject"
+	}
+
+	var object struct {
+		Kind     string `json:"kind"`
+		Metadata struct {
+			DeletionTimestamp *string  `json:"deletionTimestamp"`
+			Finalizers        []string `json:"finalizers"`
+		} `json:"metadata"`
+	}
+	err := json.Unmarshal(event.ResponseObject, &object)
+	if err != nil || object.Kind == "" {
+		return nil, "noobject"
+	}
+	// Deleting a collection or a subresource only returns a Status
+	if object.Kind == "Status" {
+		return nil, "noobject"
+	}
+	// A delete held up by finalizers only sets the deletion timestamp, and the watch would have seen an update
+	if watchType == typed.KubeWatchResult_DELETE && object.Metadata.DeletionTimestamp != nil && len(object.Metadata.Finalizers) > 0 {
+		watchType = typed.KubeWatchResult_UPDATE
+	}
+
+	ts, err := ptypes.TimestampProto(event.StageTimestamp)
+	if err != nil || event.StageTimestamp.IsZero() {
+		return nil, "timestamp"
+	}
+	var payload bytes.Buffer
+	err = json.Compact(&payload, event.ResponseObject)
+	if err != nil {
+		return nil, "noobject"
+	}
+	return &typed.KubeWatchResult{Timestamp: ts, Kind: object.Kind, WatchType: watchType, Payload: payload.String()}, ""
+}
+
+// Converts every event that stands for a watch result, and counts the rest
+func auditEventsToWatchResults(events []auditEvent) []typed.KubeWatchResult {
+	var recs []typed.KubeWatchResult
+	for idx := range events {
+		rec, skipped := auditEventToWatchResult(&events[idx])
+		if rec == nil {
+			metricIngressAuditEvents.WithLabelValues(skipped).Inc()
+			continue
+		}
+		metricIngressAuditEvents.WithLabelValues("converted").Inc()
+		recs = append(recs, *rec)
+	}
+	return recs
+}
+
+type AuditSourceConfig struct {
+	Path         string        // A JSON lines audit log file, a directory of them or a glob.  Read in name order
+	Follow       bool          // Keep reading as files grow and new ones show up, like tail -F.  Otherwise stop at the end
+	PollInterval time.Duration // How often to look for more when following.  0 = 1s
+}
+
+// AuditSource reads kubernetes audit logs and writes a watch result for every write to an object they record.  Files
+// are read from the start, which rebuilds history from before sloop was running.  When following, files are tracked
+// by identity, so a log renamed by rotation is not read again.
+type AuditSource struct {
+	config  AuditSourceConfig
+	outChan chan typed.KubeWatchResult
+	stop    chan struct{}
+	wg      *sync.WaitGroup
+	// Keyed by file name.  Rotation can move a file to a new name, so lookups also check os.SameFile
+	files map[string]*auditFile
+	// Set after the first pass.  Logs compressed from then on are ones we read before they were rotated
+	followed bool
+}
+
+type auditFile struct {
+	info   os.FileInfo
+	offset int64
+}
+
+var _ KubeResourceSource = &AuditSource{}
+
+func NewAuditSource(config AuditSourceConfig, outChan chan typed.KubeWatchResult) *AuditSource {
+	if config.PollInterval <= 0 {
+		config.PollInterval = defaultAuditPollInterval
+	}
+	return &AuditSource{config: config, outChan: outChan, stop: make(chan struct{}), wg: &sync.WaitGroup{}, files: map[string]*auditFile{}}
+}
+
+// Init starts reading in the background and returns the channel it writes to.  Without Follow the files have to
+// exist up front
+func (a *AuditSource) Init() (chan typed.KubeWatchResult, error) {
+	if !a.config.Follow {
+		_, err := getPlaybackFiles(a.config.Path)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to find audit logs")
+		}
+	}
+	a.wg.Add(1)
+	go a.run()
+	return a.outChan, nil
+}
+
+// Stop returns once nothing more will be written to outChan
+func (a *AuditSource) Stop() {
+	close(a.stop)
+	a.wg.Wait()
+}
+
+func (a *AuditSource) run() {
+	defer a.wg.Done()
+	for {
+		count, err := a.readAll()
+		if err == errPlaybackStopped {
+			return
+		}
+		if err != nil && a.config.Follow {
+			// The logs may just not be there yet
+			glog.V(common.GlogVerbose).Infof("Failed to read audit logs from %v: %v", a.config.Path, err)
+		} else if err != nil {
+			glog.Errorf("Failed to read audit logs from %v: %v", a.config.Path, err)
+		}
+		if count > 0 {
+			glog.V(common.GlogVerbose).Infof("Read %v watch results from audit logs in %v", count, a.config.Path)
+		}
+		if !a.config.Follow {
+			glog.Infof("Done reading audit logs from %v", a.config.Path)
+			return
+		}
+		select {
+		case <-a.stop:
+			return
+		case <-time.After(a.config.PollInterval):
+		}
+	}
+}
+
+// Reads whatever was added to each file since the last pass
+func (a *AuditSource) readAll() (int, error) {
+	filenames, err := getPlaybackFiles(a.config.Path)
+	if err != nil {
+		return 0, err
+	}
+	total := 0
+	seen := map[string]*auditFile{}
+	for _, filename := range filenames {
+		info, err := os.Stat(filename)
+		if err != nil {
+			// Rotated away between listing and now.  We will find it under its new name next time, so the offset has
+			// to be kept until then
+			if file, ok := a.files[filename]; ok {
+				seen[filename] = file
+			}
+			continue
+		}
+		file := a.findFile(filename, info)
+		if strings.HasSuffix(filename, gzipExtension) && a.followed && file.offset == 0 {
+			// Compressed after we started, so it holds a rotated log we already read
+			file.offset = info.Size()
+		}
+		if info.Size() < file.offset {
+			// Truncated in place, so everything in it is new
+			file.offset = 0
+		}
+		file.info = info
+		seen[filename] = file
+		count, err := a.readFile(filename, file)
+		total += count
+		if err == errPlaybackStopped {
+			return total, err
+		}
+		if err != nil {
+			glog.Errorf("Skipping the rest of %v for now: %v", filename, err)
+		}
+	}
+	a.files = seen
+	a.followed = true
+	return total, nil
+}
+
+func (a *AuditSource) findFile(filename string, info os.FileInfo) *auditFile {
+	if file, ok := a.files[filename]; ok && os.SameFile(file.info, info) {
+		return file
+	}
+	for _, file := range a.files {
+		if os.SameFile(file.info, info) {
+			return file
+		}
+	}
+	return &auditFile{info: info}
+}
+
+// Reads complete lines from the offset on.  A line still being written is left for the next pass
+func (a *AuditSource) readFile(filename string, file *auditFile) (int, error) {
+	if strings.HasSuffix(filename, gzipExtension) {
+		return a.readCompressedFile(filename, file)
+	}
+	f, err := os.Open(filename)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to open audit log %v", filename)
+	}
+	defer f.Close()
+	_, err = f.Seek(file.offset, io.SeekStart)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to seek in audit log %v", filename)
+	}
+
+	count := 0
+	reader := bufio.NewReaderSize(f, 64*1024)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err == io.EOF {
+			return count, nil
+		}
+		if err != nil {
+			return count, errors.Wrapf(err, "failed to read audit log %v", filename)
+		}
+		file.offset += int64(len(line))
+
+		sent, err := a.sendLine(line)
+		count += sent
+		if err != nil {
+			return count, err
+		}
+	}
+}
+
+// The apiserver only compresses a log once it is rotated away, so it is read whole, once.  The offset is the size of
+// the compressed file once it has been read
+func (a *AuditSource) readCompressedFile(filename string, file *auditFile) (int, error) {
+	if file.offset > 0 {
+		return 0, nil
+	}
+	f, err := os.Open(filename)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to open audit log %v", filename)
+	}
+	defer f.Close()
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to open gzip stream in audit log %v", filename)
+	}
+
+	count := 0
+	reader := bufio.NewReaderSize(gz, 64*1024)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return count, errors.Wrapf(err, "failed to read audit log %v", filename)
+		}
+		sent, sendErr := a.sendLine(line)
+		count += sent
+		if sendErr != nil {
+			return count, sendErr
+		}
+		if err == io.EOF {
+			file.offset = file.info.Size()
+			return count, nil
+		}
+	}
+}
+
+func (a *AuditSource) sendLine(line []byte) (int, error) {
+	line = bytes.TrimSpace(line)
+	if len(line) == 0 {
+		return 0, nil
+	}
+	var event auditEvent
+	err := json.Unmarshal(line, &event)
+	if err != nil {
+		metricIngressAuditEvents.WithLabelValues("invalid").Inc()
+		return 0, nil
+	}
+	count := 0
+	for _, rec := range auditEventsToWatchResults([]auditEvent{event}) {
+		select {
+		case a.outChan <- rec:
+			count++
+		case <-a.stop:
+			return count, errPlaybackStopped
+		}
+	}
+	return count, nil
+}
@@ -0,0 +1,193 @@
+/*
+ * Copyright (c) 2021, salesforce.com, inc.
+ * All rights reserved.
+ * SPDX-License-Identifier: BSD-3-Clause
+ * For full license text, see LICENSE.txt file in the repo root or https://opensource.org/licenses/BSD-3-Clause
+ */
+
+package ingress
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/salesforce/sloop/pkg/sloop/store/typed"
+	"github.com/stretchr/testify/assert"
+)
+
+const someAuditTimestamp = "2021-03-04T05:06:07.123456Z"
+
+func helper_auditLine(stage string, verb string, subresource string, code int, object string) string {
+	line := fmt.Sprintf(`{"kind":"Event","apiVersion":"audit.k8s.io/v1","stage":%q,"verb":%q,"objectRef":{"resource":"pods","subresource":%q},"responseStatus":{"code":%v},"stageTimestamp":%q`,
+		stage, verb, subresource, code, someAuditTimestamp)
+	if object != "" {
+		line += `,"responseObject":` + object
+	}
+	return line + "}"
+}
+
+func helper_podObject(name string, extraMetadata string) string {
+	return fmt.Sprintf(`{"kind":"Pod","apiVersion":"v1","metadata":{"name":%q,"namespace":"ns"%v}}`, name, extraMetadata)
+}
+
+func Test_auditEventToWatchResult(t *testing.T) {
+	finalizing := `,"deletionTimestamp":"2021-03-04T05:06:07Z","finalizers":["foo"]`
+	tests := []struct {
+		line      string
+		watchType typed.KubeWatchResult_WatchType
+		skipped   string
+	}{
+		{helper_auditLine("ResponseComplete", "create", "", 201, helper_podObject("p", "")), typed.KubeWatchResult_ADD, ""},
+		{helper_auditLine("ResponseComplete", "patch", "status", 200, helper_podObject("p", "")), typed.KubeWatchResult_UPDATE, ""},
+		{helper_auditLine("ResponseComplete", "delete", "", 200, helper_podObject("p", "")), typed.KubeWatchResult_DELETE, ""},
+		{helper_auditLine("ResponseComplete", "delete", "", 200, helper_podObject("p", finalizing)), typed.KubeWatchResult_UPDATE, ""},
+		{helper_auditLine("RequestReceived", "create", "", 201, helper_podObject("p", "")), 0, "stage"},
+		{helper_auditLine("ResponseComplete", "get", "", 200, helper_podObject("p", "")), 0, "verb"},
+		{helper_auditLine("ResponseComplete", "update", "scale", 200, `{"kind":"Scale"}`), 0, "subresource"},
+		{helper_auditLine("ResponseComplete", "create", "", 409, `{"kind":"Status"}`), 0, "failed"},
+		{helper_auditLine("ResponseComplete", "deletecollection", "", 200, `{"kind":"Status"}`), 0, "verb"},
+		{helper_auditLine("ResponseComplete", "delete", "", 200, `{"kind":"Status"}`), 0, "noobject"},
+		{helper_auditLine("ResponseComplete", "update", "", 200, ""), 0, "noobject"},
+	}
+	expectedTs, _ := time.Parse(time.RFC3339Nano, someAuditTimestamp)
+	for _, test := range tests {
+		var event auditEvent
+		assert.Nil(t, json.Unmarshal([]byte(test.line), &event))
+		rec, skipped := auditEventToWatchResult(&event)
+		assert.Equal(t, test.skipped, skipped, test.line)
+		if test.skipped != "" {
+			assert.Nil(t, rec)
+			continue
+		}
+		assert.Equal(t, "Pod", rec.Kind)
+		assert.Equal(t, test.watchType, rec.WatchType, test.line)
+		ts, _ := ptypes.Timestamp(rec.Timestamp)
+		assert.Equal(t, expectedTs, ts)
+		assert.Contains(t, rec.Payload, `"name":"p"`)
+	}
+}
+
+func helper_appendFile(t *testing.T, filename string, content string) {
+	f, err := os.OpenFile(filename, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	assert.Nil(t, err)
+	_, err = f.WriteString(content)
+	assert.Nil(t, err)
+	assert.Nil(t, f.Close())
+}
+
+func helper_gzipFile(t *testing.T, filename string, content string) {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	_, err := gz.Write([]byte(content))
+	assert.Nil(t, err)
+	assert.Nil(t, gz.Close())
+	assert.Nil(t, ioutil.WriteFile(filename, buf.Bytes(), 0644))
+}
+
+// Reads records until none arrive for a while
+func helper_collect(outChan chan typed.KubeWatchResult, quiet time.Duration) []string {
+	var payloads []string
+	for {
+		select {
+		case rec := <-outChan:
+			payloads = append(payloads, rec.Payload)
+		case <-time.After(quiet):
+			return payloads
+		}
+	}
+}
+
+func Test_AuditSource_ReadsFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "auditsource")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	helper_appendFile(t, filepath.Join(dir, "audit-1.log"), helper_auditLine("ResponseComplete", "create", "", 201, helper_podObject("a", ""))+"\n"+
+		helper_auditLine("ResponseComplete", "get", "", 200, helper_podObject("a", ""))+"\nnot json\n")
+	helper_appendFile(t, filepath.Join(dir, "audit-2.log"), helper_auditLine("ResponseComplete", "update", "", 200, helper_podObject("b", ""))+"\n")
+	// Rotated with --audit-log-compress
+	helper_gzipFile(t, filepath.Join(dir, "audit-3.log.gz"), helper_auditLine("ResponseComplete", "delete", "", 200, helper_podObject("c", ""))+"\n")
+
+	outChan := make(chan typed.KubeWatchResult, 10)
+	source := NewAuditSource(AuditSourceConfig{Path: dir}, outChan)
+	_, err = source.Init()
+	assert.Nil(t, err)
+	source.wg.Wait()
+	source.Stop()
+
+	assert.Len(t, outChan, 3)
+	assert.Contains(t, (<-outChan).Payload, `"name":"a"`)
+	assert.Contains(t, (<-outChan).Payload, `"name":"b"`)
+	assert.Contains(t, (<-outChan).Payload, `"name":"c"`)
+
+	_, err = NewAuditSource(AuditSourceConfig{Path: filepath.Join(dir, "missing")}, outChan).Init()
+	assert.NotNil(t, err)
+}
+
+func Test_AuditSource_FollowsRotation(t *testing.T) {
+	dir, err := ioutil.TempDir("", "auditsource")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	active := filepath.Join(dir, "audit.log")
+	created := func(name string) string {
+		return helper_auditLine("ResponseComplete", "create", "", 201, helper_podObject(name, ""))
+	}
+	helper_appendFile(t, active, created("a")+"\n")
+
+	outChan := make(chan typed.KubeWatchResult, 10)
+	source := NewAuditSource(AuditSourceConfig{Path: dir, Follow: true, PollInterval: 10 * time.Millisecond}, outChan)
+	_, err = source.Init()
+	assert.Nil(t, err)
+	defer source.Stop()
+	assert.Len(t, helper_collect(outChan, 200*time.Millisecond), 1)
+
+	// Half a line waits until the rest of it is written
+	line := created("b")
+	helper_appendFile(t, active, line[:10])
+	assert.Len(t, helper_collect(outChan, 100*time.Millisecond), 0)
+	helper_appendFile(t, active, line[10:]+"\n")
+	payloads := helper_collect(outChan, 200*time.Millisecond)
+	assert.Len(t, payloads, 1)
+
+	// The rotated file keeps its offset under the new name, and the new active file is read from the start
+	rotated := filepath.Join(dir, "audit-2021-03-04T05-06-07.log")
+	assert.Nil(t, os.Rename(active, rotated))
+	helper_appendFile(t, active, created("c")+"\n")
+	payloads = helper_collect(outChan, 200*time.Millisecond)
+	if assert.Len(t, payloads, 1) {
+		assert.Contains(t, payloads[0], `"name":"c"`)
+	}
+
+	// Compressing the rotated file does not read it again
+	content, err := ioutil.ReadFile(rotated)
+	assert.Nil(t, err)
+	helper_gzipFile(t, rotated+".gz", string(content))
+	assert.Nil(t, os.Remove(rotated))
+	assert.Len(t, helper_collect(outChan, 200*time.Millisecond), 0)
+}
+
+func Test_PushReceiver_ServeAudit(t *testing.T) {
+	receiver, outChan := helper_newPushReceiver(t, []PushSource{{Name: "apiserver", Token: "secret"}}, 10)
+	body := `{"kind":"EventList","apiVersion":"audit.k8s.io/v1","items":[` +
+		helper_auditLine("ResponseComplete", "create", "", 201, helper_podObject("a", "")) + "," +
+		helper_auditLine("RequestReceived", "create", "", 0, "") + `]}`
+
+	request := httptest.NewRequest(http.MethodPost, "/ctx1/audit", bytes.NewReader([]byte(body)))
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("Authorization", "Bearer secret")
+	response := httptest.NewRecorder()
+	receiver.ServeAudit(response, request)
+
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Contains(t, response.Body.String(), `"accepted":1`)
+	assert.Len(t, outChan, 1)
+}
@@ -1,26 +1,110 @@
 package ingress
 
 import (
+	"bufio"
+	"encoding/binary"
+	"io"
 	"os"
 	"runtime"
+	"strings"
 
+	"github.com/dgraph-io/badger/v2/pb"
+	"github.com/golang/protobuf/proto"
 	"github.com/pkg/errors"
 
+	"github.com/salesforce/sloop/pkg/sloop/store/typed"
 	"github.com/salesforce/sloop/pkg/sloop/store/untyped/badgerwrap"
 )
 
 // DatabaseRestore restores the DB from a backup file created by webserver.backupHandler
-func DatabaseRestore(db badgerwrap.DB, filename string) error {
+// When redactor is not nil the watch table payloads are redacted on the way in
+func DatabaseRestore(db badgerwrap.DB, filename string, redactor *Redactor) error {
 	file, err := os.Open(filename)
 	if err != nil {
 		return errors.Wrapf(err, "failed to load database restore file: %q", filename)
 	}
 	defer file.Close()
 
-	err = db.Load(file, runtime.NumCPU())
+	var reader io.Reader = file
+	if redactor != nil {
+		pipeReader, pipeWriter := io.Pipe()
+		defer pipeReader.Close()
+		go func() {
+			pipeWriter.CloseWithError(redactBackup(file, pipeWriter, redactor))
+		}()
+		reader = pipeReader
+	}
+
+	err = db.Load(reader, runtime.NumCPU())
 	if err != nil {
 		return errors.Wrapf(err, "failed to restore database from file: %q", filename)
 	}
 
 	return nil
 }
+
+// Copies a badger backup stream from r to w, redacting the payload of every watch table entry.  A backup is a
+// sequence of little endian uint64 lengths each followed by a protobuf KVList.  Payloads stored as a merge patch are
+// redacted the same way: the values in a patch are the new values at the same paths, and the nulls that delete keys
+// are left alone
+func redactBackup(r io.Reader, w io.Writer, redactor *Redactor) error {
+	br := bufio.NewReader(r)
+	watchPrefix := "/" + (&typed.WatchTableKey{}).TableName() + "/"
+	for {
+		var size uint64
+		err := binary.Read(br, binary.LittleEndian, &size)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		buf := make([]byte, size)
+		_, err = io.ReadFull(br, buf)
+		if err != nil {
+			return errors.Wrap(err, "truncated backup")
+		}
+		list := &pb.KVList{}
+		err = list.Unmarshal(buf)
+		if err != nil {
+			return errors.Wrap(err, "failed to parse backup")
+		}
+
+		for _, kv := range list.Kv {
+			// Deleted entries carry no value
+			if len(kv.Value) == 0 || !strings.HasPrefix(string(kv.Key), watchPrefix) {
+				continue
+			}
+			rec := &typed.KubeWatchResult{}
+			err = proto.Unmarshal(kv.Value, rec)
+			if err != nil {
+				return errors.Wrapf(err, "failed to parse watch entry %v", string(kv.Key))
+			}
+			before := rec.Payload
+			err = redactor.Redact(rec, "restore")
+			if err != nil {
+				return err
+			}
+			if rec.Payload == before {
+				continue
+			}
+			kv.Value, err = proto.Marshal(rec)
+			if err != nil {
+				return err
+			}
+		}
+
+		out, err := list.Marshal()
+		if err != nil {
+			return err
+		}
+		err = binary.Write(w, binary.LittleEndian, uint64(len(out)))
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(out)
+		if err != nil {
+			return err
+		}
+	}
+}
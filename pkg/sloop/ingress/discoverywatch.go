@@ -0,0 +1,89 @@
+/*
+ * Copyright (c) 2021, salesforce.com, inc.
+ * All rights reserved.
+ * SPDX-License-Identifier: BSD-3-Clause
+ * For full license text, see LICENSE.txt file in the repo root or https://opensource.org/licenses/BSD-3-Clause
+ */
+
+package ingress
+
+import (
+	"strings"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+)
+
+// Returns every resource the api server lets us list and watch, at the preferred version of its group.  This covers
+// CRDs and aggregated apis.  Resources owned by the built-in informer registry are left out (even when disabled there)
+// so each kind is only watched once.  When some groups fail discovery we still return the rest, along with the
+// groups that failed so the caller can keep whatever it was already watching for them.
+func getDiscoveredResources(discoveryClient discovery.DiscoveryInterface) ([]crdGroupVersionResourceKind, map[string]bool, error) {
+	failedGroups := map[string]bool{}
+	resourceLists, err := discovery.ServerPreferredResources(discoveryClient)
+	if err != nil {
+		groupErr, ok := err.(*discovery.ErrGroupDiscoveryFailed)
+		if !ok {
+			return nil, nil, errors.Wrap(err, "failed to discover api resources")
+		}
+		for gv, gvErr := range groupErr.Groups {
+			glog.Errorf("Discovery failed for %v: %v", gv, gvErr)
+			failedGroups[gv.Group] = true
+		}
+	}
+
+	reservedResources := map[schema.GroupResource]bool{}
+	seenKinds := map[string]bool{}
+	for _, reg := range builtinInformerRegistry {
+		reservedResources[reg.groupResource()] = true
+		seenKinds[reg.kind] = true
+	}
+
+	var resources []crdGroupVersionResourceKind
+	for _, list := range resourceLists {
+		gv, err := schema.ParseGroupVersion(list.GroupVersion)
+		if err != nil {
+			glog.Errorf("Skipping unparsable group version %q: %v", list.GroupVersion, err)
+			continue
+		}
+		for _, res := range list.APIResources {
+			// Subresources like pods/status are not separate objects
+			if strings.Contains(res.Name, "/") {
+				continue
+			}
+			if !hasVerbs(res.Verbs, "list", "watch") {
+				glog.V(2).Infof("Not watching %v.%v because it does not support list and watch", res.Name, gv.Group)
+				continue
+			}
+			if reservedResources[gv.WithResource(res.Name).GroupResource()] {
+				continue
+			}
+			// The same kind can be served by more than one group (e.g. during a group migration).  Keep the first
+			if seenKinds[res.Kind] {
+				glog.V(2).Infof("Not watching %v.%v because kind %v is already watched", res.Name, gv.Group, res.Kind)
+				continue
+			}
+			seenKinds[res.Kind] = true
+			resources = append(resources, crdGroupVersionResourceKind{group: gv.Group, version: gv.Version, resource: res.Name, kind: res.Kind})
+		}
+	}
+	return resources, failedGroups, nil
+}
+
+func hasVerbs(verbs []string, required ...string) bool {
+	for _, r := range required {
+		found := false
+		for _, v := range verbs {
+			if v == r {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,76 @@
+/*
+ * Copyright (c) 2021, salesforce.com, inc.
+ * All rights reserved.
+ * SPDX-License-Identifier: BSD-3-Clause
+ * For full license text, see LICENSE.txt file in the repo root or https://opensource.org/licenses/BSD-3-Clause
+ */
+
+package ingress
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var listWatch = []string{"get", "list", "watch"}
+
+func Test_getDiscoveredResources(t *testing.T) {
+	disc := helper_fakeDiscovery(
+		&metav1.APIResourceList{GroupVersion: "v1", APIResources: []metav1.APIResource{
+			{Name: "pods", Kind: "Pod", Verbs: listWatch},
+			{Name: "pods/status", Kind: "Pod", Verbs: listWatch},
+			{Name: "secrets", Kind: "Secret", Verbs: listWatch},
+		}},
+		&metav1.APIResourceList{GroupVersion: "metrics.k8s.io/v1beta1", APIResources: []metav1.APIResource{
+			{Name: "pods", Kind: "PodMetrics", Verbs: []string{"get", "list"}},
+		}},
+		&metav1.APIResourceList{GroupVersion: "example.com/v2", APIResources: []metav1.APIResource{
+			{Name: "widgets", Kind: "Widget", Verbs: listWatch},
+		}},
+		&metav1.APIResourceList{GroupVersion: "example.com/v1", APIResources: []metav1.APIResource{
+			{Name: "widgets", Kind: "Widget", Verbs: listWatch},
+		}},
+		&metav1.APIResourceList{GroupVersion: "events.k8s.io/v1beta1", APIResources: []metav1.APIResource{
+			{Name: "events", Kind: "Event", Verbs: listWatch},
+		}},
+	)
+
+	resources, failedGroups, err := getDiscoveredResources(disc)
+	assert.Nil(t, err)
+	assert.Len(t, failedGroups, 0)
+
+	// Pods are owned by the built-in registry, pods/status is a subresource, metrics can not be watched and events
+	// are a kind the registry already watches.  Widgets are only watched at the preferred version
+	assert.ElementsMatch(t, []crdGroupVersionResourceKind{
+		{group: "", version: "v1", resource: "secrets", kind: "Secret"},
+		{group: "example.com", version: "v2", resource: "widgets", kind: "Widget"},
+	}, resources)
+}
+
+func Test_keepCrdInformersForGroups(t *testing.T) {
+	kw := &kubeWatcherImpl{protection: &sync.Mutex{}, crdInformers: map[crdGroupVersionResourceKind]*crdInformerInfo{}}
+	flaky := crdGroupVersionResourceKind{group: "metrics.example.com", version: "v1", resource: "r", kind: "k"}
+	stable := crdGroupVersionResourceKind{group: "example.com", version: "v1", resource: "r", kind: "k2"}
+	existing := map[crdGroupVersionResourceKind]*crdInformerInfo{
+		flaky:  {crd: flaky},
+		stable: {crd: stable},
+	}
+
+	kw.keepCrdInformersForGroups(existing, map[string]bool{"metrics.example.com": true})
+	assert.Len(t, kw.crdInformers, 1)
+	assert.NotNil(t, kw.crdInformers[flaky])
+	assert.Len(t, existing, 1)
+	assert.NotNil(t, existing[stable])
+}
+
+func Test_kubeWatchFilter_Groups(t *testing.T) {
+	filter := helper_newFilter(t, KubeWatchFilterConfig{IncludeGroups: []string{"core", "apps", "*.example.com"}, ExcludeGroups: []string{"noisy.example.com"}})
+	assert.True(t, filter.groupAllowed(""))
+	assert.True(t, filter.groupAllowed("apps"))
+	assert.True(t, filter.groupAllowed("widgets.example.com"))
+	assert.False(t, filter.groupAllowed("noisy.example.com"))
+	assert.False(t, filter.groupAllowed("batch"))
+}
@@ -8,28 +8,231 @@
 package ingress
 
 import (
-	"github.com/ghodss/yaml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
 	"github.com/golang/glog"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/pkg/errors"
 	"github.com/salesforce/sloop/pkg/sloop/store/typed"
-	"io/ioutil"
 )
 
-func PlayFile(outChan chan typed.KubeWatchResult, filename string) error {
-	b, err := ioutil.ReadFile(filename)
+type PlaybackConfig struct {
+	// Replay the original gaps between records divided by this factor.  1 = real time, 10 = ten times faster
+	// 0 = as fast as possible, which is the old behavior
+	Speed float64
+	// Shift every timestamp by the same amount so the last record in the recording lands on the current time.  With
+	// a Speed that is the time it is played back at, so no record is stamped later than it is sent
+	RebaseToNow bool
+	// Closing this channel abandons the playback.  Optional, but needed for paced playback running in the
+	// background so the owner of outChan can safely close it
+	Stop <-chan struct{}
+}
+
+var errPlaybackStopped = errors.New("playback stopped")
+
+// PlayFile reads recorded watch data and writes it to outChan.  filename can be a single file, a directory
+// or a glob pattern.  With more than one file they are played back in name order, which matches the order
+// rotated recordings were written.  Every format written by FileRecorder is supported, gzipped or not,
+// as well as the legacy yaml KubePlaybackFile.
+func PlayFile(outChan chan typed.KubeWatchResult, filename string, config PlaybackConfig) error {
+	files, err := getPlaybackFiles(filename)
+	if err != nil {
+		return err
+	}
+
+	player := &filePlayer{outChan: outChan, config: config}
+	if config.RebaseToNow {
+		// We stream records, so finding the end of the recording takes an extra pass over the files
+		player.lastTs, err = getLastTimestamp(files)
+		if err != nil {
+			return err
+		}
+		// Paced playback does not know when it gets to the last record before it plays the first one
+		if config.Speed <= 0 {
+			player.rebase(time.Now(), player.lastTs)
+		}
+	}
+
+	total := 0
+	for _, file := range files {
+		count, err := player.playOneFile(file)
+		total += count
+		if err == errPlaybackStopped {
+			glog.Infof("Playback stopped after %v kubeWatch events", total)
+			return nil
+		}
+		if err != nil {
+			return errors.Wrapf(err, "failed to play back %v after %v records", file, count)
+		}
+		glog.Infof("Loaded %v resources from file source %v", count, file)
+	}
+	glog.Infof("Done writing %v kubeWatch events to channel", total)
+	return nil
+}
+
+type filePlayer struct {
+	outChan chan typed.KubeWatchResult
+	config  PlaybackConfig
+	shift   time.Duration
+	// Wall clock and record time of the first record, used to pace the ones after it
+	startedAt time.Time
+	firstTs   time.Time
+	// Newest record time in the recording, when rebasing
+	lastTs time.Time
+}
+
+func getPlaybackFiles(filename string) ([]string, error) {
+	if strings.ContainsAny(filename, "*?[") {
+		files, err := filepath.Glob(filename)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid playback pattern %v", filename)
+		}
+		if len(files) == 0 {
+			return nil, fmt.Errorf("no playback files match %v", filename)
+		}
+		sort.Strings(files)
+		return files, nil
+	}
+
+	info, err := os.Stat(filename)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open playback file %v", filename)
+	}
+	if !info.IsDir() {
+		return []string{filename}, nil
+	}
+
+	entries, err := ioutil.ReadDir(filename)
 	if err != nil {
-		panic(err)
+		return nil, errors.Wrapf(err, "failed to list playback directory %v", filename)
+	}
+	files := []string{}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			files = append(files, filepath.Join(filename, entry.Name()))
+		}
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+func (p *filePlayer) playOneFile(filename string) (int, error) {
+	count := 0
+	err := forEachRecord(filename, func(rec *typed.KubeWatchResult) error {
+		ts, err := ptypes.Timestamp(rec.Timestamp)
+		if err != nil {
+			return errors.Wrap(err, "record has an invalid timestamp")
+		}
+		err = p.wait(ts)
+		if err != nil {
+			return err
+		}
+		if p.shift != 0 {
+			rec.Timestamp, err = ptypes.TimestampProto(ts.Add(p.shift))
+			if err != nil {
+				return err
+			}
+		}
+		select {
+		case p.outChan <- *rec:
+		case <-p.config.Stop:
+			return errPlaybackStopped
+		}
+		count++
+		return nil
+	})
+	return count, err
+}
+
+// Sleeps until the record at ts is due according to the configured speed
+func (p *filePlayer) wait(ts time.Time) error {
+	if p.config.Speed <= 0 {
+		return nil
+	}
+	if p.startedAt.IsZero() {
+		p.startedAt = time.Now()
+		p.firstTs = ts
+		if p.config.RebaseToNow {
+			p.rebase(p.startedAt.Add(p.scale(p.lastTs.Sub(ts))), p.lastTs)
+		}
+		return nil
+	}
+	due := p.startedAt.Add(p.scale(ts.Sub(p.firstTs)))
+	delay := time.Until(due)
+	if delay <= 0 {
+		return nil
 	}
-	var playbackFile KubePlaybackFile
-	err = yaml.Unmarshal(b, &playbackFile)
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-p.config.Stop:
+		return errPlaybackStopped
+	}
+}
+
+// Gaps in the recording become this much time in playback
+func (p *filePlayer) scale(gap time.Duration) time.Duration {
+	return time.Duration(float64(gap) / p.config.Speed)
+}
+
+// Sets the shift so the record at ts gets the time at
+func (p *filePlayer) rebase(at time.Time, ts time.Time) {
+	p.shift = at.Sub(ts)
+	glog.Infof("Shifting playback timestamps by %v", p.shift)
+}
+
+func getLastTimestamp(files []string) (time.Time, error) {
+	var lastTs time.Time
+	for _, file := range files {
+		err := forEachRecord(file, func(rec *typed.KubeWatchResult) error {
+			ts, err := ptypes.Timestamp(rec.Timestamp)
+			if err != nil {
+				return errors.Wrap(err, "record has an invalid timestamp")
+			}
+			if ts.After(lastTs) {
+				lastTs = ts
+			}
+			return nil
+		})
+		if err != nil {
+			return lastTs, errors.Wrapf(err, "failed to scan %v for last timestamp", file)
+		}
+	}
+	return lastTs, nil
+}
+
+func forEachRecord(filename string, fn func(rec *typed.KubeWatchResult) error) error {
+	f, err := os.Open(filename)
 	if err != nil {
 		return err
 	}
+	defer f.Close()
 
-	glog.Infof("Loaded %v resources from file source %v", len(playbackFile.Data), filename)
+	decoder, err := newRecordDecoder(filename, f)
+	if err != nil {
+		return err
+	}
 
-	for _, watchRecord := range playbackFile.Data {
-		outChan <- watchRecord
+	for {
+		rec, err := decoder.Decode()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		err = fn(rec)
+		if err != nil {
+			return err
+		}
 	}
-	glog.Infof("Done writing kubeWatch events to channel")
-	return nil
 }
@@ -0,0 +1,104 @@
+/*
+ * Copyright (c) 2021, salesforce.com, inc.
+ * All rights reserved.
+ * SPDX-License-Identifier: BSD-3-Clause
+ * For full license text, see LICENSE.txt file in the repo root or https://opensource.org/licenses/BSD-3-Clause
+ */
+
+package ingress
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/salesforce/sloop/pkg/sloop/store/typed"
+	"github.com/stretchr/testify/assert"
+)
+
+func helper_writeRecording(t *testing.T, count int) (string, func()) {
+	dir, err := ioutil.TempDir("", "playback")
+	assert.Nil(t, err)
+	filename := filepath.Join(dir, "rec.ndjson")
+	helper_record(t, FileRecorderConfig{Filename: filename, Format: RecordFormatNdjson}, helper_makeRecords(t, count))
+	return filename, func() { os.RemoveAll(dir) }
+}
+
+func Test_PlayFile_RebaseToNow(t *testing.T) {
+	filename, cleanup := helper_writeRecording(t, 3)
+	defer cleanup()
+
+	before := time.Now()
+	recs := helper_playbackWithConfig(t, filename, 3, PlaybackConfig{RebaseToNow: true})
+	assert.Len(t, recs, 3)
+
+	first, err := ptypes.Timestamp(recs[0].Timestamp)
+	assert.Nil(t, err)
+	last, err := ptypes.Timestamp(recs[2].Timestamp)
+	assert.Nil(t, err)
+	assert.False(t, last.Before(before))
+	assert.True(t, last.Before(time.Now().Add(time.Second)))
+	// Gaps between records are preserved
+	assert.Equal(t, 2*time.Second, last.Sub(first))
+}
+
+func Test_PlayFile_RebaseToNowWithSpeed(t *testing.T) {
+	filename, cleanup := helper_writeRecording(t, 3)
+	defer cleanup()
+
+	// At 20x the last record is played about 100ms after the first, and that is the time it should get
+	start := time.Now()
+	recs := helper_playbackWithConfig(t, filename, 3, PlaybackConfig{Speed: 20, RebaseToNow: true})
+	end := time.Now()
+	assert.Len(t, recs, 3)
+
+	first, err := ptypes.Timestamp(recs[0].Timestamp)
+	assert.Nil(t, err)
+	last, err := ptypes.Timestamp(recs[2].Timestamp)
+	assert.Nil(t, err)
+	assert.False(t, last.Before(start.Add(100*time.Millisecond)))
+	assert.False(t, last.After(end))
+	assert.Equal(t, 2*time.Second, last.Sub(first))
+}
+
+func Test_PlayFile_SpeedKeepsScaledGaps(t *testing.T) {
+	filename, cleanup := helper_writeRecording(t, 3)
+	defer cleanup()
+
+	// Records are 1 second apart, so at 20x the whole recording should take about 100ms
+	start := time.Now()
+	recs := helper_playbackWithConfig(t, filename, 3, PlaybackConfig{Speed: 20})
+	assert.Len(t, recs, 3)
+	assert.True(t, time.Since(start) >= 100*time.Millisecond)
+
+	// Original timestamps are kept when we are not rebasing
+	helper_assertRecordsEqual(t, helper_makeRecords(t, 3), recs)
+}
+
+func Test_PlayFile_Stop(t *testing.T) {
+	filename, cleanup := helper_writeRecording(t, 3)
+	defer cleanup()
+
+	stop := make(chan struct{})
+	close(stop)
+	// Real time would take 2 seconds, but we should bail out as soon as we wait for the second record
+	start := time.Now()
+	recs := helper_playbackWithConfig(t, filename, 3, PlaybackConfig{Speed: 1, Stop: stop})
+	assert.True(t, time.Since(start) < time.Second)
+	assert.True(t, len(recs) <= 1)
+}
+
+func helper_playbackWithConfig(t *testing.T, filename string, expectedCount int, config PlaybackConfig) []typed.KubeWatchResult {
+	outChan := make(chan typed.KubeWatchResult, expectedCount+1)
+	err := PlayFile(outChan, filename, config)
+	assert.Nil(t, err)
+	close(outChan)
+	var recs []typed.KubeWatchResult
+	for rec := range outChan {
+		recs = append(recs, rec)
+	}
+	return recs
+}
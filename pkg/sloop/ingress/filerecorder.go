@@ -8,23 +8,81 @@
 package ingress
 
 import (
-	"github.com/ghodss/yaml"
+	"bufio"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
 	"github.com/golang/glog"
+	"github.com/pkg/errors"
 	"github.com/salesforce/sloop/pkg/sloop/store/typed"
-	"io/ioutil"
-	"sync"
 )
 
+// How often buffered records are pushed to disk so a crash loses at most this much data
+const recorderFlushInterval = time.Second
+
+type FileRecorderConfig struct {
+	Filename string
+	Format   string
+	// Start a new file once this many bytes have been written to the current one.  0 = no size based rotation
+	// When gzip is on this is measured before compression
+	RotateSizeBytes int64
+	// Start a new file once the current one is this old.  0 = no time based rotation
+	RotateInterval time.Duration
+	Gzip           bool
+}
+
+// FileRecorder streams watch results to disk as they arrive.  When rotation is enabled the files are named
+// <name>-<sequence><ext> next to the configured filename, so they sort in the order they were written.
 type FileRecorder struct {
-	inChan   chan typed.KubeWatchResult
-	data     []typed.KubeWatchResult
-	filename string
-	wg       sync.WaitGroup // Ensure we don't call close at the same time we are taking in events
+	inChan  chan typed.KubeWatchResult
+	config  FileRecorderConfig
+	encoder recordEncoder
+	wg      sync.WaitGroup // Ensure we don't call close at the same time we are taking in events
+
+	file         *os.File
+	gzipWriter   *gzip.Writer
+	bufWriter    *bufio.Writer
+	fileBytes    int64
+	fileOpenedAt time.Time
+	sequence     int
+	totalRecords int
+	err          error
+}
+
+func NewFileRecorder(config FileRecorderConfig, inChan chan typed.KubeWatchResult) (*FileRecorder, error) {
+	if config.Format == "" {
+		config.Format = RecordFormatNdjson
+	}
+	encoder, err := newRecordEncoder(config.Format)
+	if err != nil {
+		return nil, err
+	}
+	if config.Gzip && !strings.HasSuffix(config.Filename, gzipExtension) {
+		config.Filename += gzipExtension
+	}
+	fr := &FileRecorder{config: config, inChan: inChan, encoder: encoder}
+	err = fr.openNextFile()
+	if err != nil {
+		return nil, err
+	}
+	return fr, nil
 }
 
-func NewFileRecorder(filename string, inChan chan typed.KubeWatchResult) *FileRecorder {
-	fr := &FileRecorder{filename: filename, inChan: inChan}
-	return fr
+// RunRecordStage copies watch results from inChan to both recordChan and outChan, so a FileRecorder reading
+// recordChan sees every record that goes on to outChan.  It closes both once inChan is closed
+func RunRecordStage(inChan chan typed.KubeWatchResult, outChan chan typed.KubeWatchResult, recordChan chan typed.KubeWatchResult) {
+	defer close(outChan)
+	defer close(recordChan)
+	for rec := range inChan {
+		recordChan <- rec
+		outChan <- rec
+	}
 }
 
 func (fr *FileRecorder) Start() {
@@ -33,24 +91,142 @@ func (fr *FileRecorder) Start() {
 }
 
 func (fr *FileRecorder) listen(inChan chan typed.KubeWatchResult) {
+	defer fr.wg.Done()
+	flushTicker := time.NewTicker(recorderFlushInterval)
+	defer flushTicker.Stop()
 	for {
-		newRecord, more := <-inChan
-		if !more {
-			fr.wg.Done()
-			return
+		select {
+		case newRecord, more := <-inChan:
+			if !more {
+				return
+			}
+			err := fr.write(&newRecord)
+			if err != nil {
+				// Keep draining the channel so we dont block ingestion, but remember the error for Close()
+				glog.Errorf("Failed to record watch result to %v: %v", fr.currentFilename(), err)
+				fr.err = err
+			}
+		case <-flushTicker.C:
+			err := fr.flush()
+			if err != nil {
+				glog.Errorf("Failed to flush recording %v: %v", fr.currentFilename(), err)
+				fr.err = err
+			}
 		}
-		fr.data = append(fr.data, newRecord)
 	}
 }
 
-func (fr *FileRecorder) Close() error {
-	fr.wg.Wait()
-	f := KubePlaybackFile{Data: fr.data}
-	byteData, err := yaml.Marshal(f)
+func (fr *FileRecorder) write(rec *typed.KubeWatchResult) error {
+	if fr.needsRotation() {
+		err := fr.closeFile()
+		if err != nil {
+			return err
+		}
+		err = fr.openNextFile()
+		if err != nil {
+			return err
+		}
+	}
+	if fr.bufWriter == nil {
+		return fmt.Errorf("no open recording file")
+	}
+	n, err := fr.encoder.Encode(fr.bufWriter, rec)
+	fr.fileBytes += int64(n)
+	if err != nil {
+		return err
+	}
+	fr.totalRecords++
+	return nil
+}
+
+func (fr *FileRecorder) needsRotation() bool {
+	if fr.fileBytes == 0 {
+		return false
+	}
+	if fr.config.RotateSizeBytes > 0 && fr.fileBytes >= fr.config.RotateSizeBytes {
+		return true
+	}
+	if fr.config.RotateInterval > 0 && time.Since(fr.fileOpenedAt) >= fr.config.RotateInterval {
+		return true
+	}
+	return false
+}
+
+func (fr *FileRecorder) rotationEnabled() bool {
+	return fr.config.RotateSizeBytes > 0 || fr.config.RotateInterval > 0
+}
+
+// Turns /a/b/rec.ndjson.gz into /a/b/rec-000003.ndjson.gz when rotation is enabled
+func (fr *FileRecorder) currentFilename() string {
+	if !fr.rotationEnabled() {
+		return fr.config.Filename
+	}
+	dir, base := filepath.Split(fr.config.Filename)
+	stem, ext := base, ""
+	if idx := strings.Index(base, "."); idx > 0 {
+		stem, ext = base[:idx], base[idx:]
+	}
+	return filepath.Join(dir, fmt.Sprintf("%v-%06d%v", stem, fr.sequence, ext))
+}
+
+func (fr *FileRecorder) openNextFile() error {
+	fr.sequence++
+	filename := fr.currentFilename()
+	f, err := os.OpenFile(filename, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
+	if err != nil {
+		return errors.Wrapf(err, "failed to open record file %v", filename)
+	}
+	fr.file = f
+	var w io.Writer = f
+	if fr.config.Gzip {
+		fr.gzipWriter = gzip.NewWriter(f)
+		w = fr.gzipWriter
+	}
+	fr.bufWriter = bufio.NewWriter(w)
+	fr.fileBytes = 0
+	fr.fileOpenedAt = time.Now()
+	glog.Infof("Recording watch data to %v", filename)
+	return nil
+}
+
+func (fr *FileRecorder) flush() error {
+	if fr.bufWriter == nil {
+		return nil
+	}
+	err := fr.bufWriter.Flush()
 	if err != nil {
 		return err
 	}
-	err = ioutil.WriteFile(fr.filename, byteData, 0755)
-	glog.Infof("Wrote %v records to %v. err %v", len(fr.data), fr.filename, err)
+	if fr.gzipWriter != nil {
+		return fr.gzipWriter.Flush()
+	}
+	return nil
+}
+
+func (fr *FileRecorder) closeFile() error {
+	if fr.file == nil {
+		return nil
+	}
+	err := fr.flush()
+	if err == nil && fr.gzipWriter != nil {
+		err = fr.gzipWriter.Close()
+	}
+	closeErr := fr.file.Close()
+	if err == nil {
+		err = closeErr
+	}
+	fr.file = nil
+	fr.gzipWriter = nil
+	fr.bufWriter = nil
+	return err
+}
+
+func (fr *FileRecorder) Close() error {
+	fr.wg.Wait()
+	err := fr.closeFile()
+	if err == nil {
+		err = fr.err
+	}
+	glog.Infof("Wrote %v records to %v file(s) starting with %v. err %v", fr.totalRecords, fr.sequence, fr.config.Filename, err)
 	return err
 }
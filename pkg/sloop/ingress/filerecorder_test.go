@@ -0,0 +1,147 @@
+/*
+ * Copyright (c) 2021, salesforce.com, inc.
+ * All rights reserved.
+ * SPDX-License-Identifier: BSD-3-Clause
+ * For full license text, see LICENSE.txt file in the repo root or https://opensource.org/licenses/BSD-3-Clause
+ */
+
+package ingress
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/salesforce/sloop/pkg/sloop/store/typed"
+	"github.com/salesforce/sloop/pkg/sloop/test/assertex"
+	"github.com/stretchr/testify/assert"
+)
+
+var someRecordTime = time.Date(2019, 3, 4, 3, 4, 5, 6, time.UTC)
+
+func helper_makeRecords(t *testing.T, count int) []typed.KubeWatchResult {
+	var recs []typed.KubeWatchResult
+	for i := 0; i < count; i++ {
+		ts, err := ptypes.TimestampProto(someRecordTime.Add(time.Duration(i) * time.Second))
+		assert.Nil(t, err)
+		recs = append(recs, typed.KubeWatchResult{Timestamp: ts, Kind: "Pod", WatchType: typed.KubeWatchResult_UPDATE, Payload: `{"metadata":{"name":"p"}}`})
+	}
+	return recs
+}
+
+func helper_record(t *testing.T, config FileRecorderConfig, recs []typed.KubeWatchResult) {
+	inChan := make(chan typed.KubeWatchResult, len(recs))
+	fr, err := NewFileRecorder(config, inChan)
+	assert.Nil(t, err)
+	fr.Start()
+	for _, rec := range recs {
+		inChan <- rec
+	}
+	close(inChan)
+	assert.Nil(t, fr.Close())
+}
+
+func helper_playback(t *testing.T, filename string, expectedCount int) []typed.KubeWatchResult {
+	return helper_playbackWithConfig(t, filename, expectedCount, PlaybackConfig{})
+}
+
+func helper_assertRecordsEqual(t *testing.T, expected []typed.KubeWatchResult, actual []typed.KubeWatchResult) {
+	if !assert.Equal(t, len(expected), len(actual)) {
+		return
+	}
+	for idx := range expected {
+		assertex.ProtoEqual(t, &expected[idx], &actual[idx])
+	}
+}
+
+func Test_FileRecorder_Ndjson_RoundTrip(t *testing.T) {
+	dir, err := ioutil.TempDir("", "recorder")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	recs := helper_makeRecords(t, 5)
+	filename := filepath.Join(dir, "rec.ndjson")
+	helper_record(t, FileRecorderConfig{Filename: filename, Format: RecordFormatNdjson}, recs)
+
+	helper_assertRecordsEqual(t, recs, helper_playback(t, filename, len(recs)))
+}
+
+func Test_FileRecorder_ProtobufGzipRotation_RoundTrip(t *testing.T) {
+	dir, err := ioutil.TempDir("", "recorder")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	recs := helper_makeRecords(t, 10)
+	config := FileRecorderConfig{Filename: filepath.Join(dir, "rec.pb"), Format: RecordFormatProtobuf, RotateSizeBytes: 1, Gzip: true}
+	helper_record(t, config, recs)
+
+	files, err := filepath.Glob(filepath.Join(dir, "rec-*.pb.gz"))
+	assert.Nil(t, err)
+	assert.Len(t, files, len(recs))
+
+	helper_assertRecordsEqual(t, recs, helper_playback(t, dir, len(recs)))
+	helper_assertRecordsEqual(t, recs, helper_playback(t, filepath.Join(dir, "rec-*"), len(recs)))
+}
+
+func Test_PlayFile_LegacyYaml(t *testing.T) {
+	dir, err := ioutil.TempDir("", "recorder")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	recs := helper_makeRecords(t, 3)
+	data, err := yaml.Marshal(KubePlaybackFile{Data: recs})
+	assert.Nil(t, err)
+	filename := filepath.Join(dir, "legacy.yaml")
+	assert.Nil(t, ioutil.WriteFile(filename, data, 0644))
+
+	helper_assertRecordsEqual(t, recs, helper_playback(t, filename, len(recs)))
+}
+
+func Test_PlayFile_SniffsFormatWithoutExtension(t *testing.T) {
+	dir, err := ioutil.TempDir("", "recorder")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	recs := helper_makeRecords(t, 3)
+	filename := filepath.Join(dir, "noext")
+	helper_record(t, FileRecorderConfig{Filename: filename, Format: RecordFormatProtobuf}, recs)
+
+	helper_assertRecordsEqual(t, recs, helper_playback(t, filename, len(recs)))
+}
+
+func Test_NewFileRecorder_BadFormat(t *testing.T) {
+	_, err := NewFileRecorder(FileRecorderConfig{Filename: "x", Format: "xml"}, nil)
+	assert.NotNil(t, err)
+}
+
+func Test_RunRecordStage_RecordsEverythingItPassesOn(t *testing.T) {
+	dir, err := ioutil.TempDir("", "recorder")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	recs := helper_makeRecords(t, 5)
+	filename := filepath.Join(dir, "rec.ndjson")
+	inChan := make(chan typed.KubeWatchResult, len(recs))
+	outChan := make(chan typed.KubeWatchResult, len(recs))
+	recordChan := make(chan typed.KubeWatchResult, len(recs))
+	fr, err := NewFileRecorder(FileRecorderConfig{Filename: filename, Format: RecordFormatNdjson}, recordChan)
+	assert.Nil(t, err)
+	fr.Start()
+	for _, rec := range recs {
+		inChan <- rec
+	}
+	close(inChan)
+	RunRecordStage(inChan, outChan, recordChan)
+	assert.Nil(t, fr.Close())
+
+	var passedOn []typed.KubeWatchResult
+	for rec := range outChan {
+		passedOn = append(passedOn, rec)
+	}
+	helper_assertRecordsEqual(t, recs, passedOn)
+	helper_assertRecordsEqual(t, recs, helper_playback(t, filename, len(recs)))
+}
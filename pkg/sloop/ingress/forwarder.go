@@ -0,0 +1,225 @@
+/*
+ * Copyright (c) 2021, salesforce.com, inc.
+ * All rights reserved.
+ * SPDX-License-Identifier: BSD-3-Clause
+ * For full license text, see LICENSE.txt file in the repo root or https://opensource.org/licenses/BSD-3-Clause
+ */
+
+package ingress
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/salesforce/sloop/pkg/sloop/common"
+	"github.com/salesforce/sloop/pkg/sloop/store/typed"
+)
+
+const (
+	defaultForwardBatchRecords  = 500
+	defaultForwardBatchInterval = 2 * time.Second
+	forwardTimeout              = 30 * time.Second
+	forwardRetryMin             = time.Second
+	forwardRetryMax             = time.Minute
+)
+
+var (
+	metricIngressForwardRecords  = promauto.NewCounterVec(prometheus.CounterOpts{Name: "sloop_ingress_forward_records"}, []string{"result"})
+	metricIngressForwardRequests = promauto.NewCounterVec(prometheus.CounterOpts{Name: "sloop_ingress_forward_requests"}, []string{"code"})
+)
+
+type ForwarderConfig struct {
+	URL           string        // Ingest endpoint of the central sloop, like https://sloop.example.com/edge-1/ingest
+	Token         string        // Sent as a bearer token
+	BatchRecords  int           // Most records in one request.  0 = 500
+	BatchInterval time.Duration // How long a record waits for others to share its request.  0 = 2s
+	Client        *http.Client  // nil = a client with a 30s timeout
+}
+
+// Forwarder sends the watch results on inChan to the push endpoint of another sloop, in batches and in order.
+// A batch that can not be sent is retried with backoff until it goes through, which pushes back on inChan.  Put a
+// SpillQueue in front of it to buffer records while the central server is down.
+// After Stop it still sends everything up to the close of inChan, but gives up at the first failure so shutdown is
+// not held up by a server that is down.
+type Forwarder struct {
+	config  ForwarderConfig
+	inChan  chan typed.KubeWatchResult
+	encoder recordEncoder
+	wg      *sync.WaitGroup
+	// Closed by Stop, to cut short a retry wait
+	stopping chan struct{}
+	stopOnce *sync.Once
+	gaveUp   bool
+}
+
+func NewForwarder(config ForwarderConfig, inChan chan typed.KubeWatchResult) (*Forwarder, error) {
+	target, err := url.Parse(config.URL)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid forward url")
+	}
+	if target.Scheme != "http" && target.Scheme != "https" {
+		return nil, fmt.Errorf("forward url must be http or https, got %q", config.URL)
+	}
+	if config.BatchRecords <= 0 {
+		config.BatchRecords = defaultForwardBatchRecords
+	}
+	if config.BatchInterval <= 0 {
+		config.BatchInterval = defaultForwardBatchInterval
+	}
+	if config.Client == nil {
+		config.Client = &http.Client{Timeout: forwardTimeout}
+	}
+	return &Forwarder{config: config, inChan: inChan, encoder: &protobufEncoder{}, wg: &sync.WaitGroup{}, stopping: make(chan struct{}), stopOnce: &sync.Once{}}, nil
+}
+
+func (f *Forwarder) Start() {
+	f.wg.Add(1)
+	go f.listen()
+}
+
+// Stop is called before inChan is closed for shutdown
+func (f *Forwarder) Stop() {
+	f.stopOnce.Do(func() { close(f.stopping) })
+}
+
+// Wait returns once inChan has been closed and everything read from it was sent or dropped
+func (f *Forwarder) Wait() {
+	f.wg.Wait()
+}
+
+func (f *Forwarder) listen() {
+	defer f.wg.Done()
+	var batch []typed.KubeWatchResult
+	var flush <-chan time.Time
+	for {
+		select {
+		case rec, more := <-f.inChan:
+			if !more {
+				f.send(batch)
+				return
+			}
+			batch = append(batch, rec)
+			if len(batch) == 1 {
+				flush = time.After(f.config.BatchInterval)
+			}
+			if len(batch) < f.config.BatchRecords {
+				continue
+			}
+		case <-flush:
+		}
+		f.send(batch)
+		batch = nil
+		flush = nil
+	}
+}
+
+// Keeps trying until the batch is accepted, except for batches the server says are invalid
+func (f *Forwarder) send(batch []typed.KubeWatchResult) {
+	if len(batch) == 0 {
+		return
+	}
+	backoff := forwardRetryMin
+	for {
+		if f.gaveUp {
+			f.drop(batch, "shutdown")
+			return
+		}
+		code, retryAfter, err := f.post(batch)
+		switch {
+		case err == nil:
+			metricIngressForwardRecords.WithLabelValues("sent").Add(float64(len(batch)))
+			return
+		case code == http.StatusRequestEntityTooLarge && len(batch) > 1:
+			// More than the server lets this agent send at once.  Halves keep the order
+			glog.V(common.GlogVerbose).Infof("Batch of %v is too large for %v, splitting it", len(batch), f.config.URL)
+			f.send(batch[:len(batch)/2])
+			f.send(batch[len(batch)/2:])
+			return
+		case code == http.StatusBadRequest || code == http.StatusRequestEntityTooLarge:
+			glog.Errorf("Dropping %v records refused by %v: %v", len(batch), f.config.URL, err)
+			f.drop(batch, "refused")
+			return
+		}
+
+		glog.Errorf("Failed to forward %v records to %v, will retry: %v", len(batch), f.config.URL, err)
+		wait := backoff
+		if retryAfter > wait {
+			wait = retryAfter
+		}
+		select {
+		case <-f.stopping:
+			// Records still queued behind this batch would only wait for the same server, so stop trying
+			f.gaveUp = true
+		case <-time.After(wait):
+		}
+		backoff *= 2
+		if backoff > forwardRetryMax {
+			backoff = forwardRetryMax
+		}
+	}
+}
+
+// Returns the status code, how long the server asked us to wait, and an error unless the whole batch was accepted
+func (f *Forwarder) post(batch []typed.KubeWatchResult) (int, time.Duration, error) {
+	var body bytes.Buffer
+	gz := gzip.NewWriter(&body)
+	for idx := range batch {
+		_, err := f.encoder.Encode(gz, &batch[idx])
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+	err := gz.Close()
+	if err != nil {
+		return 0, 0, errors.Wrap(err, "failed to compress batch")
+	}
+
+	request, err := http.NewRequest(http.MethodPost, f.config.URL, &body)
+	if err != nil {
+		return 0, 0, errors.Wrap(err, "failed to create request")
+	}
+	request.Header.Set("Content-Type", PushContentTypeProtobuf)
+	request.Header.Set("Content-Encoding", "gzip")
+	if f.config.Token != "" {
+		request.Header.Set("Authorization", "Bearer "+f.config.Token)
+	}
+	response, err := f.config.Client.Do(request)
+	if err != nil {
+		metricIngressForwardRequests.WithLabelValues("error").Inc()
+		return 0, 0, err
+	}
+	defer response.Body.Close()
+	metricIngressForwardRequests.WithLabelValues(strconv.Itoa(response.StatusCode)).Inc()
+
+	if response.StatusCode != http.StatusOK {
+		message, _ := ioutil.ReadAll(io.LimitReader(response.Body, 1024))
+		retryAfter, _ := strconv.Atoi(response.Header.Get("Retry-After"))
+		return response.StatusCode, time.Duration(retryAfter) * time.Second, fmt.Errorf("%v: %s", response.Status, bytes.TrimSpace(message))
+	}
+	var ack PushAck
+	err = json.NewDecoder(response.Body).Decode(&ack)
+	if err != nil {
+		return response.StatusCode, 0, errors.Wrap(err, "failed to read acknowledgement")
+	}
+	if ack.Accepted != len(batch) {
+		return response.StatusCode, 0, fmt.Errorf("server accepted %v of %v records", ack.Accepted, len(batch))
+	}
+	return response.StatusCode, 0, nil
+}
+
+func (f *Forwarder) drop(batch []typed.KubeWatchResult, reason string) {
+	metricIngressForwardRecords.WithLabelValues(reason).Add(float64(len(batch)))
+}
@@ -0,0 +1,140 @@
+/*
+ * Copyright (c) 2021, salesforce.com, inc.
+ * All rights reserved.
+ * SPDX-License-Identifier: BSD-3-Clause
+ * For full license text, see LICENSE.txt file in the repo root or https://opensource.org/licenses/BSD-3-Clause
+ */
+
+package ingress
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/salesforce/sloop/pkg/sloop/store/typed"
+	"github.com/stretchr/testify/assert"
+)
+
+// Forwards recs to a push receiver with the given source and returns what the receiver put on its channel
+func helper_forward(t *testing.T, source PushSource, config ForwarderConfig, recs []typed.KubeWatchResult) []typed.KubeWatchResult {
+	receiver, outChan := helper_newPushReceiver(t, []PushSource{source}, len(recs))
+	server := httptest.NewServer(receiver)
+	defer server.Close()
+
+	config.URL = server.URL
+	inChan := make(chan typed.KubeWatchResult)
+	forwarder, err := NewForwarder(config, inChan)
+	assert.Nil(t, err)
+	forwarder.Start()
+	for _, rec := range recs {
+		inChan <- rec
+	}
+	close(inChan)
+	forwarder.Wait()
+	close(outChan)
+
+	var received []typed.KubeWatchResult
+	for rec := range outChan {
+		received = append(received, rec)
+	}
+	return received
+}
+
+func Test_Forwarder_SendsBatchesInOrder(t *testing.T) {
+	recs := helper_makeRecords(t, 5)
+	received := helper_forward(t, PushSource{Name: "agent", Token: "secret"}, ForwarderConfig{Token: "secret", BatchRecords: 2}, recs)
+	helper_assertRecordsEqual(t, recs, received)
+}
+
+func Test_Forwarder_SplitsBatchesOverBurst(t *testing.T) {
+	recs := helper_makeRecords(t, 7)
+	source := PushSource{Name: "agent", Token: "secret", Burst: 2}
+	received := helper_forward(t, source, ForwarderConfig{Token: "secret", BatchRecords: 10}, recs)
+	helper_assertRecordsEqual(t, recs, received)
+}
+
+func Test_Forwarder_FlushesAfterInterval(t *testing.T) {
+	receiver, outChan := helper_newPushReceiver(t, []PushSource{{Name: "agent", Token: "secret"}}, 10)
+	server := httptest.NewServer(receiver)
+	defer server.Close()
+
+	inChan := make(chan typed.KubeWatchResult)
+	forwarder, err := NewForwarder(ForwarderConfig{URL: server.URL, Token: "secret", BatchRecords: 100, BatchInterval: 10 * time.Millisecond}, inChan)
+	assert.Nil(t, err)
+	forwarder.Start()
+	defer func() {
+		close(inChan)
+		forwarder.Wait()
+	}()
+
+	recs := helper_makeRecords(t, 1)
+	inChan <- recs[0]
+	select {
+	case rec := <-outChan:
+		helper_assertRecordsEqual(t, recs, []typed.KubeWatchResult{rec})
+	case <-time.After(5 * time.Second):
+		assert.Fail(t, "batch was not flushed")
+	}
+}
+
+func Test_Forwarder_RetriesUntilAccepted(t *testing.T) {
+	receiver, outChan := helper_newPushReceiver(t, []PushSource{{Name: "agent", Token: "secret"}}, 10)
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			http.Error(writer, "down", http.StatusServiceUnavailable)
+			return
+		}
+		receiver.ServeHTTP(writer, request)
+	}))
+	defer server.Close()
+
+	inChan := make(chan typed.KubeWatchResult)
+	forwarder, err := NewForwarder(ForwarderConfig{URL: server.URL, Token: "secret", BatchRecords: 2}, inChan)
+	assert.Nil(t, err)
+	forwarder.Start()
+	recs := helper_makeRecords(t, 2)
+	inChan <- recs[0]
+	inChan <- recs[1]
+
+	helper_assertRecordsEqual(t, recs, helper_readChan(outChan, 2))
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+	close(inChan)
+	forwarder.Wait()
+}
+
+func Test_Forwarder_GivesUpWhenStopped(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		http.Error(writer, "down", http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	inChan := make(chan typed.KubeWatchResult, 10)
+	forwarder, err := NewForwarder(ForwarderConfig{URL: server.URL, BatchRecords: 1}, inChan)
+	assert.Nil(t, err)
+	for _, rec := range helper_makeRecords(t, 3) {
+		inChan <- rec
+	}
+	forwarder.Start()
+	forwarder.Stop()
+	close(inChan)
+
+	done := make(chan struct{})
+	go func() {
+		forwarder.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		assert.Fail(t, "forwarder kept retrying after it was stopped")
+	}
+}
+
+func Test_NewForwarder_BadUrl(t *testing.T) {
+	_, err := NewForwarder(ForwarderConfig{URL: "sloop:8080/ctx/ingest"}, nil)
+	assert.NotNil(t, err)
+}
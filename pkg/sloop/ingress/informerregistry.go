@@ -0,0 +1,289 @@
+/*
+ * Copyright (c) 2021, salesforce.com, inc.
+ * All rights reserved.
+ * SPDX-License-Identifier: BSD-3-Clause
+ * For full license text, see LICENSE.txt file in the repo root or https://opensource.org/licenses/BSD-3-Clause
+ */
+
+package ingress
+
+import (
+	"fmt"
+
+	"github.com/golang/glog"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
+)
+
+type typedInformerFunc func(factory informers.SharedInformerFactory) cache.SharedIndexInformer
+
+type typedInformerVersion struct {
+	version  string
+	informer typedInformerFunc
+}
+
+// informerRegistration describes one built-in kind sloop knows how to watch.  The version comes from discovery,
+// so we follow whatever the cluster prefers.  When that is a version this client library has no typed informer
+// for (like policy/v1 or autoscaling/v2) we watch it through the dynamic client instead.
+type informerRegistration struct {
+	kind             string
+	group            string
+	resource         string
+	enabledByDefault bool
+	// Typed informers this client library supports.  The first one is used when discovery is unavailable
+	typedVersions []typedInformerVersion
+}
+
+func (r *informerRegistration) groupResource() schema.GroupResource {
+	return schema.GroupResource{Group: r.group, Resource: r.resource}
+}
+
+func (r *informerRegistration) typedInformerForVersion(version string) typedInformerFunc {
+	for _, tv := range r.typedVersions {
+		if tv.version == version {
+			return tv.informer
+		}
+	}
+	return nil
+}
+
+// Some kinds are off by default.  RBAC kinds need extra permissions, EndpointSlices duplicate Endpoints and Leases
+// are heartbeats that update every few seconds
+var builtinInformerRegistry = []informerRegistration{
+	{kind: "DaemonSet", group: "apps", resource: "daemonsets", enabledByDefault: true, typedVersions: []typedInformerVersion{
+		{"v1", func(f informers.SharedInformerFactory) cache.SharedIndexInformer {
+			return f.Apps().V1().DaemonSets().Informer()
+		}},
+	}},
+	{kind: "Deployment", group: "apps", resource: "deployments", enabledByDefault: true, typedVersions: []typedInformerVersion{
+		{"v1", func(f informers.SharedInformerFactory) cache.SharedIndexInformer {
+			return f.Apps().V1().Deployments().Informer()
+		}},
+	}},
+	{kind: "ReplicaSet", group: "apps", resource: "replicasets", enabledByDefault: true, typedVersions: []typedInformerVersion{
+		{"v1", func(f informers.SharedInformerFactory) cache.SharedIndexInformer {
+			return f.Apps().V1().ReplicaSets().Informer()
+		}},
+	}},
+	{kind: "StatefulSet", group: "apps", resource: "statefulsets", enabledByDefault: true, typedVersions: []typedInformerVersion{
+		{"v1", func(f informers.SharedInformerFactory) cache.SharedIndexInformer {
+			return f.Apps().V1().StatefulSets().Informer()
+		}},
+	}},
+	{kind: "ConfigMap", group: "", resource: "configmaps", enabledByDefault: true, typedVersions: []typedInformerVersion{
+		{"v1", func(f informers.SharedInformerFactory) cache.SharedIndexInformer {
+			return f.Core().V1().ConfigMaps().Informer()
+		}},
+	}},
+	{kind: "Endpoint", group: "", resource: "endpoints", enabledByDefault: true, typedVersions: []typedInformerVersion{
+		{"v1", func(f informers.SharedInformerFactory) cache.SharedIndexInformer {
+			return f.Core().V1().Endpoints().Informer()
+		}},
+	}},
+	{kind: "Event", group: "", resource: "events", enabledByDefault: true, typedVersions: []typedInformerVersion{
+		{"v1", func(f informers.SharedInformerFactory) cache.SharedIndexInformer {
+			return f.Core().V1().Events().Informer()
+		}},
+	}},
+	{kind: "HorizontalPodAutoscaler", group: "autoscaling", resource: "horizontalpodautoscalers", enabledByDefault: true, typedVersions: []typedInformerVersion{
+		{"v1", func(f informers.SharedInformerFactory) cache.SharedIndexInformer {
+			return f.Autoscaling().V1().HorizontalPodAutoscalers().Informer()
+		}},
+		{"v2beta2", func(f informers.SharedInformerFactory) cache.SharedIndexInformer {
+			return f.Autoscaling().V2beta2().HorizontalPodAutoscalers().Informer()
+		}},
+		{"v2beta1", func(f informers.SharedInformerFactory) cache.SharedIndexInformer {
+			return f.Autoscaling().V2beta1().HorizontalPodAutoscalers().Informer()
+		}},
+	}},
+	{kind: "Job", group: "batch", resource: "jobs", enabledByDefault: true, typedVersions: []typedInformerVersion{
+		{"v1", func(f informers.SharedInformerFactory) cache.SharedIndexInformer {
+			return f.Batch().V1().Jobs().Informer()
+		}},
+	}},
+	{kind: "Namespace", group: "", resource: "namespaces", enabledByDefault: true, typedVersions: []typedInformerVersion{
+		{"v1", func(f informers.SharedInformerFactory) cache.SharedIndexInformer {
+			return f.Core().V1().Namespaces().Informer()
+		}},
+	}},
+	{kind: "Node", group: "", resource: "nodes", enabledByDefault: true, typedVersions: []typedInformerVersion{
+		{"v1", func(f informers.SharedInformerFactory) cache.SharedIndexInformer {
+			return f.Core().V1().Nodes().Informer()
+		}},
+	}},
+	{kind: "PersistentVolumeClaim", group: "", resource: "persistentvolumeclaims", enabledByDefault: true, typedVersions: []typedInformerVersion{
+		{"v1", func(f informers.SharedInformerFactory) cache.SharedIndexInformer {
+			return f.Core().V1().PersistentVolumeClaims().Informer()
+		}},
+	}},
+	{kind: "PersistentVolume", group: "", resource: "persistentvolumes", enabledByDefault: true, typedVersions: []typedInformerVersion{
+		{"v1", func(f informers.SharedInformerFactory) cache.SharedIndexInformer {
+			return f.Core().V1().PersistentVolumes().Informer()
+		}},
+	}},
+	{kind: "Pod", group: "", resource: "pods", enabledByDefault: true, typedVersions: []typedInformerVersion{
+		{"v1", func(f informers.SharedInformerFactory) cache.SharedIndexInformer {
+			return f.Core().V1().Pods().Informer()
+		}},
+	}},
+	{kind: "PodDisruptionBudget", group: "policy", resource: "poddisruptionbudgets", enabledByDefault: true, typedVersions: []typedInformerVersion{
+		{"v1beta1", func(f informers.SharedInformerFactory) cache.SharedIndexInformer {
+			return f.Policy().V1beta1().PodDisruptionBudgets().Informer()
+		}},
+	}},
+	{kind: "Service", group: "", resource: "services", enabledByDefault: true, typedVersions: []typedInformerVersion{
+		{"v1", func(f informers.SharedInformerFactory) cache.SharedIndexInformer {
+			return f.Core().V1().Services().Informer()
+		}},
+	}},
+	{kind: "ReplicationController", group: "", resource: "replicationcontrollers", enabledByDefault: true, typedVersions: []typedInformerVersion{
+		{"v1", func(f informers.SharedInformerFactory) cache.SharedIndexInformer {
+			return f.Core().V1().ReplicationControllers().Informer()
+		}},
+	}},
+	{kind: "StorageClass", group: "storage.k8s.io", resource: "storageclasses", enabledByDefault: true, typedVersions: []typedInformerVersion{
+		{"v1", func(f informers.SharedInformerFactory) cache.SharedIndexInformer {
+			return f.Storage().V1().StorageClasses().Informer()
+		}},
+	}},
+	{kind: "Ingress", group: "networking.k8s.io", resource: "ingresses", enabledByDefault: true, typedVersions: []typedInformerVersion{
+		{"v1beta1", func(f informers.SharedInformerFactory) cache.SharedIndexInformer {
+			return f.Networking().V1beta1().Ingresses().Informer()
+		}},
+	}},
+	{kind: "NetworkPolicy", group: "networking.k8s.io", resource: "networkpolicies", enabledByDefault: true, typedVersions: []typedInformerVersion{
+		{"v1", func(f informers.SharedInformerFactory) cache.SharedIndexInformer {
+			return f.Networking().V1().NetworkPolicies().Informer()
+		}},
+	}},
+	{kind: "CronJob", group: "batch", resource: "cronjobs", enabledByDefault: true, typedVersions: []typedInformerVersion{
+		{"v1beta1", func(f informers.SharedInformerFactory) cache.SharedIndexInformer {
+			return f.Batch().V1beta1().CronJobs().Informer()
+		}},
+	}},
+	{kind: "ServiceAccount", group: "", resource: "serviceaccounts", enabledByDefault: true, typedVersions: []typedInformerVersion{
+		{"v1", func(f informers.SharedInformerFactory) cache.SharedIndexInformer {
+			return f.Core().V1().ServiceAccounts().Informer()
+		}},
+	}},
+	{kind: "ResourceQuota", group: "", resource: "resourcequotas", enabledByDefault: true, typedVersions: []typedInformerVersion{
+		{"v1", func(f informers.SharedInformerFactory) cache.SharedIndexInformer {
+			return f.Core().V1().ResourceQuotas().Informer()
+		}},
+	}},
+	{kind: "LimitRange", group: "", resource: "limitranges", enabledByDefault: true, typedVersions: []typedInformerVersion{
+		{"v1", func(f informers.SharedInformerFactory) cache.SharedIndexInformer {
+			return f.Core().V1().LimitRanges().Informer()
+		}},
+	}},
+	{kind: "PriorityClass", group: "scheduling.k8s.io", resource: "priorityclasses", enabledByDefault: true, typedVersions: []typedInformerVersion{
+		{"v1", func(f informers.SharedInformerFactory) cache.SharedIndexInformer {
+			return f.Scheduling().V1().PriorityClasses().Informer()
+		}},
+	}},
+	{kind: "Role", group: "rbac.authorization.k8s.io", resource: "roles", typedVersions: []typedInformerVersion{
+		{"v1", func(f informers.SharedInformerFactory) cache.SharedIndexInformer {
+			return f.Rbac().V1().Roles().Informer()
+		}},
+	}},
+	{kind: "RoleBinding", group: "rbac.authorization.k8s.io", resource: "rolebindings", typedVersions: []typedInformerVersion{
+		{"v1", func(f informers.SharedInformerFactory) cache.SharedIndexInformer {
+			return f.Rbac().V1().RoleBindings().Informer()
+		}},
+	}},
+	{kind: "ClusterRole", group: "rbac.authorization.k8s.io", resource: "clusterroles", typedVersions: []typedInformerVersion{
+		{"v1", func(f informers.SharedInformerFactory) cache.SharedIndexInformer {
+			return f.Rbac().V1().ClusterRoles().Informer()
+		}},
+	}},
+	{kind: "ClusterRoleBinding", group: "rbac.authorization.k8s.io", resource: "clusterrolebindings", typedVersions: []typedInformerVersion{
+		{"v1", func(f informers.SharedInformerFactory) cache.SharedIndexInformer {
+			return f.Rbac().V1().ClusterRoleBindings().Informer()
+		}},
+	}},
+	{kind: "EndpointSlice", group: "discovery.k8s.io", resource: "endpointslices", typedVersions: []typedInformerVersion{
+		{"v1beta1", func(f informers.SharedInformerFactory) cache.SharedIndexInformer {
+			return f.Discovery().V1beta1().EndpointSlices().Informer()
+		}},
+	}},
+	{kind: "Lease", group: "coordination.k8s.io", resource: "leases", typedVersions: []typedInformerVersion{
+		{"v1", func(f informers.SharedInformerFactory) cache.SharedIndexInformer {
+			return f.Coordination().V1().Leases().Informer()
+		}},
+	}},
+}
+
+// A built-in kind resolved against the cluster.  typedInformer is nil when the version needs the dynamic client
+type resolvedInformer struct {
+	kind          string
+	gvr           schema.GroupVersionResource
+	typedInformer typedInformerFunc
+}
+
+// Returns the registrations enabled after applying overrides, which map kind to on/off
+func enabledInformerRegistrations(overrides map[string]bool) ([]informerRegistration, error) {
+	known := map[string]bool{}
+	for _, reg := range builtinInformerRegistry {
+		known[reg.kind] = true
+	}
+	for kind := range overrides {
+		if !known[kind] {
+			return nil, fmt.Errorf("unknown built-in informer kind %q", kind)
+		}
+	}
+
+	var enabled []informerRegistration
+	for _, reg := range builtinInformerRegistry {
+		on, found := overrides[reg.kind]
+		if !found {
+			on = reg.enabledByDefault
+		}
+		if on {
+			enabled = append(enabled, reg)
+		}
+	}
+	return enabled, nil
+}
+
+// Picks the preferred served version for each registration.  Kinds the cluster does not serve are skipped.
+// If discovery fails for a group we assume the default typed version, which is how sloop behaved before discovery
+func resolveInformers(discoveryClient discovery.DiscoveryInterface, registrations []informerRegistration) []resolvedInformer {
+	preferred := map[schema.GroupResource]string{}
+	failedGroups := map[string]bool{}
+	resourceLists, err := discovery.ServerPreferredResources(discoveryClient)
+	if err != nil {
+		if groupErr, ok := err.(*discovery.ErrGroupDiscoveryFailed); ok {
+			for gv := range groupErr.Groups {
+				failedGroups[gv.Group] = true
+			}
+		}
+		glog.Errorf("Discovery of preferred versions failed, falling back to defaults where needed: %v", err)
+	}
+	for _, list := range resourceLists {
+		gv, err := schema.ParseGroupVersion(list.GroupVersion)
+		if err != nil {
+			continue
+		}
+		for _, res := range list.APIResources {
+			preferred[schema.GroupResource{Group: gv.Group, Resource: res.Name}] = gv.Version
+		}
+	}
+	discoveryFailed := len(resourceLists) == 0 && err != nil
+
+	var resolved []resolvedInformer
+	for _, reg := range registrations {
+		version, found := preferred[reg.groupResource()]
+		if !found {
+			if !discoveryFailed && !failedGroups[reg.group] {
+				glog.Infof("Not watching %v because the cluster does not serve %v", reg.kind, reg.groupResource())
+				continue
+			}
+			version = reg.typedVersions[0].version
+		}
+		gvr := reg.groupResource().WithVersion(version)
+		resolved = append(resolved, resolvedInformer{kind: reg.kind, gvr: gvr, typedInformer: reg.typedInformerForVersion(version)})
+	}
+	return resolved
+}
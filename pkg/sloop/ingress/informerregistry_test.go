@@ -0,0 +1,116 @@
+/*
+ * Copyright (c) 2021, salesforce.com, inc.
+ * All rights reserved.
+ * SPDX-License-Identifier: BSD-3-Clause
+ * For full license text, see LICENSE.txt file in the repo root or https://opensource.org/licenses/BSD-3-Clause
+ */
+
+package ingress
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	discoveryFake "k8s.io/client-go/discovery/fake"
+	kubernetesFake "k8s.io/client-go/kubernetes/fake"
+)
+
+type failingDiscovery struct {
+	*discoveryFake.FakeDiscovery
+}
+
+func (d failingDiscovery) ServerGroups() (*metav1.APIGroupList, error) {
+	return nil, fmt.Errorf("forbidden")
+}
+
+func helper_fakeDiscovery(resources ...*metav1.APIResourceList) *discoveryFake.FakeDiscovery {
+	kubeClient := kubernetesFake.NewSimpleClientset()
+	kubeClient.Resources = resources
+	return kubeClient.Discovery().(*discoveryFake.FakeDiscovery)
+}
+
+func helper_registrations(t *testing.T, kinds ...string) []informerRegistration {
+	overrides := map[string]bool{}
+	for _, reg := range builtinInformerRegistry {
+		overrides[reg.kind] = false
+	}
+	for _, kind := range kinds {
+		overrides[kind] = true
+	}
+	regs, err := enabledInformerRegistrations(overrides)
+	assert.Nil(t, err)
+	return regs
+}
+
+func helper_resolvedByKind(resolved []resolvedInformer) map[string]resolvedInformer {
+	ret := map[string]resolvedInformer{}
+	for _, r := range resolved {
+		ret[r.kind] = r
+	}
+	return ret
+}
+
+func Test_enabledInformerRegistrations_Defaults(t *testing.T) {
+	regs, err := enabledInformerRegistrations(nil)
+	assert.Nil(t, err)
+	kinds := map[string]bool{}
+	for _, reg := range regs {
+		kinds[reg.kind] = true
+	}
+	assert.True(t, kinds["Pod"])
+	assert.True(t, kinds["CronJob"])
+	assert.False(t, kinds["Lease"])
+	assert.False(t, kinds["Role"])
+}
+
+func Test_enabledInformerRegistrations_Overrides(t *testing.T) {
+	regs, err := enabledInformerRegistrations(map[string]bool{"Lease": true, "Pod": false})
+	assert.Nil(t, err)
+	kinds := map[string]bool{}
+	for _, reg := range regs {
+		kinds[reg.kind] = true
+	}
+	assert.True(t, kinds["Lease"])
+	assert.False(t, kinds["Pod"])
+
+	_, err = enabledInformerRegistrations(map[string]bool{"NotAKind": true})
+	assert.NotNil(t, err)
+}
+
+func Test_resolveInformers_PreferredVersions(t *testing.T) {
+	disc := helper_fakeDiscovery(
+		&metav1.APIResourceList{GroupVersion: "v1", APIResources: []metav1.APIResource{{Name: "pods", Kind: "Pod"}}},
+		&metav1.APIResourceList{GroupVersion: "policy/v1", APIResources: []metav1.APIResource{{Name: "poddisruptionbudgets", Kind: "PodDisruptionBudget"}}},
+		&metav1.APIResourceList{GroupVersion: "autoscaling/v2beta2", APIResources: []metav1.APIResource{{Name: "horizontalpodautoscalers", Kind: "HorizontalPodAutoscaler"}}},
+		&metav1.APIResourceList{GroupVersion: "autoscaling/v1", APIResources: []metav1.APIResource{{Name: "horizontalpodautoscalers", Kind: "HorizontalPodAutoscaler"}}},
+	)
+	resolved := helper_resolvedByKind(resolveInformers(disc, helper_registrations(t, "Pod", "PodDisruptionBudget", "HorizontalPodAutoscaler", "Lease")))
+
+	assert.Len(t, resolved, 3)
+	assert.Equal(t, schema.GroupVersionResource{Version: "v1", Resource: "pods"}, resolved["Pod"].gvr)
+	assert.NotNil(t, resolved["Pod"].typedInformer)
+
+	// No typed informer for policy/v1 in this client library, so it goes through the dynamic client
+	assert.Equal(t, schema.GroupVersionResource{Group: "policy", Version: "v1", Resource: "poddisruptionbudgets"}, resolved["PodDisruptionBudget"].gvr)
+	assert.Nil(t, resolved["PodDisruptionBudget"].typedInformer)
+
+	assert.Equal(t, "v2beta2", resolved["HorizontalPodAutoscaler"].gvr.Version)
+	assert.NotNil(t, resolved["HorizontalPodAutoscaler"].typedInformer)
+
+	// Not served by this cluster
+	_, found := resolved["Lease"]
+	assert.False(t, found)
+}
+
+func Test_resolveInformers_DiscoveryFailureUsesDefaults(t *testing.T) {
+	disc := failingDiscovery{helper_fakeDiscovery()}
+	resolved := helper_resolvedByKind(resolveInformers(disc, helper_registrations(t, "Pod", "PodDisruptionBudget")))
+
+	assert.Len(t, resolved, 2)
+	assert.Equal(t, "v1", resolved["Pod"].gvr.Version)
+	assert.Equal(t, "v1beta1", resolved["PodDisruptionBudget"].gvr.Version)
+	assert.NotNil(t, resolved["PodDisruptionBudget"].typedInformer)
+}
@@ -24,11 +24,11 @@ var ClientConfig = clientcmd.ClientConfig.ClientConfig
 var RawConfig = clientcmd.ClientConfig.RawConfig
 
 // GetKubernetesContext takes optional user preferences and returns the Kubernetes context in use
-func GetKubernetesContext(masterURL string, kubeContextPreference string, privilegedAccess bool) (string, error) {
+func GetKubernetesContext(masterURL string, kubeConfig string, kubeContextPreference string, privilegedAccess bool) (string, error) {
 	glog.Infof("Getting k8s context with user-defined config masterURL=%v, kubeContextPreference=%v.", masterURL, kubeContextPreference)
 	contextInUse := kubeContextPreference
 	if privilegedAccess {
-		clientConfig := getConfig(masterURL, kubeContextPreference)
+		clientConfig := getConfig(masterURL, kubeConfig, kubeContextPreference)
 		// This tells us the currentContext defined in the kubeConfig which gets used if we dont have an override
 		rawConfig, err := RawConfig(clientConfig)
 		if err != nil {
@@ -46,18 +46,18 @@ func GetKubernetesContext(masterURL string, kubeContextPreference string, privil
 }
 
 // MakeKubernetesClient takes masterURL and kubeContext (user preference should have already been resolved before calling this)
-// and returns a K8s client
-func MakeKubernetesClient(masterURL string, kubeContext string, privilegedAccess bool) (kubernetes.Interface, error) {
+// and returns a K8s client.  kubeConfig is an optional path to a kubeconfig file, otherwise the default loading rules apply
+func MakeKubernetesClient(masterURL string, kubeConfig string, kubeContext string, privilegedAccess bool) (kubernetes.Interface, error) {
 	glog.Infof("Creating k8sclient with user-defined config masterURL=%v, kubeContext=%v.", masterURL, kubeContext)
 	var config *rest.Config
 	var err error
 	if privilegedAccess {
-		clientConfig := getConfig(masterURL, kubeContext)
+		clientConfig := getConfig(masterURL, kubeConfig, kubeContext)
 		config, err = ClientConfig(clientConfig)
 		glog.Infof("Building k8sclient with context=%v, masterURL=%v, configFile=%v.", kubeContext, config.Host, clientConfig.ConfigAccess().GetLoadingPrecedence())
 	} else {
 		glog.Infof("Creating Config using BuildConfigFromFlags")
-		config, err = BuildConfigFromFlags(masterURL, "")
+		config, err = BuildConfigFromFlags(masterURL, kubeConfig)
 		if err != nil {
 			glog.Errorf("Cannot create config using BuildConfigFromFlags")
 			return nil, err
@@ -75,8 +75,9 @@ func MakeKubernetesClient(masterURL string, kubeContext string, privilegedAccess
 	return clientset, nil
 }
 
-func getConfig(masterURL string, kubeContext string) clientcmd.ClientConfig {
+func getConfig(masterURL string, kubeConfig string, kubeContext string) clientcmd.ClientConfig {
 	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+	loadingRules.ExplicitPath = kubeConfig
 	return clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
 		loadingRules,
 		&clientcmd.ConfigOverrides{CurrentContext: kubeContext, ClusterInfo: api.Cluster{Server: masterURL}})
@@ -21,7 +21,7 @@ func TestGetKubernetesContext(t *testing.T) {
 		}
 		return api.Config{}, nil
 	}
-	GetKubernetesContext("", "", privilegedAccess)
+	GetKubernetesContext("", "", "", privilegedAccess)
 	if !methodInvoked {
 		t.Errorf("RawConfig not invoked")
 	}
@@ -29,7 +29,7 @@ func TestGetKubernetesContext(t *testing.T) {
 
 func TestGetKubernetesContextNoPrivilegedAccess(t *testing.T) {
 	var context string
-	context, _ = GetKubernetesContext("", "", false)
+	context, _ = GetKubernetesContext("", "", "", false)
 	assert.Equal(t, context, "")
 }
 
@@ -46,7 +46,7 @@ func TestMakeKubernetesClientByGetConfig(t *testing.T) {
 		}
 		return &rest.Config{}, nil
 	}
-	MakeKubernetesClient("", "", privilegedAccess)
+	MakeKubernetesClient("", "", "", privilegedAccess)
 	if !methodInvoked {
 		t.Errorf("ClientConfig not invoked")
 	}
@@ -65,7 +65,7 @@ func TestMakeKubernetesClientByBuildConfigFromFlag(t *testing.T) {
 		}
 		return &rest.Config{}, nil
 	}
-	MakeKubernetesClient("", "", privilegedAccess)
+	MakeKubernetesClient("", "", "", privilegedAccess)
 	if !methodInvoked {
 		t.Errorf("BuildConfigFromFlags not invoked")
 	}
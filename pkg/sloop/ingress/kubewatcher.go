@@ -23,8 +23,10 @@ import (
 	"github.com/salesforce/sloop/pkg/sloop/kubeextractor"
 	"github.com/salesforce/sloop/pkg/sloop/store/typed"
 	"k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/dynamic/dynamicinformer"
 	"k8s.io/client-go/informers"
@@ -55,10 +57,16 @@ type crdInformerInfo struct {
 
 type kubeWatcherImpl struct {
 	informerFactory informers.SharedInformerFactory
-	stopChan        chan struct{}
+	// Kinds with label or field selectors need their own factory, because list options apply to a whole factory
+	filteredFactories []informers.SharedInformerFactory
+	stopChan          chan struct{}
+	filter            *kubeWatchFilter
 
+	// Holds the dynamic informers for CRDs, or for every discovered resource when watchAllResources is set
 	crdInformers      map[crdGroupVersionResourceKind]*crdInformerInfo
 	activeCrdInformer int64
+	watchAllResources bool
+	discoveryClient   discovery.DiscoveryInterface
 
 	outchan        chan typed.KubeWatchResult
 	resync         time.Duration
@@ -66,6 +74,8 @@ type kubeWatcherImpl struct {
 	stopped        bool
 	refreshCrd     *time.Ticker
 	currentContext string
+	// Objects created before this were already there when we started, so adding them is not a creation
+	startTime time.Time
 }
 
 var (
@@ -73,81 +83,159 @@ var (
 	metricIngressGranularKubewatchcount = promauto.NewCounterVec(prometheus.CounterOpts{Name: "metric_ingress_event_kubewatchcount"}, []string{"namespace", "name", "kind", "reason", "type"})
 	metricIngressKubewatchcount         = promauto.NewCounterVec(prometheus.CounterOpts{Name: "sloop_ingress_kubewatchcount"}, []string{"kind", "watchtype", "namespace"})
 	metricIngressKubewatchbytes         = promauto.NewCounterVec(prometheus.CounterOpts{Name: "sloop_ingress_kubewatchbytes"}, []string{"kind", "watchtype", "namespace"})
+	metricIngressKubewatchReplays       = promauto.NewCounterVec(prometheus.CounterOpts{Name: "sloop_ingress_kubewatch_replays"}, []string{"kind", "watchtype"})
 	metricCrdInformerStarted            = promauto.NewGauge(prometheus.GaugeOpts{Name: "sloop_crd_informer_started"})
 	metricCrdInformerRunning            = promauto.NewGauge(prometheus.GaugeOpts{Name: "sloop_crd_informer_running"})
 )
 
-// Todo: Add additional parameters for filtering
-func NewKubeWatcherSource(kubeClient kubernetes.Interface, outChan chan typed.KubeWatchResult, resync time.Duration, includeCrds bool, crdRefreshInterval time.Duration, masterURL string, kubeContext string, enableGranularMetrics bool) (KubeWatcher, error) {
-	kw := &kubeWatcherImpl{resync: resync, protection: &sync.Mutex{}}
+var newDynamicClient = func(masterURL string, kubeConfig string, kubeContext string) (dynamic.Interface, error) {
+	kubeCfg, err := getConfig(masterURL, kubeConfig, kubeContext).ClientConfig()
+	if err != nil {
+		return nil, err
+	}
+	return dynamic.NewForConfig(kubeCfg)
+}
+
+type KubeWatcherConfig struct {
+	Resync                time.Duration // How often the informers send every object again
+	IncludeCrds           bool          // Also watch custom resources
+	CrdRefreshInterval    time.Duration // How often custom resources, or every resource with WatchAllResources, are looked up again
+	MasterURL             string        // Used with KubeConfig and KubeContext for the dynamic clients
+	KubeConfig            string
+	KubeContext           string
+	EnableGranularMetrics bool // Count events by namespace, name and reason
+	Filter                KubeWatchFilterConfig
+	BuiltinInformers      map[string]bool // Turns built-in informers on or off by kind.  nil = the defaults
+	WatchAllResources     bool            // Use discovery to watch every listable resource
+}
+
+func NewKubeWatcherSource(config KubeWatcherConfig, kubeClient kubernetes.Interface, outChan chan typed.KubeWatchResult) (KubeWatcher, error) {
+	filter, err := newKubeWatchFilter(config.Filter)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid kube watch filter")
+	}
+	kw := &kubeWatcherImpl{resync: config.Resync, protection: &sync.Mutex{}, filter: filter, watchAllResources: config.WatchAllResources, startTime: time.Now()}
+	kw.discoveryClient = kubeClient.Discovery()
 	kw.stopChan = make(chan struct{})
 	kw.crdInformers = make(map[crdGroupVersionResourceKind]*crdInformerInfo)
 	kw.outchan = outChan
 
-	kw.startWellKnownInformers(kubeClient, enableGranularMetrics)
-	if includeCrds {
-		err := kw.startCustomInformers(masterURL, kubeContext, enableGranularMetrics)
+	err = kw.startWellKnownInformers(kubeClient, config.BuiltinInformers, config.MasterURL, config.KubeConfig, config.KubeContext, config.EnableGranularMetrics)
+	if err != nil {
+		return nil, err
+	}
+	// Discovery finds CRDs too, so there is nothing extra to do for IncludeCrds in that mode
+	if config.IncludeCrds || config.WatchAllResources {
+		err := kw.startCustomInformers(config.MasterURL, config.KubeConfig, config.KubeContext, config.EnableGranularMetrics)
 		if err != nil {
 			return nil, err
 		}
 
-		kw.refreshCrd = time.NewTicker(crdRefreshInterval)
-		go kw.refreshCrdInformers(masterURL, kubeContext, enableGranularMetrics)
+		kw.refreshCrd = time.NewTicker(config.CrdRefreshInterval)
+		go kw.refreshCrdInformers(config.MasterURL, config.KubeConfig, config.KubeContext, config.EnableGranularMetrics)
 	}
 
 	return kw, nil
 }
 
-func (i *kubeWatcherImpl) startWellKnownInformers(kubeclient kubernetes.Interface, enableGranularMetrics bool) {
+func (i *kubeWatcherImpl) startWellKnownInformers(kubeclient kubernetes.Interface, builtinInformers map[string]bool, masterURL string, kubeConfig string, kubeContext string, enableGranularMetrics bool) error {
+	registrations, err := enabledInformerRegistrations(builtinInformers)
+	if err != nil {
+		return err
+	}
+	var allowed []informerRegistration
+	for _, reg := range registrations {
+		if !i.filter.groupAllowed(reg.group) || !i.filter.kindAllowed(reg.kind) {
+			glog.Infof("Not watching %v because it is filtered out", reg.kind)
+			continue
+		}
+		allowed = append(allowed, reg)
+	}
+
 	i.informerFactory = informers.NewSharedInformerFactory(kubeclient, i.resync)
+	var dynamicClient dynamic.Interface
+	var dynamicFactories []dynamicinformer.DynamicSharedInformerFactory
+	for _, resolved := range resolveInformers(kubeclient.Discovery(), allowed) {
+		tweak := i.filter.tweakListOptions(resolved.kind)
+		handler := i.getEventHandlerForResource(resolved.kind, enableGranularMetrics)
+		if resolved.typedInformer != nil {
+			factory := i.informerFactory
+			if tweak != nil {
+				factory = informers.NewSharedInformerFactoryWithOptions(kubeclient, i.resync, informers.WithTweakListOptions(tweak))
+				i.filteredFactories = append(i.filteredFactories, factory)
+			}
+			resolved.typedInformer(factory).AddEventHandler(handler)
+			glog.V(2).Infof("Watching %v using %v", resolved.kind, resolved.gvr)
+			continue
+		}
+
+		// This client library has no typed informer for the version the cluster prefers
+		if dynamicClient == nil {
+			dynamicClient, err = newDynamicClient(masterURL, kubeConfig, kubeContext)
+			if err != nil {
+				return errors.Wrapf(err, "failed to create dynamic client to watch %v", resolved.gvr)
+			}
+		}
+		factory := dynamicinformer.NewFilteredDynamicSharedInformerFactory(dynamicClient, i.resync, "", tweak)
+		factory.ForResource(resolved.gvr).Informer().AddEventHandler(handler)
+		dynamicFactories = append(dynamicFactories, factory)
+		glog.V(2).Infof("Watching %v using %v through the dynamic client", resolved.kind, resolved.gvr)
+	}
 
-	i.informerFactory.Apps().V1().DaemonSets().Informer().AddEventHandler(i.getEventHandlerForResource("DaemonSet", enableGranularMetrics))
-	i.informerFactory.Apps().V1().Deployments().Informer().AddEventHandler(i.getEventHandlerForResource("Deployment", enableGranularMetrics))
-	i.informerFactory.Apps().V1().ReplicaSets().Informer().AddEventHandler(i.getEventHandlerForResource("ReplicaSet", enableGranularMetrics))
-	i.informerFactory.Apps().V1().StatefulSets().Informer().AddEventHandler(i.getEventHandlerForResource("StatefulSet", enableGranularMetrics))
-	i.informerFactory.Core().V1().ConfigMaps().Informer().AddEventHandler(i.getEventHandlerForResource("ConfigMap", enableGranularMetrics))
-	i.informerFactory.Core().V1().Endpoints().Informer().AddEventHandler(i.getEventHandlerForResource("Endpoint", enableGranularMetrics))
-	i.informerFactory.Core().V1().Events().Informer().AddEventHandler(i.getEventHandlerForResource("Event", enableGranularMetrics))
-	i.informerFactory.Autoscaling().V1().HorizontalPodAutoscalers().Informer().AddEventHandler(i.getEventHandlerForResource("HorizontalPodAutoscaler", enableGranularMetrics))
-	i.informerFactory.Batch().V1().Jobs().Informer().AddEventHandler(i.getEventHandlerForResource("Job", enableGranularMetrics))
-	i.informerFactory.Core().V1().Namespaces().Informer().AddEventHandler(i.getEventHandlerForResource("Namespace", enableGranularMetrics))
-	i.informerFactory.Core().V1().Nodes().Informer().AddEventHandler(i.getEventHandlerForResource("Node", enableGranularMetrics))
-	i.informerFactory.Core().V1().PersistentVolumeClaims().Informer().AddEventHandler(i.getEventHandlerForResource("PersistentVolumeClaim", enableGranularMetrics))
-	i.informerFactory.Core().V1().PersistentVolumes().Informer().AddEventHandler(i.getEventHandlerForResource("PersistentVolume", enableGranularMetrics))
-	i.informerFactory.Core().V1().Pods().Informer().AddEventHandler(i.getEventHandlerForResource("Pod", enableGranularMetrics))
-	i.informerFactory.Policy().V1beta1().PodDisruptionBudgets().Informer().AddEventHandler(i.getEventHandlerForResource("PodDisruptionBudget", enableGranularMetrics))
-	i.informerFactory.Core().V1().Services().Informer().AddEventHandler(i.getEventHandlerForResource("Service", enableGranularMetrics))
-	i.informerFactory.Core().V1().ReplicationControllers().Informer().AddEventHandler(i.getEventHandlerForResource("ReplicationController", enableGranularMetrics))
-	i.informerFactory.Storage().V1().StorageClasses().Informer().AddEventHandler(i.getEventHandlerForResource("StorageClass", enableGranularMetrics))
 	i.informerFactory.Start(i.stopChan)
+	for _, factory := range i.filteredFactories {
+		factory.Start(i.stopChan)
+	}
+	for _, factory := range dynamicFactories {
+		factory.Start(i.stopChan)
+	}
+	return nil
 }
 
-func (i *kubeWatcherImpl) startCustomInformers(masterURL string, kubeContext string, enableGranularMetrics bool) error {
+func (i *kubeWatcherImpl) startCustomInformers(masterURL string, kubeConfig string, kubeContext string, enableGranularMetrics bool) error {
 
-	clientCfg := getConfig(masterURL, kubeContext)
+	clientCfg := getConfig(masterURL, kubeConfig, kubeContext)
 	kubeCfg, err := clientCfg.ClientConfig()
 	if err != nil {
 		return errors.Wrap(err, "failed to read config while starting custom informers")
 	}
 
-	crdClient, err := newCrdClient(kubeCfg)
-	if err != nil {
-		return errors.Wrap(err, "failed to instantiate client for querying CRDs")
-	}
-	crdList, err := getCrdList(crdClient)
-	if err != nil {
-		return errors.Wrap(err, "failed to query list of CRDs")
+	var resources []crdGroupVersionResourceKind
+	var failedGroups map[string]bool
+	if i.watchAllResources {
+		resources, failedGroups, err = getDiscoveredResources(i.discoveryClient)
+		if err != nil {
+			return err
+		}
+		glog.Infof("Discovered %d watchable resources", len(resources))
+	} else {
+		crdClient, err := newCrdClient(kubeCfg)
+		if err != nil {
+			return errors.Wrap(err, "failed to instantiate client for querying CRDs")
+		}
+		resources, err = getCrdList(crdClient)
+		if err != nil {
+			return errors.Wrap(err, "failed to query list of CRDs")
+		}
+		glog.Infof("Found %d CRD definitions", len(resources))
 	}
 
-	glog.Infof("Found %d CRD definitions", len(crdList))
 	dynamicClient, err := dynamic.NewForConfig(kubeCfg)
 	if err != nil {
 		return errors.Wrap(err, "failed to instantiate client for custom informers")
 	}
 	existing := i.pullCrdInformers()
+	i.keepCrdInformersForGroups(existing, failedGroups)
 	factory := dynamicinformer.NewFilteredDynamicSharedInformerFactory(dynamicClient, i.resync, "", nil)
-	for _, crd := range crdList {
-		i.existingOrStartNewCrdInformer(crd, existing, factory, enableGranularMetrics)
+	for _, crd := range resources {
+		if !i.filter.groupAllowed(crd.group) || !i.filter.kindAllowed(crd.kind) {
+			continue
+		}
+		crdFactory := factory
+		if tweak := i.filter.tweakListOptions(crd.kind); tweak != nil {
+			crdFactory = dynamicinformer.NewFilteredDynamicSharedInformerFactory(dynamicClient, i.resync, "", tweak)
+		}
+		i.existingOrStartNewCrdInformer(crd, existing, crdFactory, enableGranularMetrics)
 	}
 
 	glog.Infof("Stopping %d CRD Informers", len(existing))
@@ -165,6 +253,18 @@ func (i *kubeWatcherImpl) pullCrdInformers() map[crdGroupVersionResourceKind]*cr
 	return crdInformers
 }
 
+// A group that failed discovery is usually a flaky aggregated api, so keep its informers running until we know more
+func (i *kubeWatcherImpl) keepCrdInformersForGroups(existing map[crdGroupVersionResourceKind]*crdInformerInfo, groups map[string]bool) {
+	i.protection.Lock()
+	defer i.protection.Unlock()
+	for crd, crdInformer := range existing {
+		if groups[crd.group] {
+			i.crdInformers[crd] = crdInformer
+			delete(existing, crd)
+		}
+	}
+}
+
 func (i *kubeWatcherImpl) existingOrStartNewCrdInformer(crd crdGroupVersionResourceKind, existing map[crdGroupVersionResourceKind]*crdInformerInfo, factory dynamicinformer.DynamicSharedInformerFactory, enableGranularMetrics bool) {
 	i.protection.Lock()
 	defer i.protection.Unlock()
@@ -262,6 +362,7 @@ func (i *kubeWatcherImpl) reportAdd(kind string, enableGranularMetrics bool) fun
 			Kind:      kind,
 			WatchType: typed.KubeWatchResult_ADD,
 			Payload:   "",
+			Replay:    i.existedBeforeStart(obj),
 		}
 		i.processUpdate(kind, obj, watchResultShell, enableGranularMetrics)
 	}
@@ -285,18 +386,46 @@ func (i *kubeWatcherImpl) reportDelete(kind string, enableGranularMetrics bool)
 }
 
 func (i *kubeWatcherImpl) reportUpdate(kind string, enableGranularMetrics bool) func(interface{}, interface{}) {
-	return func(_ interface{}, newObj interface{}) {
+	return func(oldObj interface{}, newObj interface{}) {
 		watchResultShell := &typed.KubeWatchResult{
 			Timestamp: ptypes.TimestampNow(),
 			Kind:      kind,
 			WatchType: typed.KubeWatchResult_UPDATE,
 			Payload:   "",
+			Replay:    isResync(oldObj, newObj),
 		}
 		i.processUpdate(kind, newObj, watchResultShell, enableGranularMetrics)
 	}
 }
 
+// The informer lists everything when it starts, and reports each object as added
+func (i *kubeWatcherImpl) existedBeforeStart(obj interface{}) bool {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return false
+	}
+	created := accessor.GetCreationTimestamp()
+	// Creation timestamps only have seconds
+	return !created.IsZero() && created.Time.Before(i.startTime.Truncate(time.Second))
+}
+
+// A resync or a relist reports every object as updated, even when it did not change
+func isResync(oldObj interface{}, newObj interface{}) bool {
+	oldAccessor, err := meta.Accessor(oldObj)
+	if err != nil {
+		return false
+	}
+	newAccessor, err := meta.Accessor(newObj)
+	if err != nil {
+		return false
+	}
+	return newAccessor.GetResourceVersion() != "" && oldAccessor.GetResourceVersion() == newAccessor.GetResourceVersion()
+}
+
 func (i *kubeWatcherImpl) processUpdate(kind string, obj interface{}, watchResult *typed.KubeWatchResult, enableGranularmetrics bool) {
+	if !i.filter.allow(kind, obj) {
+		return
+	}
 	resourceJson, err := i.getResourceAsJsonString(kind, obj)
 	if err != nil {
 		glog.Error(err)
@@ -323,6 +452,9 @@ func (i *kubeWatcherImpl) processUpdate(kind string, obj interface{}, watchResul
 	}
 	metricIngressKubewatchcount.WithLabelValues(kind, watchResult.WatchType.String(), kubeMetadata.Namespace).Inc()
 	metricIngressKubewatchbytes.WithLabelValues(kind, watchResult.WatchType.String(), kubeMetadata.Namespace).Add(float64(len(resourceJson)))
+	if watchResult.Replay {
+		metricIngressKubewatchReplays.WithLabelValues(kind, watchResult.WatchType.String()).Inc()
+	}
 
 	glog.V(common.GlogVerbose).Infof("Informer update (%s) - Name: %s, Namespace: %s, ResourceVersion: %s", watchResult.WatchType, kubeMetadata.Name, kubeMetadata.Namespace, kubeMetadata.ResourceVersion)
 	watchResult.Payload = resourceJson
@@ -338,7 +470,9 @@ func (i *kubeWatcherImpl) writeToOutChan(watchResult *typed.KubeWatchResult) {
 	if i.stopped {
 		return
 	}
-	i.outchan <- *watchResult // WARNING - if this channel gets full, this push will block while holding i.protection in a locked state
+	// WARNING - if this channel gets full, this push will block while holding i.protection in a locked state
+	// The server puts a SpillQueue on the other end of it so that only happens if it is turned off
+	i.outchan <- *watchResult
 }
 
 func (i *kubeWatcherImpl) getResourceAsJsonString(kind string, obj interface{}) (string, error) {
@@ -350,10 +484,10 @@ func (i *kubeWatcherImpl) getResourceAsJsonString(kind string, obj interface{})
 	return string(bytes), nil
 }
 
-func (i *kubeWatcherImpl) refreshCrdInformers(masterURL string, kubeContext string, enableGranularMetrics bool) {
+func (i *kubeWatcherImpl) refreshCrdInformers(masterURL string, kubeConfig string, kubeContext string, enableGranularMetrics bool) {
 	for range i.refreshCrd.C {
 		glog.V(common.GlogVerbose).Infof("Starting to refresh CRD informers")
-		err := i.startCustomInformers(masterURL, kubeContext, enableGranularMetrics)
+		err := i.startCustomInformers(masterURL, kubeConfig, kubeContext, enableGranularMetrics)
 		if err != nil {
 			glog.Errorf("Failed to refresh CRD informers: %v", err)
 		}
@@ -72,13 +72,19 @@ func Test_bigPicture(t *testing.T) {
 	newCrdClient = newTestCrdClient(reactionListOfOne) // force startCustomInformers() to use a fake clientset
 
 	kubeClient := kubernetesFake.NewSimpleClientset()
+	kubeClient.Resources = []*metav1.APIResourceList{
+		{GroupVersion: "v1", APIResources: []metav1.APIResource{{Name: "namespaces", Kind: "Namespace"}, {Name: "services", Kind: "Service", Namespaced: true}}},
+	}
 	outChan := make(chan typed.KubeWatchResult, 5)
-	resync := 30 * time.Minute
-	includeCrds := true
-	masterURL := "url"
-	kubeContext := "" // empty string makes things work
-	enableGranularMetrics := true
-	kw, err := NewKubeWatcherSource(kubeClient, outChan, resync, includeCrds, time.Duration(10*time.Second), masterURL, kubeContext, enableGranularMetrics)
+	config := KubeWatcherConfig{
+		Resync:                30 * time.Minute,
+		IncludeCrds:           true,
+		CrdRefreshInterval:    10 * time.Second,
+		MasterURL:             "url",
+		KubeContext:           "", // empty string makes things work
+		EnableGranularMetrics: true,
+	}
+	kw, err := NewKubeWatcherSource(config, kubeClient, outChan)
 	assert.NoError(t, err)
 
 	// create service and await corresponding event
@@ -193,6 +199,32 @@ func Test_reportUpdate(t *testing.T) {
 	verifyChannelEmpty(t, outChan)
 }
 
+func Test_reportAdd_FlagsObjectsFromBeforeStart(t *testing.T) {
+	outChan := make(chan typed.KubeWatchResult, 5)
+	kw := &kubeWatcherImpl{protection: &sync.Mutex{}, outchan: outChan, startTime: time.Now()}
+	report := kw.reportAdd("Pod", false)
+
+	old := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "old", CreationTimestamp: metav1.NewTime(kw.startTime.Add(-time.Hour))}}
+	created := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "new", CreationTimestamp: metav1.NewTime(kw.startTime.Add(time.Second))}}
+	report(old)
+	report(created)
+	assert.True(t, (<-outChan).Replay)
+	assert.False(t, (<-outChan).Replay)
+}
+
+func Test_reportUpdate_FlagsResyncs(t *testing.T) {
+	outChan := make(chan typed.KubeWatchResult, 5)
+	kw := &kubeWatcherImpl{protection: &sync.Mutex{}, outchan: outChan}
+	report := kw.reportUpdate("Pod", false)
+
+	v1 := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p", ResourceVersion: "1"}}
+	v2 := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p", ResourceVersion: "2"}}
+	report(v1, v1)
+	report(v1, v2)
+	assert.True(t, (<-outChan).Replay)
+	assert.False(t, (<-outChan).Replay)
+}
+
 func Test_processUpdate(t *testing.T) {
 	outChan := make(chan typed.KubeWatchResult, 5)
 	kw := &kubeWatcherImpl{protection: &sync.Mutex{}, outchan: outChan}
@@ -0,0 +1,242 @@
+/*
+ * Copyright (c) 2021, salesforce.com, inc.
+ * All rights reserved.
+ * SPDX-License-Identifier: BSD-3-Clause
+ * For full license text, see LICENSE.txt file in the repo root or https://opensource.org/licenses/BSD-3-Clause
+ */
+
+package ingress
+
+import (
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/salesforce/sloop/pkg/sloop/kubeextractor"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// KubeWatchFilterConfig decides which resources the kube watcher ingests.
+// Namespace and kind patterns are globs (kube-*), or regular expressions when wrapped in slashes (/^kube-.*$/).
+// An empty include list includes everything, and exclude always wins over include.
+// Namespace filters apply to namespaced resources and to Namespace objects themselves.  Cluster scoped
+// resources like Nodes are only subject to the kind filters.
+// Group filters decide which api groups get informers at all.  The core group is called "core".
+type KubeWatchFilterConfig struct {
+	IncludeNamespaces []string `json:"includeNamespaces"`
+	ExcludeNamespaces []string `json:"excludeNamespaces"`
+	IncludeKinds      []string `json:"includeKinds"`
+	ExcludeKinds      []string `json:"excludeKinds"`
+	IncludeGroups     []string `json:"includeGroups"`
+	ExcludeGroups     []string `json:"excludeGroups"`
+	// Keyed by kind.  These are passed to the informers so the api server never sends us the filtered objects
+	LabelSelectors map[string]string `json:"labelSelectors"`
+	FieldSelectors map[string]string `json:"fieldSelectors"`
+}
+
+// How the core api group, which has an empty name, is referred to in group filters
+const coreGroupName = "core"
+
+const (
+	filterRuleIncludeNamespace = "includeNamespace"
+	filterRuleExcludeNamespace = "excludeNamespace"
+	filterRuleIncludeKind      = "includeKind"
+	filterRuleExcludeKind      = "excludeKind"
+)
+
+var (
+	metricIngressFilterHitcount  = promauto.NewCounterVec(prometheus.CounterOpts{Name: "sloop_ingress_filter_hitcount"}, []string{"kind", "namespace", "rule"})
+	metricIngressFilterMisscount = promauto.NewCounterVec(prometheus.CounterOpts{Name: "sloop_ingress_filter_misscount"}, []string{"kind", "namespace"})
+)
+
+type nameMatcher struct {
+	globs   []string
+	regexes []*regexp.Regexp
+}
+
+func newNameMatcher(patterns []string) (*nameMatcher, error) {
+	m := &nameMatcher{}
+	for _, pattern := range patterns {
+		if len(pattern) >= 2 && strings.HasPrefix(pattern, "/") && strings.HasSuffix(pattern, "/") {
+			re, err := regexp.Compile(pattern[1 : len(pattern)-1])
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid filter regex %v", pattern)
+			}
+			m.regexes = append(m.regexes, re)
+			continue
+		}
+		_, err := path.Match(pattern, "")
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid filter glob %v", pattern)
+		}
+		m.globs = append(m.globs, pattern)
+	}
+	return m, nil
+}
+
+func (m *nameMatcher) empty() bool {
+	return len(m.globs) == 0 && len(m.regexes) == 0
+}
+
+func (m *nameMatcher) matches(name string) bool {
+	for _, glob := range m.globs {
+		// Patterns were validated up front, so the only possible error is already ruled out
+		if ok, _ := path.Match(glob, name); ok {
+			return true
+		}
+	}
+	for _, re := range m.regexes {
+		if re.MatchString(name) {
+			return true
+		}
+	}
+	return false
+}
+
+type kubeWatchFilter struct {
+	includeNamespaces *nameMatcher
+	excludeNamespaces *nameMatcher
+	includeKinds      *nameMatcher
+	excludeKinds      *nameMatcher
+	includeGroups     *nameMatcher
+	excludeGroups     *nameMatcher
+	labelSelectors    map[string]string
+	fieldSelectors    map[string]string
+}
+
+func newKubeWatchFilter(config KubeWatchFilterConfig) (*kubeWatchFilter, error) {
+	var err error
+	f := &kubeWatchFilter{labelSelectors: config.LabelSelectors, fieldSelectors: config.FieldSelectors}
+	f.includeNamespaces, err = newNameMatcher(config.IncludeNamespaces)
+	if err != nil {
+		return nil, err
+	}
+	f.excludeNamespaces, err = newNameMatcher(config.ExcludeNamespaces)
+	if err != nil {
+		return nil, err
+	}
+	f.includeKinds, err = newNameMatcher(config.IncludeKinds)
+	if err != nil {
+		return nil, err
+	}
+	f.excludeKinds, err = newNameMatcher(config.ExcludeKinds)
+	if err != nil {
+		return nil, err
+	}
+	f.includeGroups, err = newNameMatcher(config.IncludeGroups)
+	if err != nil {
+		return nil, err
+	}
+	f.excludeGroups, err = newNameMatcher(config.ExcludeGroups)
+	if err != nil {
+		return nil, err
+	}
+	for kind, selector := range config.LabelSelectors {
+		_, err = labels.Parse(selector)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid label selector for kind %v", kind)
+		}
+	}
+	for kind, selector := range config.FieldSelectors {
+		_, err = fields.ParseSelector(selector)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid field selector for kind %v", kind)
+		}
+	}
+	return f, nil
+}
+
+// Returns the rule that rejects this kind, or "" if the kind is allowed.  A nil filter allows everything
+func (f *kubeWatchFilter) rejectKindRule(kind string) string {
+	if f == nil {
+		return ""
+	}
+	if f.excludeKinds.matches(kind) {
+		return filterRuleExcludeKind
+	}
+	if !f.includeKinds.empty() && !f.includeKinds.matches(kind) {
+		return filterRuleIncludeKind
+	}
+	return ""
+}
+
+func (f *kubeWatchFilter) kindAllowed(kind string) bool {
+	return f.rejectKindRule(kind) == ""
+}
+
+func (f *kubeWatchFilter) groupAllowed(group string) bool {
+	if f == nil {
+		return true
+	}
+	if group == "" {
+		group = coreGroupName
+	}
+	if f.excludeGroups.matches(group) {
+		return false
+	}
+	return f.includeGroups.empty() || f.includeGroups.matches(group)
+}
+
+func (f *kubeWatchFilter) rejectNamespaceRule(namespace string) string {
+	if f == nil || namespace == "" {
+		return ""
+	}
+	if f.excludeNamespaces.matches(namespace) {
+		return filterRuleExcludeNamespace
+	}
+	if !f.includeNamespaces.empty() && !f.includeNamespaces.matches(namespace) {
+		return filterRuleIncludeNamespace
+	}
+	return ""
+}
+
+// Decides if a watch update for obj should be ingested, and records the decision in the filter metrics
+func (f *kubeWatchFilter) allow(kind string, obj interface{}) bool {
+	if f == nil {
+		return true
+	}
+	namespace := filterNamespaceForObject(kind, obj)
+	rule := f.rejectKindRule(kind)
+	if rule == "" {
+		rule = f.rejectNamespaceRule(namespace)
+	}
+	if rule != "" {
+		metricIngressFilterHitcount.WithLabelValues(kind, namespace, rule).Inc()
+		return false
+	}
+	metricIngressFilterMisscount.WithLabelValues(kind, namespace).Inc()
+	return true
+}
+
+// Returns a function to add the configured selectors for this kind to list and watch calls, or nil if there are none
+func (f *kubeWatchFilter) tweakListOptions(kind string) func(*metav1.ListOptions) {
+	if f == nil {
+		return nil
+	}
+	labelSelector := f.labelSelectors[kind]
+	fieldSelector := f.fieldSelectors[kind]
+	if labelSelector == "" && fieldSelector == "" {
+		return nil
+	}
+	return func(options *metav1.ListOptions) {
+		options.LabelSelector = labelSelector
+		options.FieldSelector = fieldSelector
+	}
+}
+
+func filterNamespaceForObject(kind string, obj interface{}) string {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return ""
+	}
+	if kind == kubeextractor.NamespaceKind {
+		return accessor.GetName()
+	}
+	return accessor.GetNamespace()
+}
@@ -0,0 +1,111 @@
+/*
+ * Copyright (c) 2021, salesforce.com, inc.
+ * All rights reserved.
+ * SPDX-License-Identifier: BSD-3-Clause
+ * For full license text, see LICENSE.txt file in the repo root or https://opensource.org/licenses/BSD-3-Clause
+ */
+
+package ingress
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/salesforce/sloop/pkg/sloop/store/typed"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func helper_newFilter(t *testing.T, config KubeWatchFilterConfig) *kubeWatchFilter {
+	filter, err := newKubeWatchFilter(config)
+	assert.Nil(t, err)
+	return filter
+}
+
+func helper_pod(namespace string) *corev1.Pod {
+	return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p", Namespace: namespace}}
+}
+
+func Test_nameMatcher_GlobAndRegex(t *testing.T) {
+	m, err := newNameMatcher([]string{"kube-*", "/^istio-(system|ingress)$/"})
+	assert.Nil(t, err)
+	assert.True(t, m.matches("kube-system"))
+	assert.True(t, m.matches("istio-system"))
+	assert.False(t, m.matches("istio-other"))
+	assert.False(t, m.matches("default"))
+	assert.False(t, m.empty())
+}
+
+func Test_newKubeWatchFilter_InvalidPatterns(t *testing.T) {
+	_, err := newKubeWatchFilter(KubeWatchFilterConfig{IncludeNamespaces: []string{"[bad"}})
+	assert.NotNil(t, err)
+	_, err = newKubeWatchFilter(KubeWatchFilterConfig{ExcludeKinds: []string{"/(bad/"}})
+	assert.NotNil(t, err)
+	_, err = newKubeWatchFilter(KubeWatchFilterConfig{LabelSelectors: map[string]string{"Pod": "a=b=c"}})
+	assert.NotNil(t, err)
+	_, err = newKubeWatchFilter(KubeWatchFilterConfig{FieldSelectors: map[string]string{"Pod": "a"}})
+	assert.NotNil(t, err)
+}
+
+func Test_kubeWatchFilter_Namespaces(t *testing.T) {
+	filter := helper_newFilter(t, KubeWatchFilterConfig{IncludeNamespaces: []string{"team-*"}, ExcludeNamespaces: []string{"team-noisy"}})
+	assert.True(t, filter.allow("Pod", helper_pod("team-a")))
+	assert.False(t, filter.allow("Pod", helper_pod("team-noisy")))
+	assert.False(t, filter.allow("Pod", helper_pod("default")))
+	// Cluster scoped resources are not subject to namespace filters, but namespaces themselves are
+	assert.True(t, filter.allow("Node", &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "n"}}))
+	assert.True(t, filter.allow("Namespace", &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a"}}))
+	assert.False(t, filter.allow("Namespace", &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}}))
+}
+
+func Test_kubeWatchFilter_Kinds(t *testing.T) {
+	filter := helper_newFilter(t, KubeWatchFilterConfig{ExcludeKinds: []string{"Event", "/Endpoint.*/"}})
+	assert.True(t, filter.kindAllowed("Pod"))
+	assert.False(t, filter.kindAllowed("Event"))
+	assert.False(t, filter.kindAllowed("EndpointSlice"))
+
+	filter = helper_newFilter(t, KubeWatchFilterConfig{IncludeKinds: []string{"Pod", "Deployment"}, ExcludeKinds: []string{"Deployment"}})
+	assert.True(t, filter.kindAllowed("Pod"))
+	assert.False(t, filter.kindAllowed("Deployment"))
+	assert.False(t, filter.kindAllowed("Service"))
+}
+
+func Test_kubeWatchFilter_NilAllowsEverything(t *testing.T) {
+	var filter *kubeWatchFilter
+	assert.True(t, filter.kindAllowed("Pod"))
+	assert.True(t, filter.allow("Pod", helper_pod("default")))
+	assert.Nil(t, filter.tweakListOptions("Pod"))
+}
+
+func Test_kubeWatchFilter_TweakListOptions(t *testing.T) {
+	filter := helper_newFilter(t, KubeWatchFilterConfig{
+		LabelSelectors: map[string]string{"Pod": "app=web"},
+		FieldSelectors: map[string]string{"Pod": "status.phase!=Succeeded", "Event": "type=Warning"},
+	})
+	assert.Nil(t, filter.tweakListOptions("Service"))
+
+	options := metav1.ListOptions{}
+	filter.tweakListOptions("Pod")(&options)
+	assert.Equal(t, "app=web", options.LabelSelector)
+	assert.Equal(t, "status.phase!=Succeeded", options.FieldSelector)
+
+	options = metav1.ListOptions{}
+	filter.tweakListOptions("Event")(&options)
+	assert.Equal(t, "", options.LabelSelector)
+	assert.Equal(t, "type=Warning", options.FieldSelector)
+}
+
+func Test_processUpdate_FilteredOut(t *testing.T) {
+	outChan := make(chan typed.KubeWatchResult, 5)
+	filter := helper_newFilter(t, KubeWatchFilterConfig{ExcludeNamespaces: []string{"kube-system"}})
+	kw := &kubeWatcherImpl{protection: &sync.Mutex{}, outchan: outChan, filter: filter}
+
+	kw.processUpdate("Pod", helper_pod("kube-system"), &typed.KubeWatchResult{Kind: "Pod"}, false)
+	verifyChannelEmpty(t, outChan)
+
+	kw.processUpdate("Pod", helper_pod("default"), &typed.KubeWatchResult{Kind: "Pod"}, false)
+	result := <-outChan
+	assert.Equal(t, "Pod", result.Kind)
+	verifyChannelEmpty(t, outChan)
+}
@@ -0,0 +1,106 @@
+/*
+ * Copyright (c) 2021, salesforce.com, inc.
+ * All rights reserved.
+ * SPDX-License-Identifier: BSD-3-Clause
+ * For full license text, see LICENSE.txt file in the repo root or https://opensource.org/licenses/BSD-3-Clause
+ */
+
+package ingress
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/salesforce/sloop/pkg/sloop/kubeextractor"
+)
+
+// MinorUpdateRule names a part of a payload that changes all the time without anything happening, like a heartbeat.
+// When everything else is the same as in the last stored payload, the update is not stored again and only counts as
+// a watch with no change.  Path and MatchName work the same way as in RedactionRule.  metadata.resourceVersion
+// changes with every update, so it is always ignored for kinds that have a rule
+type MinorUpdateRule struct {
+	Kind      string `json:"kind"` // Glob.  Empty matches every kind
+	Path      string `json:"path"`
+	MatchName string `json:"matchName"`
+}
+
+// MatchesKind tells if the rule applies to kind, which takes the glob and the empty kind into account
+func (r *MinorUpdateRule) MatchesKind(kind string) bool {
+	pp := payloadPath{kind: r.Kind}
+	return pp.matchesKind(kind)
+}
+
+// Nodes update the heartbeat in each of their conditions every few seconds
+var DefaultMinorUpdateRules = []MinorUpdateRule{
+	{Kind: kubeextractor.NodeKind, Path: "status.conditions.*.lastHeartbeatTime"},
+}
+
+var resourceVersionPath = payloadPath{segments: []string{"metadata", "resourceVersion"}}
+
+type MinorUpdates struct {
+	paths []payloadPath
+}
+
+// NewMinorUpdates returns nil when there are no rules, and a nil MinorUpdates treats every update as major
+func NewMinorUpdates(rules []MinorUpdateRule) (*MinorUpdates, error) {
+	if len(rules) == 0 {
+		return nil, nil
+	}
+	m := &MinorUpdates{}
+	for _, rule := range rules {
+		pp, err := newPayloadPath(rule.Kind, rule.Path, rule.MatchName)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid minor update rule")
+		}
+		m.paths = append(m.paths, pp)
+	}
+	return m, nil
+}
+
+func (m *MinorUpdates) MatchesKind(kind string) bool {
+	if m == nil {
+		return false
+	}
+	for _, pp := range m.paths {
+		if pp.matchesKind(kind) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsMinorUpdate tells if payload only differs from prevPayload in the paths the rules for kind ignore
+func (m *MinorUpdates) IsMinorUpdate(kind string, prevPayload string, payload string) (bool, error) {
+	if !m.MatchesKind(kind) {
+		return false, nil
+	}
+	prevObj, err := m.withoutIgnoredPaths(kind, prevPayload)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to parse previous %v payload", kind)
+	}
+	obj, err := m.withoutIgnoredPaths(kind, payload)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to parse %v payload", kind)
+	}
+	return reflect.DeepEqual(prevObj, obj), nil
+}
+
+func (m *MinorUpdates) withoutIgnoredPaths(kind string, payload string) (interface{}, error) {
+	decoder := json.NewDecoder(strings.NewReader(payload))
+	decoder.UseNumber()
+	var obj interface{}
+	err := decoder.Decode(&obj)
+	if err != nil {
+		return nil, err
+	}
+	remove := func(interface{}) (interface{}, pathAction) { return nil, pathRemove }
+	resourceVersionPath.apply(obj, remove)
+	for _, pp := range m.paths {
+		if pp.matchesKind(kind) {
+			pp.apply(obj, remove)
+		}
+	}
+	return obj, nil
+}
@@ -0,0 +1,70 @@
+/*
+ * Copyright (c) 2021, salesforce.com, inc.
+ * All rights reserved.
+ * SPDX-License-Identifier: BSD-3-Clause
+ * For full license text, see LICENSE.txt file in the repo root or https://opensource.org/licenses/BSD-3-Clause
+ */
+
+package ingress
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func helper_nodePayload(resourceVersion string, heartbeat string, outOfDisk string) string {
+	return fmt.Sprintf(`{"metadata":{"name":"somehostname","uid":"1f9c4fdc","resourceVersion":%q},"status":{"conditions":[`+
+		`{"type":"OutOfDisk","status":%q,"lastHeartbeatTime":%q,"lastTransitionTime":"2019-07-19T15:35:56Z"},`+
+		`{"type":"MemoryPressure","status":"False","lastHeartbeatTime":%q,"lastTransitionTime":"2019-07-19T15:35:56Z"}]}}`,
+		resourceVersion, outOfDisk, heartbeat, heartbeat)
+}
+
+func helper_isMinorUpdate(t *testing.T, rules []MinorUpdateRule, kind string, prevPayload string, payload string) bool {
+	minorUpdates, err := NewMinorUpdates(rules)
+	assert.Nil(t, err)
+	minor, err := minorUpdates.IsMinorUpdate(kind, prevPayload, payload)
+	assert.Nil(t, err)
+	return minor
+}
+
+func Test_IsMinorUpdate_DefaultRulesForNodes(t *testing.T) {
+	node := helper_nodePayload("873691308", "2019-07-23T17:18:10Z", "False")
+	assert.True(t, helper_isMinorUpdate(t, DefaultMinorUpdateRules, "Node", node, node))
+	assert.True(t, helper_isMinorUpdate(t, DefaultMinorUpdateRules, "Node", node, helper_nodePayload("873691358", "2019-07-23T17:18:20Z", "False")))
+	assert.False(t, helper_isMinorUpdate(t, DefaultMinorUpdateRules, "Node", node, helper_nodePayload("873691308", "2019-07-23T17:18:10Z", "True")))
+
+	// Other kinds have no rule, so even a new resource version alone is a change
+	pod := `{"metadata":{"name":"p","resourceVersion":"1"}}`
+	assert.False(t, helper_isMinorUpdate(t, DefaultMinorUpdateRules, "Pod", pod, `{"metadata":{"name":"p","resourceVersion":"2"}}`))
+}
+
+func Test_IsMinorUpdate_CustomRules(t *testing.T) {
+	rules := []MinorUpdateRule{
+		{Kind: "Lease", Path: "spec.renewTime"},
+		{Kind: "Endpoint", Path: "metadata.annotations.*", MatchName: `^control-plane\.alpha\.kubernetes\.io/leader$`},
+	}
+	lease := `{"metadata":{"name":"l","resourceVersion":"1"},"spec":{"holderIdentity":"a","renewTime":"2021-01-01T00:00:00.000000Z"}}`
+	assert.True(t, helper_isMinorUpdate(t, rules, "Lease", lease, `{"metadata":{"name":"l","resourceVersion":"2"},"spec":{"holderIdentity":"a","renewTime":"2021-01-01T00:00:02.000000Z"}}`))
+	assert.False(t, helper_isMinorUpdate(t, rules, "Lease", lease, `{"metadata":{"name":"l","resourceVersion":"3"},"spec":{"holderIdentity":"b","renewTime":"2021-01-01T00:00:04.000000Z"}}`))
+
+	endpoints := `{"metadata":{"name":"e","resourceVersion":"1","annotations":{"control-plane.alpha.kubernetes.io/leader":"{\"renewTime\":\"1\"}","owner":"a"}}}`
+	assert.True(t, helper_isMinorUpdate(t, rules, "Endpoint", endpoints, `{"metadata":{"name":"e","resourceVersion":"2","annotations":{"control-plane.alpha.kubernetes.io/leader":"{\"renewTime\":\"2\"}","owner":"a"}}}`))
+	assert.False(t, helper_isMinorUpdate(t, rules, "Endpoint", endpoints, `{"metadata":{"name":"e","resourceVersion":"2","annotations":{"control-plane.alpha.kubernetes.io/leader":"{\"renewTime\":\"2\"}","owner":"b"}}}`))
+}
+
+func Test_NewMinorUpdates(t *testing.T) {
+	minorUpdates, err := NewMinorUpdates(nil)
+	assert.Nil(t, err)
+	assert.Nil(t, minorUpdates)
+	assert.False(t, minorUpdates.MatchesKind("Node"))
+
+	_, err = NewMinorUpdates([]MinorUpdateRule{{Kind: "Lease"}})
+	assert.NotNil(t, err)
+
+	minorUpdates, err = NewMinorUpdates(DefaultMinorUpdateRules)
+	assert.Nil(t, err)
+	_, err = minorUpdates.IsMinorUpdate("Node", "{", "{}")
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,181 @@
+/*
+ * Copyright (c) 2021, salesforce.com, inc.
+ * All rights reserved.
+ * SPDX-License-Identifier: BSD-3-Clause
+ * For full license text, see LICENSE.txt file in the repo root or https://opensource.org/licenses/BSD-3-Clause
+ */
+
+package ingress
+
+import (
+	"bytes"
+	"encoding/json"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/salesforce/sloop/pkg/sloop/store/typed"
+)
+
+// payloadPath selects values inside a kubernetes object decoded from json.  It is shared by pruning and redaction.
+// The path is dotted, and * matches every key of a map or every item of a list.  matchName optionally narrows the
+// selection: when the path ends in * it is matched against the map key, otherwise against the name field next to
+// the value (which is how env vars are matched by name)
+type payloadPath struct {
+	kind      string // Glob.  Empty matches every kind
+	segments  []string
+	matchName *regexp.Regexp
+}
+
+type pathAction int
+
+const (
+	pathKeep pathAction = iota
+	pathReplace
+	pathRemove // Only supported for object fields
+)
+
+func newPayloadPath(kind string, dottedPath string, matchName string) (payloadPath, error) {
+	p := payloadPath{kind: kind, segments: strings.Split(dottedPath, ".")}
+	if dottedPath == "" {
+		return p, errors.Errorf("rule for kind %q has no path", kind)
+	}
+	if _, err := path.Match(kind, ""); err != nil {
+		return p, errors.Wrapf(err, "invalid kind glob %q", kind)
+	}
+	if matchName != "" {
+		re, err := regexp.Compile(matchName)
+		if err != nil {
+			return p, errors.Wrapf(err, "invalid matchName for %v", dottedPath)
+		}
+		p.matchName = re
+	}
+	return p, nil
+}
+
+func (p *payloadPath) matchesKind(kind string) bool {
+	if p.kind == "" {
+		return true
+	}
+	ok, _ := path.Match(p.kind, kind)
+	return ok
+}
+
+// Calls visit for each selected value and applies what it returns.  Returns the number of values replaced or removed
+func (p *payloadPath) apply(obj interface{}, visit func(value interface{}) (interface{}, pathAction)) int {
+	return p.applySegments(obj, p.segments, visit)
+}
+
+func (p *payloadPath) applySegments(obj interface{}, segments []string, visit func(value interface{}) (interface{}, pathAction)) int {
+	if len(segments) == 0 {
+		return 0
+	}
+	seg := segments[0]
+	last := len(segments) == 1
+	count := 0
+	switch node := obj.(type) {
+	case map[string]interface{}:
+		for key, child := range node {
+			if seg != "*" && seg != key {
+				continue
+			}
+			if !last {
+				count += p.applySegments(child, segments[1:], visit)
+				continue
+			}
+			if p.matchName != nil {
+				name := key
+				if seg != "*" {
+					name, _ = node["name"].(string)
+				}
+				if !p.matchName.MatchString(name) {
+					continue
+				}
+			}
+			newValue, action := visit(child)
+			switch action {
+			case pathReplace:
+				node[key] = newValue
+				count++
+			case pathRemove:
+				delete(node, key)
+				count++
+			}
+		}
+	case []interface{}:
+		if seg != "*" {
+			return 0
+		}
+		for idx, child := range node {
+			if !last {
+				count += p.applySegments(child, segments[1:], visit)
+				continue
+			}
+			if p.matchName != nil {
+				childObj, _ := child.(map[string]interface{})
+				name, _ := childObj["name"].(string)
+				if !p.matchName.MatchString(name) {
+					continue
+				}
+			}
+			newValue, action := visit(child)
+			if action == pathReplace {
+				node[idx] = newValue
+				count++
+			}
+		}
+	}
+	return count
+}
+
+// Something that rewrites parts of a decoded payload.  apply returns the number of values it changed
+type payloadRewriter interface {
+	matchesKind(kind string) bool
+	apply(kind string, obj interface{}) int
+}
+
+// Decodes rec.Payload once, runs every rewriter that applies to its kind, and writes the payload back only if
+// something changed, so untouched records keep their original formatting.  Returns the change count per rewriter
+func rewritePayload(rec *typed.KubeWatchResult, rewriters ...payloadRewriter) ([]int, error) {
+	counts := make([]int, len(rewriters))
+	any := false
+	for _, rw := range rewriters {
+		if rw != nil && rw.matchesKind(rec.Kind) {
+			any = true
+		}
+	}
+	if !any || rec.Payload == "" {
+		return counts, nil
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(rec.Payload))
+	// Keep numbers exactly as they were instead of turning them into float64
+	decoder.UseNumber()
+	var obj interface{}
+	err := decoder.Decode(&obj)
+	if err != nil {
+		return counts, errors.Wrapf(err, "failed to parse %v payload", rec.Kind)
+	}
+
+	total := 0
+	for idx, rw := range rewriters {
+		if rw != nil && rw.matchesKind(rec.Kind) {
+			counts[idx] = rw.apply(rec.Kind, obj)
+			total += counts[idx]
+		}
+	}
+	if total == 0 {
+		return counts, nil
+	}
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	err = encoder.Encode(obj)
+	if err != nil {
+		return counts, errors.Wrapf(err, "failed to write %v payload", rec.Kind)
+	}
+	rec.Payload = strings.TrimSuffix(buf.String(), "\n")
+	return counts, nil
+}
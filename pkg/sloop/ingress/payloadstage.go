@@ -0,0 +1,42 @@
+/*
+ * Copyright (c) 2021, salesforce.com, inc.
+ * All rights reserved.
+ * SPDX-License-Identifier: BSD-3-Clause
+ * For full license text, see LICENSE.txt file in the repo root or https://opensource.org/licenses/BSD-3-Clause
+ */
+
+package ingress
+
+import (
+	"github.com/golang/glog"
+	"github.com/salesforce/sloop/pkg/sloop/store/typed"
+)
+
+// RunPayloadStage copies watch results from inChan to outChan, pruning and then redacting payloads on the way.
+// Either can be nil.  It closes outChan once inChan is closed, so shutdown order is unchanged for whoever reads
+// outChan.  Records that fail to parse are dropped rather than risk storing them unredacted
+func RunPayloadStage(pruner *Pruner, redactor *Redactor, inChan chan typed.KubeWatchResult, outChan chan typed.KubeWatchResult) {
+	defer close(outChan)
+	for rec := range inChan {
+		err := processPayload(pruner, redactor, &rec)
+		if err != nil {
+			glog.Errorf("Dropping watch result: %v", err)
+			continue
+		}
+		outChan <- rec
+	}
+}
+
+func processPayload(pruner *Pruner, redactor *Redactor, rec *typed.KubeWatchResult) error {
+	beforeBytes := len(rec.Payload)
+	counts, err := rewritePayload(rec, pruner, redactor)
+	if err != nil {
+		return err
+	}
+	if pruner.matchesKind(rec.Kind) {
+		metricIngressKubewatchbytesBeforePrune.WithLabelValues(rec.Kind).Add(float64(beforeBytes))
+		metricIngressKubewatchbytesAfterPrune.WithLabelValues(rec.Kind).Add(float64(len(rec.Payload)))
+	}
+	redactor.recordMetric(rec.Kind, "ingest", counts[1])
+	return nil
+}
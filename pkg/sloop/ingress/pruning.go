@@ -0,0 +1,76 @@
+/*
+ * Copyright (c) 2021, salesforce.com, inc.
+ * All rights reserved.
+ * SPDX-License-Identifier: BSD-3-Clause
+ * For full license text, see LICENSE.txt file in the repo root or https://opensource.org/licenses/BSD-3-Clause
+ */
+
+package ingress
+
+import (
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// PruningRule removes parts of payloads we never look at before they are stored.  Path and MatchName work the same
+// way as in RedactionRule, except the path has to end at an object field because that is what gets deleted
+type PruningRule struct {
+	Kind      string `json:"kind"` // Glob.  Empty matches every kind
+	Path      string `json:"path"`
+	MatchName string `json:"matchName"`
+}
+
+// managedFields and the last-applied annotation are usually most of the bytes in a payload, and nothing in sloop
+// reads them
+var DefaultPruningRules = []PruningRule{
+	{Path: "metadata.managedFields"},
+	{Path: "metadata.annotations.*", MatchName: `^kubectl\.kubernetes\.io/last-applied-configuration$`},
+}
+
+var (
+	metricIngressKubewatchbytesBeforePrune = promauto.NewCounterVec(prometheus.CounterOpts{Name: "sloop_ingress_kubewatchbytes_beforeprune"}, []string{"kind"})
+	metricIngressKubewatchbytesAfterPrune  = promauto.NewCounterVec(prometheus.CounterOpts{Name: "sloop_ingress_kubewatchbytes_afterprune"}, []string{"kind"})
+)
+
+type Pruner struct {
+	paths []payloadPath
+}
+
+// NewPruner returns nil when there are no rules, and a nil Pruner leaves payloads alone
+func NewPruner(rules []PruningRule) (*Pruner, error) {
+	if len(rules) == 0 {
+		return nil, nil
+	}
+	p := &Pruner{}
+	for _, rule := range rules {
+		pp, err := newPayloadPath(rule.Kind, rule.Path, rule.MatchName)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid pruning rule")
+		}
+		p.paths = append(p.paths, pp)
+	}
+	return p, nil
+}
+
+func (p *Pruner) matchesKind(kind string) bool {
+	if p == nil {
+		return false
+	}
+	for _, pp := range p.paths {
+		if pp.matchesKind(kind) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *Pruner) apply(kind string, obj interface{}) int {
+	count := 0
+	for _, pp := range p.paths {
+		if pp.matchesKind(kind) {
+			count += pp.apply(obj, func(interface{}) (interface{}, pathAction) { return nil, pathRemove })
+		}
+	}
+	return count
+}
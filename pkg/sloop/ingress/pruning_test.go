@@ -0,0 +1,68 @@
+/*
+ * Copyright (c) 2021, salesforce.com, inc.
+ * All rights reserved.
+ * SPDX-License-Identifier: BSD-3-Clause
+ * For full license text, see LICENSE.txt file in the repo root or https://opensource.org/licenses/BSD-3-Clause
+ */
+
+package ingress
+
+import (
+	"testing"
+
+	"github.com/salesforce/sloop/pkg/sloop/store/typed"
+	"github.com/stretchr/testify/assert"
+)
+
+const someManagedPodPayload = `{"metadata":{"name":"p","annotations":{"kubectl.kubernetes.io/last-applied-configuration":"{}","owner":"team-a"},"managedFields":[{"manager":"kubectl"}]},"spec":{"nodeName":"n1"}}`
+
+func helper_prune(t *testing.T, rules []PruningRule, kind string, payload string) string {
+	pruner, err := NewPruner(rules)
+	assert.Nil(t, err)
+	rec := &typed.KubeWatchResult{Kind: kind, Payload: payload}
+	assert.Nil(t, processPayload(pruner, nil, rec))
+	return rec.Payload
+}
+
+func Test_Prune_DefaultRules(t *testing.T) {
+	payload := helper_prune(t, DefaultPruningRules, "Pod", someManagedPodPayload)
+	assert.Equal(t, `{"metadata":{"annotations":{"owner":"team-a"},"name":"p"},"spec":{"nodeName":"n1"}}`, payload)
+}
+
+func Test_Prune_KindAndNothingToPrune(t *testing.T) {
+	rules := []PruningRule{{Kind: "Node", Path: "status.images"}}
+	assert.Equal(t, someManagedPodPayload, helper_prune(t, rules, "Pod", someManagedPodPayload))
+	assert.Equal(t, `{ "status": {} }`, helper_prune(t, rules, "Node", `{ "status": {} }`))
+	assert.Equal(t, `{"status":{}}`, helper_prune(t, rules, "Node", `{"status":{"images":[{"names":["a"]}]}}`))
+}
+
+func Test_NewPruner_Invalid(t *testing.T) {
+	pruner, err := NewPruner(nil)
+	assert.Nil(t, err)
+	assert.Nil(t, pruner)
+
+	_, err = NewPruner([]PruningRule{{Kind: "Pod"}})
+	assert.NotNil(t, err)
+	_, err = NewPruner([]PruningRule{{Kind: "[", Path: "metadata.managedFields"}})
+	assert.NotNil(t, err)
+}
+
+func Test_RunPayloadStage_PruneThenRedact(t *testing.T) {
+	pruner, err := NewPruner(DefaultPruningRules)
+	assert.Nil(t, err)
+	redactor, err := NewRedactor(DefaultRedactionRules)
+	assert.Nil(t, err)
+	inChan := make(chan typed.KubeWatchResult, 2)
+	outChan := make(chan typed.KubeWatchResult, 2)
+	inChan <- typed.KubeWatchResult{Kind: "Secret", Payload: someSecretPayload}
+	close(inChan)
+
+	RunPayloadStage(pruner, redactor, inChan, outChan)
+
+	rec := <-outChan
+	assert.NotContains(t, rec.Payload, "last-applied-configuration")
+	assert.NotContains(t, rec.Payload, "aHVudGVyMg==")
+	assert.Contains(t, rec.Payload, `"owner":"team-a"`)
+	_, open := <-outChan
+	assert.False(t, open)
+}
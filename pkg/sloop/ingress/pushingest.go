@@ -0,0 +1,282 @@
+/*
+ * Copyright (c) 2021, salesforce.com, inc.
+ * All rights reserved.
+ * SPDX-License-Identifier: BSD-3-Clause
+ * For full license text, see LICENSE.txt file in the repo root or https://opensource.org/licenses/BSD-3-Clause
+ */
+
+package ingress
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"mime"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/salesforce/sloop/pkg/sloop/common"
+	"github.com/salesforce/sloop/pkg/sloop/store/typed"
+	"golang.org/x/time/rate"
+)
+
+const (
+	PushContentTypeNdjson   = "application/x-ndjson"
+	PushContentTypeProtobuf = "application/x-protobuf"
+	defaultPushBurst        = 1000
+	maxPushBatchBytes       = 64 * 1024 * 1024
+)
+
+var (
+	metricIngressPushRecords  = promauto.NewCounterVec(prometheus.CounterOpts{Name: "sloop_ingress_push_records"}, []string{"source", "context"})
+	metricIngressPushRejected = promauto.NewCounterVec(prometheus.CounterOpts{Name: "sloop_ingress_push_rejected"}, []string{"source", "reason"})
+)
+
+// PushSource is a remote agent that is allowed to send watch results to the ingest endpoint
+type PushSource struct {
+	Name          string   `json:"name"`
+	Token         string   `json:"token"`
+	TokenFile     string   `json:"tokenFile"`     // Read once at startup, and used instead of Token
+	Contexts      []string `json:"contexts"`      // Clusters it may send to.  Empty allows all of them
+	RecordsPerSec float64  `json:"recordsPerSec"` // 0 = no limit
+	Burst         int      `json:"burst"`         // Also the largest batch we take from it.  0 = 1000
+}
+
+// PushAck is the response to a batch.  Records are acknowledged once they are on the processing queue
+type PushAck struct {
+	Accepted int `json:"accepted"`
+}
+
+type pushSource struct {
+	name     string
+	token    []byte
+	contexts map[string]bool
+	limiter  *rate.Limiter
+	// Checked on its own, because a limiter without a rate lets any batch through
+	maxBatch int
+}
+
+// PushSources authenticates and rate limits push ingestion.  Limits are per source across every cluster it sends to
+type PushSources struct {
+	sources []*pushSource
+}
+
+// NewPushSources returns nil when there are no sources, which leaves the ingest endpoint turned off
+func NewPushSources(sources []PushSource) (*PushSources, error) {
+	if len(sources) == 0 {
+		return nil, nil
+	}
+	ret := &PushSources{}
+	seen := map[string]bool{}
+	for _, source := range sources {
+		if source.Name == "" || seen[source.Name] {
+			return nil, fmt.Errorf("every push source needs a unique name, got %q", source.Name)
+		}
+		seen[source.Name] = true
+		token := source.Token
+		if source.TokenFile != "" {
+			data, err := ioutil.ReadFile(source.TokenFile)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to read token for push source %v", source.Name)
+			}
+			token = strings.TrimSpace(string(data))
+		}
+		if token == "" {
+			return nil, fmt.Errorf("push source %v has no token", source.Name)
+		}
+		if source.RecordsPerSec < 0 || source.Burst < 0 {
+			return nil, fmt.Errorf("push source %v can not have a negative rate limit", source.Name)
+		}
+
+		ps := &pushSource{name: source.Name, token: []byte(token), contexts: map[string]bool{}}
+		for _, ctx := range source.Contexts {
+			ps.contexts[ctx] = true
+		}
+		limit := rate.Inf
+		if source.RecordsPerSec > 0 {
+			limit = rate.Limit(source.RecordsPerSec)
+		}
+		burst := source.Burst
+		if burst == 0 {
+			burst = defaultPushBurst
+		}
+		ps.limiter = rate.NewLimiter(limit, burst)
+		ps.maxBatch = burst
+		ret.sources = append(ret.sources, ps)
+	}
+	return ret, nil
+}
+
+// Compares against every token so the time taken does not give away which one was close
+func (s *PushSources) authenticate(request *http.Request) *pushSource {
+	auth := request.Header.Get("Authorization")
+	if !strings.HasPrefix(auth, "Bearer ") {
+		return nil
+	}
+	token := []byte(strings.TrimPrefix(auth, "Bearer "))
+	var found *pushSource
+	for _, source := range s.sources {
+		if subtle.ConstantTimeCompare(token, source.token) == 1 {
+			found = source
+		}
+	}
+	return found
+}
+
+// PushReceiver serves the ingest endpoint for one cluster.  Batches are decoded and checked in full before anything
+// goes on outChan, so a batch is either acknowledged as a whole or can be retried as a whole
+type PushReceiver struct {
+	sources *PushSources
+	context string
+	outChan chan typed.KubeWatchResult
+	// Held for reading while a batch is written to outChan, so Stop can make sure nothing is written after it
+	protection *sync.RWMutex
+	stopped    bool
+}
+
+func NewPushReceiver(sources *PushSources, context string, outChan chan typed.KubeWatchResult) *PushReceiver {
+	return &PushReceiver{sources: sources, context: context, outChan: outChan, protection: &sync.RWMutex{}}
+}
+
+func (p *PushReceiver) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	p.serve(writer, request, decodePushBatch)
+}
+
+// ServeAudit takes audit event lists from the webhook backend of an apiserver.  Point it at /<context>/audit with a
+// kubeconfig that has the token of a push source.  Events that do not stand for a watch result are skipped
+func (p *PushReceiver) ServeAudit(writer http.ResponseWriter, request *http.Request) {
+	p.serve(writer, request, decodeAuditBatch)
+}
+
+func (p *PushReceiver) serve(writer http.ResponseWriter, request *http.Request, decode func(http.ResponseWriter, *http.Request) ([]typed.KubeWatchResult, error)) {
+	if request.Method != http.MethodPost {
+		writer.Header().Set("Allow", http.MethodPost)
+		http.Error(writer, "use POST", http.StatusMethodNotAllowed)
+		return
+	}
+	source := p.sources.authenticate(request)
+	if source == nil {
+		p.reject(writer, "unknown", "unauthorized", http.StatusUnauthorized, "missing or unknown bearer token")
+		return
+	}
+	if len(source.contexts) > 0 && !source.contexts[p.context] {
+		p.reject(writer, source.name, "forbidden", http.StatusForbidden, fmt.Sprintf("source %v may not send to %v", source.name, p.context))
+		return
+	}
+
+	batch, err := decode(writer, request)
+	if err != nil {
+		p.reject(writer, source.name, "badrequest", http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if len(batch) > source.maxBatch {
+		p.reject(writer, source.name, "toolarge", http.StatusRequestEntityTooLarge, fmt.Sprintf("batch of %v is more than the burst of %v", len(batch), source.maxBatch))
+		return
+	}
+	now := time.Now()
+	reservation := source.limiter.ReserveN(now, len(batch))
+	if delay := reservation.DelayFrom(now); delay > 0 {
+		reservation.CancelAt(now)
+		writer.Header().Set("Retry-After", fmt.Sprintf("%v", int(math.Ceil(delay.Seconds()))))
+		p.reject(writer, source.name, "ratelimited", http.StatusTooManyRequests, "rate limit exceeded")
+		return
+	}
+
+	accepted, err := p.send(request, batch)
+	metricIngressPushRecords.WithLabelValues(source.name, p.context).Add(float64(accepted))
+	glog.V(common.GlogVerbose).Infof("Push source %v sent %v of %v records to %v", source.name, accepted, len(batch), p.context)
+	if err != nil {
+		p.reject(writer, source.name, "unavailable", http.StatusServiceUnavailable, err.Error())
+		return
+	}
+	writer.Header().Set("content-type", "application/json")
+	json.NewEncoder(writer).Encode(PushAck{Accepted: accepted})
+}
+
+func (p *PushReceiver) send(request *http.Request, batch []typed.KubeWatchResult) (int, error) {
+	p.protection.RLock()
+	defer p.protection.RUnlock()
+	if p.stopped {
+		return 0, fmt.Errorf("shutting down")
+	}
+	for idx := range batch {
+		select {
+		case p.outChan <- batch[idx]:
+		case <-request.Context().Done():
+			return idx, fmt.Errorf("request cancelled after %v records", idx)
+		}
+	}
+	return len(batch), nil
+}
+
+// Stop waits for batches in flight and turns away new ones.  Call it before closing outChan
+func (p *PushReceiver) Stop() {
+	p.protection.Lock()
+	defer p.protection.Unlock()
+	p.stopped = true
+}
+
+func (p *PushReceiver) reject(writer http.ResponseWriter, source string, reason string, code int, message string) {
+	metricIngressPushRejected.WithLabelValues(source, reason).Inc()
+	glog.V(common.GlogVerbose).Infof("Rejected push to %v from %v: %v", p.context, source, message)
+	http.Error(writer, message, code)
+}
+
+func decodePushBatch(writer http.ResponseWriter, request *http.Request) ([]typed.KubeWatchResult, error) {
+	mediaType, _, _ := mime.ParseMediaType(request.Header.Get("Content-Type"))
+	var format string
+	switch mediaType {
+	case PushContentTypeNdjson, "application/json":
+		format = RecordFormatNdjson
+	case PushContentTypeProtobuf:
+		format = RecordFormatProtobuf
+	default:
+		return nil, fmt.Errorf("unsupported content type %q.  Use %v or %v", mediaType, PushContentTypeNdjson, PushContentTypeProtobuf)
+	}
+
+	body := http.MaxBytesReader(writer, request.Body, maxPushBatchBytes)
+	decoder, err := newRecordDecoderForFormat("push batch", body, format)
+	if err != nil {
+		return nil, err
+	}
+	now, _ := ptypes.TimestampProto(time.Now())
+	var batch []typed.KubeWatchResult
+	for {
+		rec, err := decoder.Decode()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to decode record %v", len(batch))
+		}
+		if rec.Kind == "" || rec.Payload == "" {
+			return nil, fmt.Errorf("record %v needs a kind and a payload", len(batch))
+		}
+		if rec.Timestamp == nil {
+			rec.Timestamp = now
+		}
+		batch = append(batch, *rec)
+	}
+	return batch, nil
+}
+
+func decodeAuditBatch(writer http.ResponseWriter, request *http.Request) ([]typed.KubeWatchResult, error) {
+	body := http.MaxBytesReader(writer, request.Body, maxPushBatchBytes)
+	var events auditEventList
+	err := json.NewDecoder(body).Decode(&events)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode audit event list")
+	}
+	return auditEventsToWatchResults(events.Items), nil
+}
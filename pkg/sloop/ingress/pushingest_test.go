@@ -0,0 +1,143 @@
+/*
+ * Copyright (c) 2021, salesforce.com, inc.
+ * All rights reserved.
+ * SPDX-License-Identifier: BSD-3-Clause
+ * For full license text, see LICENSE.txt file in the repo root or https://opensource.org/licenses/BSD-3-Clause
+ */
+
+package ingress
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/salesforce/sloop/pkg/sloop/store/typed"
+	"github.com/stretchr/testify/assert"
+)
+
+func helper_encodeBatch(t *testing.T, format string, recs []typed.KubeWatchResult) []byte {
+	encoder, err := newRecordEncoder(format)
+	assert.Nil(t, err)
+	var buf bytes.Buffer
+	for idx := range recs {
+		_, err := encoder.Encode(&buf, &recs[idx])
+		assert.Nil(t, err)
+	}
+	return buf.Bytes()
+}
+
+func helper_push(receiver *PushReceiver, token string, contentType string, body []byte) *httptest.ResponseRecorder {
+	request := httptest.NewRequest(http.MethodPost, "/ctx1/ingest", bytes.NewReader(body))
+	request.Header.Set("Content-Type", contentType)
+	if token != "" {
+		request.Header.Set("Authorization", "Bearer "+token)
+	}
+	response := httptest.NewRecorder()
+	receiver.ServeHTTP(response, request)
+	return response
+}
+
+func helper_newPushReceiver(t *testing.T, sources []PushSource, bufferSize int) (*PushReceiver, chan typed.KubeWatchResult) {
+	pushSources, err := NewPushSources(sources)
+	assert.Nil(t, err)
+	outChan := make(chan typed.KubeWatchResult, bufferSize)
+	return NewPushReceiver(pushSources, "ctx1", outChan), outChan
+}
+
+func helper_readChan(outChan chan typed.KubeWatchResult, count int) []typed.KubeWatchResult {
+	var recs []typed.KubeWatchResult
+	for i := 0; i < count; i++ {
+		recs = append(recs, <-outChan)
+	}
+	return recs
+}
+
+func Test_PushReceiver_AcceptsNdjsonAndProtobuf(t *testing.T) {
+	receiver, outChan := helper_newPushReceiver(t, []PushSource{{Name: "agent", Token: "secret"}}, 10)
+	recs := helper_makeRecords(t, 3)
+
+	for contentType, format := range map[string]string{PushContentTypeNdjson: RecordFormatNdjson, PushContentTypeProtobuf: RecordFormatProtobuf} {
+		response := helper_push(receiver, "secret", contentType, helper_encodeBatch(t, format, recs))
+		assert.Equal(t, http.StatusOK, response.Code, contentType)
+		var ack PushAck
+		assert.Nil(t, json.Unmarshal(response.Body.Bytes(), &ack))
+		assert.Equal(t, 3, ack.Accepted)
+		helper_assertRecordsEqual(t, recs, helper_readChan(outChan, 3))
+	}
+}
+
+func Test_PushReceiver_Auth(t *testing.T) {
+	sources := []PushSource{{Name: "agent", Token: "secret"}, {Name: "other", Token: "other-secret", Contexts: []string{"ctx2"}}}
+	receiver, outChan := helper_newPushReceiver(t, sources, 10)
+	body := helper_encodeBatch(t, RecordFormatNdjson, helper_makeRecords(t, 1))
+
+	assert.Equal(t, http.StatusUnauthorized, helper_push(receiver, "", PushContentTypeNdjson, body).Code)
+	assert.Equal(t, http.StatusUnauthorized, helper_push(receiver, "wrong", PushContentTypeNdjson, body).Code)
+	assert.Equal(t, http.StatusForbidden, helper_push(receiver, "other-secret", PushContentTypeNdjson, body).Code)
+	assert.Len(t, outChan, 0)
+}
+
+func Test_PushReceiver_RejectsBadBatchWhole(t *testing.T) {
+	receiver, outChan := helper_newPushReceiver(t, []PushSource{{Name: "agent", Token: "secret"}}, 10)
+	recs := helper_makeRecords(t, 3)
+	recs[2].Kind = ""
+
+	assert.Equal(t, http.StatusBadRequest, helper_push(receiver, "secret", PushContentTypeNdjson, helper_encodeBatch(t, RecordFormatNdjson, recs)).Code)
+	assert.Equal(t, http.StatusBadRequest, helper_push(receiver, "secret", "text/plain", []byte("hello")).Code)
+	assert.Len(t, outChan, 0)
+}
+
+func Test_PushReceiver_RateLimit(t *testing.T) {
+	receiver, outChan := helper_newPushReceiver(t, []PushSource{{Name: "agent", Token: "secret", RecordsPerSec: 0.001, Burst: 3}}, 10)
+	body := helper_encodeBatch(t, RecordFormatNdjson, helper_makeRecords(t, 2))
+
+	assert.Equal(t, http.StatusOK, helper_push(receiver, "secret", PushContentTypeNdjson, body).Code)
+	response := helper_push(receiver, "secret", PushContentTypeNdjson, body)
+	assert.Equal(t, http.StatusTooManyRequests, response.Code)
+	assert.NotEmpty(t, response.Header().Get("Retry-After"))
+	// A refused batch does not use up the tokens, so a smaller one still fits
+	small := helper_encodeBatch(t, RecordFormatNdjson, helper_makeRecords(t, 1))
+	assert.Equal(t, http.StatusOK, helper_push(receiver, "secret", PushContentTypeNdjson, small).Code)
+	assert.Len(t, outChan, 3)
+
+	big := helper_encodeBatch(t, RecordFormatNdjson, helper_makeRecords(t, 4))
+	assert.Equal(t, http.StatusRequestEntityTooLarge, helper_push(receiver, "secret", PushContentTypeNdjson, big).Code)
+}
+
+func Test_PushReceiver_UnlimitedRateStillLimitsBatch(t *testing.T) {
+	receiver, outChan := helper_newPushReceiver(t, []PushSource{{Name: "agent", Token: "secret", Burst: 3}}, 10)
+
+	for i := 0; i < 3; i++ {
+		body := helper_encodeBatch(t, RecordFormatNdjson, helper_makeRecords(t, 3))
+		assert.Equal(t, http.StatusOK, helper_push(receiver, "secret", PushContentTypeNdjson, body).Code)
+	}
+	assert.Len(t, outChan, 9)
+
+	big := helper_encodeBatch(t, RecordFormatNdjson, helper_makeRecords(t, 4))
+	assert.Equal(t, http.StatusRequestEntityTooLarge, helper_push(receiver, "secret", PushContentTypeNdjson, big).Code)
+	assert.Len(t, outChan, 9)
+}
+
+func Test_PushReceiver_Stopped(t *testing.T) {
+	receiver, outChan := helper_newPushReceiver(t, []PushSource{{Name: "agent", Token: "secret"}}, 10)
+	receiver.Stop()
+	body := helper_encodeBatch(t, RecordFormatNdjson, helper_makeRecords(t, 1))
+	assert.Equal(t, http.StatusServiceUnavailable, helper_push(receiver, "secret", PushContentTypeNdjson, body).Code)
+	assert.Len(t, outChan, 0)
+}
+
+func Test_NewPushSources(t *testing.T) {
+	sources, err := NewPushSources(nil)
+	assert.Nil(t, err)
+	assert.Nil(t, sources)
+
+	_, err = NewPushSources([]PushSource{{Name: "agent"}})
+	assert.NotNil(t, err)
+	_, err = NewPushSources([]PushSource{{Name: "agent", Token: "a"}, {Name: "agent", Token: "b"}})
+	assert.NotNil(t, err)
+	_, err = NewPushSources([]PushSource{{Name: "agent", TokenFile: "/does/not/exist"}})
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,223 @@
+/*
+ * Copyright (c) 2021, salesforce.com, inc.
+ * All rights reserved.
+ * SPDX-License-Identifier: BSD-3-Clause
+ * For full license text, see LICENSE.txt file in the repo root or https://opensource.org/licenses/BSD-3-Clause
+ */
+
+package ingress
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	"github.com/salesforce/sloop/pkg/sloop/store/typed"
+)
+
+const gzipExtension = ".gz"
+
+// Protect playback from a corrupt length prefix asking us to allocate a huge buffer
+const maxProtobufRecordBytes = 256 * 1024 * 1024
+
+type recordEncoder interface {
+	Encode(w io.Writer, rec *typed.KubeWatchResult) (int, error)
+}
+
+type recordDecoder interface {
+	// Returns io.EOF when there are no more records
+	Decode() (*typed.KubeWatchResult, error)
+}
+
+func newRecordEncoder(format string) (recordEncoder, error) {
+	switch format {
+	case RecordFormatNdjson:
+		return &ndjsonEncoder{marshaler: &jsonpb.Marshaler{}}, nil
+	case RecordFormatProtobuf:
+		return &protobufEncoder{}, nil
+	default:
+		return nil, fmt.Errorf("unsupported record format %q.  Use %v or %v", format, RecordFormatNdjson, RecordFormatProtobuf)
+	}
+}
+
+type ndjsonEncoder struct {
+	marshaler *jsonpb.Marshaler
+}
+
+func (e *ndjsonEncoder) Encode(w io.Writer, rec *typed.KubeWatchResult) (int, error) {
+	line, err := e.marshaler.MarshalToString(rec)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to marshal watch result to json")
+	}
+	return io.WriteString(w, line+"\n")
+}
+
+type protobufEncoder struct {
+}
+
+func (e *protobufEncoder) Encode(w io.Writer, rec *typed.KubeWatchResult) (int, error) {
+	data, err := proto.Marshal(rec)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to marshal watch result to protobuf")
+	}
+	prefix := make([]byte, binary.MaxVarintLen64)
+	prefixLen := binary.PutUvarint(prefix, uint64(len(data)))
+	n, err := w.Write(prefix[:prefixLen])
+	if err != nil {
+		return n, err
+	}
+	m, err := w.Write(data)
+	return n + m, err
+}
+
+type ndjsonDecoder struct {
+	scanner *bufio.Scanner
+}
+
+func (d *ndjsonDecoder) Decode() (*typed.KubeWatchResult, error) {
+	for d.scanner.Scan() {
+		line := bytes.TrimSpace(d.scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		rec := &typed.KubeWatchResult{}
+		err := jsonpb.Unmarshal(bytes.NewReader(line), rec)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal json record")
+		}
+		return rec, nil
+	}
+	if d.scanner.Err() != nil {
+		return nil, d.scanner.Err()
+	}
+	return nil, io.EOF
+}
+
+type protobufDecoder struct {
+	reader *bufio.Reader
+}
+
+func (d *protobufDecoder) Decode() (*typed.KubeWatchResult, error) {
+	size, err := binary.ReadUvarint(d.reader)
+	if err != nil {
+		// io.EOF here means we ended cleanly on a record boundary
+		return nil, err
+	}
+	if size > maxProtobufRecordBytes {
+		return nil, fmt.Errorf("protobuf record length %v exceeds max of %v", size, maxProtobufRecordBytes)
+	}
+	data := make([]byte, size)
+	_, err = io.ReadFull(d.reader, data)
+	if err != nil {
+		return nil, errors.Wrap(err, "truncated protobuf record")
+	}
+	rec := &typed.KubeWatchResult{}
+	err = proto.Unmarshal(data, rec)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal protobuf record")
+	}
+	return rec, nil
+}
+
+// The legacy yaml format is a single document, so we need to load all of it up front
+type yamlDecoder struct {
+	data []typed.KubeWatchResult
+	pos  int
+}
+
+func newYamlDecoder(r io.Reader) (*yamlDecoder, error) {
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	var playbackFile KubePlaybackFile
+	err = yaml.Unmarshal(b, &playbackFile)
+	if err != nil {
+		return nil, err
+	}
+	return &yamlDecoder{data: playbackFile.Data}, nil
+}
+
+func (d *yamlDecoder) Decode() (*typed.KubeWatchResult, error) {
+	if d.pos >= len(d.data) {
+		return nil, io.EOF
+	}
+	rec := &d.data[d.pos]
+	d.pos++
+	return rec, nil
+}
+
+// Returns a decoder for any supported recording format.  Gzip is detected from the magic header bytes.
+// The format comes from the file extension when it is known, otherwise we sniff the start of the content.
+func newRecordDecoder(filename string, r io.Reader) (recordDecoder, error) {
+	return newRecordDecoderForFormat(filename, r, formatFromFilename(filename))
+}
+
+// Like newRecordDecoder, but for streams without a filename.  An empty format is sniffed from the content
+func newRecordDecoderForFormat(filename string, r io.Reader, format string) (recordDecoder, error) {
+	br := bufio.NewReader(r)
+	magic, _ := br.Peek(2)
+	if len(magic) == 2 && magic[0] == 0x1f && magic[1] == 0x8b {
+		gz, err := gzip.NewReader(br)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to open gzip stream in %v", filename)
+		}
+		br = bufio.NewReader(gz)
+	}
+
+	if format == "" {
+		format = sniffFormat(br)
+	}
+
+	switch format {
+	case RecordFormatNdjson:
+		scanner := bufio.NewScanner(br)
+		// Payloads for large objects can easily exceed the default 64k token size
+		scanner.Buffer(make([]byte, 0, 64*1024), maxProtobufRecordBytes)
+		return &ndjsonDecoder{scanner: scanner}, nil
+	case RecordFormatProtobuf:
+		return &protobufDecoder{reader: br}, nil
+	default:
+		return newYamlDecoder(br)
+	}
+}
+
+func formatFromFilename(filename string) string {
+	ext := strings.ToLower(filepath.Ext(strings.TrimSuffix(filename, gzipExtension)))
+	switch ext {
+	case ".ndjson", ".jsonl", ".json":
+		return RecordFormatNdjson
+	case ".pb", ".bin", ".protobuf":
+		return RecordFormatProtobuf
+	case ".yaml", ".yml":
+		return RecordFormatYaml
+	}
+	return ""
+}
+
+func sniffFormat(br *bufio.Reader) string {
+	peek, _ := br.Peek(binary.MaxVarintLen64 + 1)
+	trimmed := bytes.TrimLeft(peek, " \t\r\n")
+	if len(trimmed) > 0 && trimmed[0] == '{' {
+		return RecordFormatNdjson
+	}
+	// A length prefix followed by the tag of one of the KubeWatchResult fields
+	size, n := binary.Uvarint(peek)
+	if n > 0 && n < len(peek) && size <= maxProtobufRecordBytes {
+		switch peek[n] {
+		case 0x0a, 0x12, 0x18, 0x22:
+			return RecordFormatProtobuf
+		}
+	}
+	return RecordFormatYaml
+}
@@ -0,0 +1,150 @@
+/*
+ * Copyright (c) 2021, salesforce.com, inc.
+ * All rights reserved.
+ * SPDX-License-Identifier: BSD-3-Clause
+ * For full license text, see LICENSE.txt file in the repo root or https://opensource.org/licenses/BSD-3-Clause
+ */
+
+package ingress
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/salesforce/sloop/pkg/sloop/common"
+	"github.com/salesforce/sloop/pkg/sloop/store/typed"
+)
+
+const (
+	RedactionModeHash   = "hash"
+	RedactionModeMarker = "marker"
+	redactionMarker     = "REDACTED"
+)
+
+// RedactionRule replaces sensitive values in payloads before they are stored.
+// Path is a dotted path into the object where * matches every key of a map or every item of a list, for example
+// data.* or spec.template.spec.containers.*.env.*.value
+// MatchName is an optional regex.  When the path ends in * it is matched against the map key, otherwise against
+// the name field next to the value, which is how env vars are matched by name.
+type RedactionRule struct {
+	Kind      string `json:"kind"` // Glob.  Empty matches every kind
+	Path      string `json:"path"`
+	MatchName string `json:"matchName"`
+	Mode      string `json:"mode"` // hash (default) keeps a short sha256 so changes are still visible, marker does not
+}
+
+// Used when no rules are configured.  Secrets are only watched when someone opts in, but if they do the values
+// should not end up on disk, including the copy kubectl keeps in the last-applied annotation
+var DefaultRedactionRules = []RedactionRule{
+	{Kind: "Secret", Path: "data.*"},
+	{Kind: "Secret", Path: "stringData.*"},
+	{Kind: "Secret", Path: "metadata.annotations.*", MatchName: `^kubectl\.kubernetes\.io/last-applied-configuration$`},
+}
+
+var metricIngressRedactedFields = promauto.NewCounterVec(prometheus.CounterOpts{Name: "sloop_ingress_redacted_fields"}, []string{"kind", "stage"})
+
+type compiledRedactionRule struct {
+	path payloadPath
+	mode string
+}
+
+type Redactor struct {
+	rules []compiledRedactionRule
+}
+
+// NewRedactor returns nil when there are no rules, and a nil Redactor leaves payloads alone
+func NewRedactor(rules []RedactionRule) (*Redactor, error) {
+	if len(rules) == 0 {
+		return nil, nil
+	}
+	r := &Redactor{}
+	for _, rule := range rules {
+		p, err := newPayloadPath(rule.Kind, rule.Path, rule.MatchName)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid redaction rule")
+		}
+		compiled := compiledRedactionRule{path: p, mode: rule.Mode}
+		if compiled.mode == "" {
+			compiled.mode = RedactionModeHash
+		}
+		if compiled.mode != RedactionModeHash && compiled.mode != RedactionModeMarker {
+			return nil, fmt.Errorf("invalid redaction mode %q.  Use %v or %v", rule.Mode, RedactionModeHash, RedactionModeMarker)
+		}
+		r.rules = append(r.rules, compiled)
+	}
+	return r, nil
+}
+
+// Redact rewrites rec.Payload in place.  stage is only used to label the metric
+func (r *Redactor) Redact(rec *typed.KubeWatchResult, stage string) error {
+	counts, err := rewritePayload(rec, r)
+	if err != nil {
+		return errors.Wrap(err, "redaction failed")
+	}
+	r.recordMetric(rec.Kind, stage, counts[0])
+	return nil
+}
+
+func (r *Redactor) recordMetric(kind string, stage string, count int) {
+	if count == 0 {
+		return
+	}
+	metricIngressRedactedFields.WithLabelValues(kind, stage).Add(float64(count))
+	glog.V(common.GlogVerbose).Infof("Redacted %v fields from %v", count, kind)
+}
+
+func (r *Redactor) matchesKind(kind string) bool {
+	if r == nil {
+		return false
+	}
+	for _, rule := range r.rules {
+		if rule.path.matchesKind(kind) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Redactor) apply(kind string, obj interface{}) int {
+	count := 0
+	for _, rule := range r.rules {
+		if !rule.path.matchesKind(kind) {
+			continue
+		}
+		mode := rule.mode
+		count += rule.path.apply(obj, func(value interface{}) (interface{}, pathAction) {
+			// A null has nothing to hide, and in a payload patch it deletes the key
+			if value == nil || isRedacted(value) {
+				return nil, pathKeep
+			}
+			return redactValue(value, mode), pathReplace
+		})
+	}
+	return count
+}
+
+// Restoring a backup or playing back a recording made with redaction on should not hash the hash
+func isRedacted(value interface{}) bool {
+	s, ok := value.(string)
+	return ok && strings.HasPrefix(s, redactionMarker)
+}
+
+func redactValue(value interface{}, mode string) string {
+	if mode == RedactionModeMarker {
+		return redactionMarker
+	}
+	raw, ok := value.(string)
+	if !ok {
+		b, _ := json.Marshal(value)
+		raw = string(b)
+	}
+	sum := sha256.Sum256([]byte(raw))
+	return redactionMarker + ":sha256:" + hex.EncodeToString(sum[:8])
+}
@@ -0,0 +1,195 @@
+/*
+ * Copyright (c) 2021, salesforce.com, inc.
+ * All rights reserved.
+ * SPDX-License-Identifier: BSD-3-Clause
+ * For full license text, see LICENSE.txt file in the repo root or https://opensource.org/licenses/BSD-3-Clause
+ */
+
+package ingress
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dgraph-io/badger/v2"
+	"github.com/dgraph-io/badger/v2/pb"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/salesforce/sloop/pkg/sloop/store/typed"
+	"github.com/salesforce/sloop/pkg/sloop/store/untyped"
+	"github.com/salesforce/sloop/pkg/sloop/store/untyped/badgerwrap"
+	"github.com/stretchr/testify/assert"
+)
+
+const someSecretPayload = `{"metadata":{"name":"s","namespace":"n","annotations":{"kubectl.kubernetes.io/last-applied-configuration":"{\"data\":{\"password\":\"aHVudGVyMg==\"}}","owner":"team-a"}},"data":{"password":"aHVudGVyMg==","user":"YWRtaW4="}}`
+
+const somePodPayload = `{"metadata":{"name":"p","generation":12345678901234567},"spec":{"containers":[{"name":"c","env":[{"name":"DB_PASSWORD","value":"hunter2"},{"name":"LOG_LEVEL","value":"debug"}]}]}}`
+
+func helper_redact(t *testing.T, rules []RedactionRule, kind string, payload string) string {
+	redactor, err := NewRedactor(rules)
+	assert.Nil(t, err)
+	rec := &typed.KubeWatchResult{Kind: kind, Payload: payload}
+	assert.Nil(t, redactor.Redact(rec, "test"))
+	return rec.Payload
+}
+
+func helper_parse(t *testing.T, payload string) map[string]interface{} {
+	var obj map[string]interface{}
+	assert.Nil(t, json.Unmarshal([]byte(payload), &obj))
+	return obj
+}
+
+func Test_Redact_DefaultSecretRules(t *testing.T) {
+	payload := helper_redact(t, DefaultRedactionRules, "Secret", someSecretPayload)
+	assert.NotContains(t, payload, "aHVudGVyMg==")
+	assert.NotContains(t, payload, "YWRtaW4=")
+
+	obj := helper_parse(t, payload)
+	data := obj["data"].(map[string]interface{})
+	assert.True(t, strings.HasPrefix(data["password"].(string), "REDACTED:sha256:"))
+	annotations := obj["metadata"].(map[string]interface{})["annotations"].(map[string]interface{})
+	assert.Equal(t, "team-a", annotations["owner"])
+}
+
+func Test_Redact_OtherKindsUntouched(t *testing.T) {
+	assert.Equal(t, someSecretPayload, helper_redact(t, DefaultRedactionRules, "ConfigMap", someSecretPayload))
+	// A matching kind with nothing to redact keeps its original formatting too
+	assert.Equal(t, `{ "metadata": {"name": "s"} }`, helper_redact(t, DefaultRedactionRules, "Secret", `{ "metadata": {"name": "s"} }`))
+}
+
+func Test_Redact_EnvByName(t *testing.T) {
+	rules := []RedactionRule{{Kind: "Pod", Path: "spec.containers.*.env.*.value", MatchName: "PASSWORD|TOKEN", Mode: RedactionModeMarker}}
+	payload := helper_redact(t, rules, "Pod", somePodPayload)
+	assert.NotContains(t, payload, "hunter2")
+	assert.Contains(t, payload, `{"name":"DB_PASSWORD","value":"REDACTED"}`)
+	assert.Contains(t, payload, `{"name":"LOG_LEVEL","value":"debug"}`)
+	// Large numbers survive the round trip
+	assert.Contains(t, payload, `"generation":12345678901234567`)
+}
+
+func Test_Redact_HashIsStableAndIdempotent(t *testing.T) {
+	once := helper_redact(t, DefaultRedactionRules, "Secret", someSecretPayload)
+	assert.Equal(t, once, helper_redact(t, DefaultRedactionRules, "Secret", someSecretPayload))
+	assert.Equal(t, once, helper_redact(t, DefaultRedactionRules, "Secret", once))
+}
+
+func Test_NewRedactor_Invalid(t *testing.T) {
+	redactor, err := NewRedactor(nil)
+	assert.Nil(t, err)
+	assert.Nil(t, redactor)
+
+	_, err = NewRedactor([]RedactionRule{{Kind: "Secret"}})
+	assert.NotNil(t, err)
+	_, err = NewRedactor([]RedactionRule{{Path: "data.*", Mode: "rot13"}})
+	assert.NotNil(t, err)
+	_, err = NewRedactor([]RedactionRule{{Path: "data.*", MatchName: "("}})
+	assert.NotNil(t, err)
+}
+
+func Test_RunPayloadStage_Redaction(t *testing.T) {
+	redactor, err := NewRedactor(DefaultRedactionRules)
+	assert.Nil(t, err)
+	inChan := make(chan typed.KubeWatchResult, 3)
+	outChan := make(chan typed.KubeWatchResult, 3)
+	inChan <- typed.KubeWatchResult{Kind: "Secret", Payload: someSecretPayload}
+	inChan <- typed.KubeWatchResult{Kind: "Secret", Payload: "not json"}
+	close(inChan)
+
+	RunPayloadStage(nil, redactor, inChan, outChan)
+
+	var results []typed.KubeWatchResult
+	for rec := range outChan {
+		results = append(results, rec)
+	}
+	// The unparsable record is dropped instead of stored unredacted
+	assert.Len(t, results, 1)
+	assert.NotContains(t, results[0].Payload, "aHVudGVyMg==")
+}
+
+func Test_redactBackup(t *testing.T) {
+	secret, err := proto.Marshal(&typed.KubeWatchResult{Kind: "Secret", Payload: someSecretPayload})
+	assert.Nil(t, err)
+	list := &pb.KVList{Kv: []*pb.KV{
+		{Key: []byte("/watch/001546405200/Secret/n/s/1546405300"), Value: secret},
+		{Key: []byte("/ressum/001546405200/Secret/n/s"), Value: []byte("untouched")},
+		{Key: []byte("/watch/001546405200/Secret/n/deleted/1546405300")},
+	}}
+	data, err := list.Marshal()
+	assert.Nil(t, err)
+	var in bytes.Buffer
+	assert.Nil(t, binary.Write(&in, binary.LittleEndian, uint64(len(data))))
+	in.Write(data)
+
+	redactor, err := NewRedactor(DefaultRedactionRules)
+	assert.Nil(t, err)
+	var out bytes.Buffer
+	assert.Nil(t, redactBackup(&in, &out, redactor))
+
+	var size uint64
+	assert.Nil(t, binary.Read(&out, binary.LittleEndian, &size))
+	outList := &pb.KVList{}
+	assert.Nil(t, outList.Unmarshal(out.Next(int(size))))
+	assert.Len(t, outList.Kv, 3)
+
+	rec := &typed.KubeWatchResult{}
+	assert.Nil(t, proto.Unmarshal(outList.Kv[0].Value, rec))
+	assert.NotContains(t, rec.Payload, "aHVudGVyMg==")
+	assert.Equal(t, []byte("untouched"), outList.Kv[1].Value)
+	assert.Equal(t, 0, out.Len())
+}
+
+func Test_DatabaseRestore_RedactsPayloadPatches(t *testing.T) {
+	untyped.TestHookSetPartitionDuration(time.Hour)
+	keyframeTs := time.Date(2019, 1, 2, 3, 4, 5, 0, time.UTC)
+	patchTs := keyframeTs.Add(time.Minute)
+	keyframe, err := proto.Marshal(&typed.KubeWatchResult{Kind: "Secret", Payload: someSecretPayload})
+	assert.Nil(t, err)
+	keyframeProtoTs, err := ptypes.TimestampProto(keyframeTs)
+	assert.Nil(t, err)
+	// Drops the password and changes the user
+	patch, err := proto.Marshal(&typed.KubeWatchResult{Kind: "Secret", Payload: `{"data":{"password":null,"user":"cm9vdA=="}}`, PayloadKeyframe: keyframeProtoTs})
+	assert.Nil(t, err)
+	patchKey := typed.NewWatchTableKey(untyped.GetPartitionId(patchTs), "Secret", "n", "s", patchTs).String()
+	list := &pb.KVList{Kv: []*pb.KV{
+		{Key: []byte(typed.NewWatchTableKey(untyped.GetPartitionId(keyframeTs), "Secret", "n", "s", keyframeTs).String()), Value: keyframe, Version: 1},
+		{Key: []byte(patchKey), Value: patch, Version: 1},
+	}}
+	data, err := list.Marshal()
+	assert.Nil(t, err)
+	dir, err := ioutil.TempDir("", "dbrestore")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	backup := filepath.Join(dir, "backup")
+	var in bytes.Buffer
+	assert.Nil(t, binary.Write(&in, binary.LittleEndian, uint64(len(data))))
+	in.Write(data)
+	assert.Nil(t, ioutil.WriteFile(backup, in.Bytes(), 0644))
+
+	// The mock does not load backups
+	db, err := (&badgerwrap.BadgerFactory{}).Open(badger.DefaultOptions(filepath.Join(dir, "db")).WithLogger(nil))
+	assert.Nil(t, err)
+	defer db.Close()
+	redactor, err := NewRedactor(DefaultRedactionRules)
+	assert.Nil(t, err)
+	assert.Nil(t, DatabaseRestore(db, backup, redactor))
+
+	err = db.View(func(txn badgerwrap.Txn) error {
+		rec, err := typed.OpenKubeWatchResultTable().Get(txn, patchKey)
+		if !assert.Nil(t, err) {
+			return nil
+		}
+		secretData := helper_parse(t, rec.Payload)["data"].(map[string]interface{})
+		assert.NotContains(t, secretData, "password")
+		assert.True(t, strings.HasPrefix(secretData["user"].(string), redactionMarker))
+		assert.NotContains(t, rec.Payload, "cm9vdA==")
+		return nil
+	})
+	assert.Nil(t, err)
+}
@@ -0,0 +1,298 @@
+/*
+ * Copyright (c) 2021, salesforce.com, inc.
+ * All rights reserved.
+ * SPDX-License-Identifier: BSD-3-Clause
+ * For full license text, see LICENSE.txt file in the repo root or https://opensource.org/licenses/BSD-3-Clause
+ */
+
+package ingress
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/pkg/errors"
+	"github.com/salesforce/sloop/pkg/sloop/store/typed"
+	k8syaml "k8s.io/apimachinery/pkg/util/yaml"
+)
+
+type SnapshotImportConfig struct {
+	// A file, a directory that is walked recursively, or a glob.  Only .yaml, .yml and .json files are read, gzipped
+	// or not, so the other things in a must-gather directory are skipped
+	Path string
+	// When the snapshot was taken.  Zero uses the modification time of each file
+	CaptureTime time.Time
+}
+
+// The parts of an object or list we need to file it
+type snapshotObject struct {
+	Kind       string `json:"kind"`
+	APIVersion string `json:"apiVersion"`
+	Metadata   struct {
+		Name      string `json:"name"`
+		Namespace string `json:"namespace"`
+		UID       string `json:"uid"`
+	} `json:"metadata"`
+	Items []json.RawMessage `json:"items"`
+}
+
+type snapshotFile struct {
+	filename string
+	ts       time.Time
+}
+
+type snapshotEntry struct {
+	kind      string
+	namespace string
+	uid       string
+	payload   string
+}
+
+// ImportSnapshots reads `kubectl get -o yaml` output and directories of yaml or json dumps, and writes a watch result
+// to outChan for every object in them.  Lists are split into their items.
+// Files with the same timestamp make up one snapshot, and snapshots are imported oldest first so several of them
+// become a timeline.  The first time an object shows up it is an ADD, and after that an UPDATE, which processing
+// records as a change or as no change.  An object is deleted when a later snapshot has the same kind in the same
+// namespace but not the object.  A snapshot that only covers some kinds or namespaces never deletes the rest.
+func ImportSnapshots(outChan chan typed.KubeWatchResult, config SnapshotImportConfig) error {
+	files, err := findSnapshotFiles(config)
+	if err != nil {
+		return err
+	}
+	importer := &snapshotImporter{outChan: outChan, known: map[string]*snapshotEntry{}}
+	for start := 0; start < len(files); {
+		end := start + 1
+		for end < len(files) && files[end].ts.Equal(files[start].ts) {
+			end++
+		}
+		err = importer.importSnapshot(files[start:end])
+		if err != nil {
+			return err
+		}
+		start = end
+	}
+	glog.Infof("Imported %v objects from %v snapshots in %v (%v seen in an earlier snapshot, %v deleted)", importer.objects, importer.snapshots, config.Path, importer.updates, importer.deletes)
+	return nil
+}
+
+func findSnapshotFiles(config SnapshotImportConfig) ([]snapshotFile, error) {
+	var filenames []string
+	if strings.ContainsAny(config.Path, "*?[") {
+		matches, err := filepath.Glob(config.Path)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid snapshot pattern %v", config.Path)
+		}
+		filenames = matches
+	} else {
+		err := filepath.Walk(config.Path, func(filename string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if !info.IsDir() && (filename == config.Path || isSnapshotFile(filename)) {
+				filenames = append(filenames, filename)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read snapshots from %v", config.Path)
+		}
+	}
+	if len(filenames) == 0 {
+		return nil, fmt.Errorf("no snapshot files found in %v", config.Path)
+	}
+
+	var files []snapshotFile
+	for _, filename := range filenames {
+		ts := config.CaptureTime
+		if ts.IsZero() {
+			info, err := os.Stat(filename)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to open snapshot %v", filename)
+			}
+			// Files from one dump are usually written within the same second, so small differences do not split it
+			ts = info.ModTime().Truncate(time.Second)
+		}
+		files = append(files, snapshotFile{filename: filename, ts: ts})
+	}
+	sort.SliceStable(files, func(i, j int) bool {
+		if !files[i].ts.Equal(files[j].ts) {
+			return files[i].ts.Before(files[j].ts)
+		}
+		return files[i].filename < files[j].filename
+	})
+	return files, nil
+}
+
+func isSnapshotFile(filename string) bool {
+	switch strings.ToLower(filepath.Ext(strings.TrimSuffix(filename, gzipExtension))) {
+	case ".yaml", ".yml", ".json":
+		return true
+	}
+	return false
+}
+
+type snapshotImporter struct {
+	outChan chan typed.KubeWatchResult
+	// Last payload of every object we imported, by kind, namespace and name
+	known     map[string]*snapshotEntry
+	snapshots int
+	objects   int
+	updates   int
+	deletes   int
+}
+
+func (s *snapshotImporter) importSnapshot(files []snapshotFile) error {
+	ts, err := ptypes.TimestampProto(files[0].ts)
+	if err != nil {
+		return errors.Wrapf(err, "invalid timestamp for %v", files[0].filename)
+	}
+	seen := map[string]bool{}
+	// Kind and namespace pairs this snapshot has at least one object for
+	covered := map[string]bool{}
+	for _, file := range files {
+		err = forEachSnapshotObject(file.filename, func(obj *snapshotObject, payload string) {
+			key := fmt.Sprintf("%v/%v/%v", obj.Kind, obj.Metadata.Namespace, obj.Metadata.Name)
+			seen[key] = true
+			covered[obj.Kind+"/"+obj.Metadata.Namespace] = true
+			s.objects++
+
+			watchType := typed.KubeWatchResult_ADD
+			if prev, ok := s.known[key]; ok {
+				watchType = typed.KubeWatchResult_UPDATE
+				// Same name but a new object, so the old one must have been deleted in between
+				if prev.uid != "" && obj.Metadata.UID != "" && prev.uid != obj.Metadata.UID {
+					s.write(ts, prev.kind, typed.KubeWatchResult_DELETE, prev.payload)
+					watchType = typed.KubeWatchResult_ADD
+				}
+				s.updates++
+			}
+			s.known[key] = &snapshotEntry{kind: obj.Kind, namespace: obj.Metadata.Namespace, uid: obj.Metadata.UID, payload: payload}
+			s.write(ts, obj.Kind, watchType, payload)
+		})
+		if err != nil {
+			return errors.Wrapf(err, "failed to import snapshot %v", file.filename)
+		}
+	}
+
+	// Sorted so the same snapshots always import the same way
+	var gone []string
+	for key, entry := range s.known {
+		if !seen[key] && covered[entry.kind+"/"+entry.namespace] {
+			gone = append(gone, key)
+		}
+	}
+	sort.Strings(gone)
+	for _, key := range gone {
+		s.write(ts, s.known[key].kind, typed.KubeWatchResult_DELETE, s.known[key].payload)
+		delete(s.known, key)
+		s.deletes++
+	}
+	s.snapshots++
+	return nil
+}
+
+func (s *snapshotImporter) write(ts *timestamp.Timestamp, kind string, watchType typed.KubeWatchResult_WatchType, payload string) {
+	s.outChan <- typed.KubeWatchResult{Timestamp: ts, Kind: kind, WatchType: watchType, Payload: payload}
+}
+
+// Calls fn for every object in a yaml or json stream, with lists split into their items
+func forEachSnapshotObject(filename string, fn func(obj *snapshotObject, payload string)) error {
+	f, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	br := bufio.NewReader(f)
+	var r io.Reader = br
+	magic, _ := br.Peek(2)
+	if len(magic) == 2 && magic[0] == 0x1f && magic[1] == 0x8b {
+		gz, err := gzip.NewReader(br)
+		if err != nil {
+			return err
+		}
+		r = gz
+	}
+
+	decoder := k8syaml.NewYAMLOrJSONDecoder(r, 4096)
+	for {
+		var raw json.RawMessage
+		err := decoder.Decode(&raw)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		// Empty yaml documents come out as null
+		if len(raw) == 0 || bytes.Equal(raw, []byte("null")) {
+			continue
+		}
+		err = splitSnapshotObject(raw, "", "", fn)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// Items of typed lists like a PodList from the api do not have their own kind, so they get it from the list
+func splitSnapshotObject(raw json.RawMessage, listKind string, listAPIVersion string, fn func(obj *snapshotObject, payload string)) error {
+	var obj snapshotObject
+	err := json.Unmarshal(raw, &obj)
+	if err != nil {
+		return err
+	}
+	if strings.HasSuffix(obj.Kind, "List") && obj.Metadata.Name == "" {
+		for _, item := range obj.Items {
+			err = splitSnapshotObject(item, strings.TrimSuffix(obj.Kind, "List"), obj.APIVersion, fn)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if obj.Metadata.Name == "" {
+		return nil
+	}
+
+	if obj.Kind == "" && listKind != "" {
+		// Number keeps large integers intact on the way back out
+		var fields map[string]interface{}
+		decoder := json.NewDecoder(bytes.NewReader(raw))
+		decoder.UseNumber()
+		err = decoder.Decode(&fields)
+		if err != nil {
+			return err
+		}
+		fields["kind"] = listKind
+		if _, ok := fields["apiVersion"]; !ok && listAPIVersion != "" {
+			fields["apiVersion"] = listAPIVersion
+		}
+		raw, err = json.Marshal(fields)
+		if err != nil {
+			return err
+		}
+		obj.Kind = listKind
+	}
+	if obj.Kind == "" {
+		return nil
+	}
+	var payload bytes.Buffer
+	err = json.Compact(&payload, raw)
+	if err != nil {
+		return err
+	}
+	fn(&obj, payload.String())
+	return nil
+}
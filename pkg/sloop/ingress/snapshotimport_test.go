@@ -0,0 +1,156 @@
+/*
+ * Copyright (c) 2021, salesforce.com, inc.
+ * All rights reserved.
+ * SPDX-License-Identifier: BSD-3-Clause
+ * For full license text, see LICENSE.txt file in the repo root or https://opensource.org/licenses/BSD-3-Clause
+ */
+
+package ingress
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/salesforce/sloop/pkg/sloop/store/typed"
+	"github.com/stretchr/testify/assert"
+)
+
+const kubectlGetAllYaml = `apiVersion: v1
+kind: List
+items:
+- apiVersion: v1
+  kind: Pod
+  metadata:
+    name: a
+    namespace: ns1
+    uid: uid-a
+- apiVersion: v1
+  kind: Pod
+  metadata:
+    name: b
+    namespace: ns1
+    uid: uid-b
+metadata:
+  resourceVersion: ""
+`
+
+const multiDocYaml = `# dumped by hand
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: d
+  namespace: ns1
+spec:
+  replicas: 3
+---
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: c
+  namespace: ns2
+`
+
+const podListJson = `{"kind":"PodList","apiVersion":"v1","metadata":{},"items":[{"metadata":{"name":"e","namespace":"ns3","generation":12345678901234567}}]}`
+
+func helper_writeSnapshot(t *testing.T, filename string, content string, mtime time.Time) {
+	assert.Nil(t, os.MkdirAll(filepath.Dir(filename), 0755))
+	assert.Nil(t, ioutil.WriteFile(filename, []byte(content), 0644))
+	if !mtime.IsZero() {
+		assert.Nil(t, os.Chtimes(filename, mtime, mtime))
+	}
+}
+
+// Returns "WATCHTYPE Kind name" for every record, along with their timestamps
+func helper_importSnapshots(t *testing.T, config SnapshotImportConfig) ([]string, []time.Time) {
+	outChan := make(chan typed.KubeWatchResult, 100)
+	assert.Nil(t, ImportSnapshots(outChan, config))
+	close(outChan)
+	var summaries []string
+	var times []time.Time
+	for rec := range outChan {
+		var obj snapshotObject
+		assert.Nil(t, json.Unmarshal([]byte(rec.Payload), &obj))
+		assert.Equal(t, rec.Kind, obj.Kind)
+		summaries = append(summaries, fmt.Sprintf("%v %v %v", rec.WatchType, rec.Kind, obj.Metadata.Name))
+		ts, err := ptypes.Timestamp(rec.Timestamp)
+		assert.Nil(t, err)
+		times = append(times, ts)
+	}
+	return summaries, times
+}
+
+func Test_ImportSnapshots_SplitsListsAndDocuments(t *testing.T) {
+	dir, err := ioutil.TempDir("", "snapshotimport")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	helper_writeSnapshot(t, filepath.Join(dir, "all.yaml"), kubectlGetAllYaml, time.Time{})
+	helper_writeSnapshot(t, filepath.Join(dir, "namespaces", "ns1", "dump.yml"), multiDocYaml, time.Time{})
+	helper_writeSnapshot(t, filepath.Join(dir, "namespaces", "ns3", "pods.json"), podListJson, time.Time{})
+	helper_writeSnapshot(t, filepath.Join(dir, "namespaces", "ns3", "pod.log"), "not a snapshot", time.Time{})
+
+	captured := time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC)
+	summaries, times := helper_importSnapshots(t, SnapshotImportConfig{Path: dir, CaptureTime: captured})
+	assert.Equal(t, []string{"ADD Pod a", "ADD Pod b", "ADD Deployment d", "ADD ConfigMap c", "ADD Pod e"}, summaries)
+	for _, ts := range times {
+		assert.Equal(t, captured, ts)
+	}
+}
+
+func Test_ImportSnapshots_TypedListItemsKeepNumbers(t *testing.T) {
+	dir, err := ioutil.TempDir("", "snapshotimport")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	filename := filepath.Join(dir, "pods.json")
+	helper_writeSnapshot(t, filename, podListJson, time.Time{})
+
+	outChan := make(chan typed.KubeWatchResult, 10)
+	assert.Nil(t, ImportSnapshots(outChan, SnapshotImportConfig{Path: filename}))
+	rec := <-outChan
+	assert.Equal(t, "Pod", rec.Kind)
+	assert.Contains(t, rec.Payload, `"apiVersion":"v1"`)
+	assert.Contains(t, rec.Payload, `"generation":12345678901234567`)
+}
+
+func Test_ImportSnapshots_Timeline(t *testing.T) {
+	dir, err := ioutil.TempDir("", "snapshotimport")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	first := time.Date(2021, 3, 4, 5, 0, 0, 0, time.UTC)
+	second := first.Add(time.Hour)
+
+	pod := func(name string, uid string, image string) string {
+		return fmt.Sprintf(`{"kind":"Pod","apiVersion":"v1","metadata":{"name":%q,"namespace":"ns1","uid":%q},"spec":{"image":%q}}`, name, uid, image)
+	}
+	configMap := `{"kind":"ConfigMap","apiVersion":"v1","metadata":{"name":"cm","namespace":"ns1"}}`
+	helper_writeSnapshot(t, filepath.Join(dir, "1", "pods.json"), pod("a", "1", "v1")+pod("b", "2", "v1")+pod("c", "3", "v1")+configMap, first)
+	// b is gone, c was recreated and the configmap is not part of this dump at all
+	helper_writeSnapshot(t, filepath.Join(dir, "2", "pods.json"), pod("a", "1", "v2")+pod("c", "4", "v1")+pod("d", "5", "v1"), second)
+
+	summaries, times := helper_importSnapshots(t, SnapshotImportConfig{Path: dir})
+	assert.Equal(t, []string{
+		"ADD Pod a", "ADD Pod b", "ADD Pod c", "ADD ConfigMap cm",
+		"UPDATE Pod a", "DELETE Pod c", "ADD Pod c", "ADD Pod d", "DELETE Pod b",
+	}, summaries)
+	for idx, ts := range times {
+		if idx < 4 {
+			assert.Equal(t, first, ts.UTC())
+		} else {
+			assert.Equal(t, second, ts.UTC())
+		}
+	}
+}
+
+func Test_ImportSnapshots_NothingFound(t *testing.T) {
+	dir, err := ioutil.TempDir("", "snapshotimport")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	assert.NotNil(t, ImportSnapshots(make(chan typed.KubeWatchResult), SnapshotImportConfig{Path: dir}))
+}
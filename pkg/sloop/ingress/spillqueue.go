@@ -0,0 +1,323 @@
+/*
+ * Copyright (c) 2021, salesforce.com, inc.
+ * All rights reserved.
+ * SPDX-License-Identifier: BSD-3-Clause
+ * For full license text, see LICENSE.txt file in the repo root or https://opensource.org/licenses/BSD-3-Clause
+ */
+
+package ingress
+
+import (
+	"bufio"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"sync"
+
+	"github.com/golang/glog"
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/salesforce/sloop/pkg/sloop/common"
+	"github.com/salesforce/sloop/pkg/sloop/store/typed"
+)
+
+const defaultSpillSegmentBytes = 16 * 1024 * 1024
+
+var (
+	metricIngressQueueDepth        = promauto.NewGaugeVec(prometheus.GaugeOpts{Name: "sloop_ingress_queue_depth"}, []string{"cluster", "location"})
+	metricIngressQueueSpilledbytes = promauto.NewCounterVec(prometheus.CounterOpts{Name: "sloop_ingress_queue_spilledbytes"}, []string{"cluster"})
+	metricIngressQueueDropcount    = promauto.NewCounterVec(prometheus.CounterOpts{Name: "sloop_ingress_queue_dropcount"}, []string{"cluster", "kind", "reason"})
+)
+
+// Endpoints change whenever a pod behind a service comes or goes, which makes them most of the updates in a busy
+// cluster and the least missed.  The kind is the one the watcher gives them
+var DefaultDropUpdateKinds = []string{"Endpoint"}
+
+type SpillQueueConfig struct {
+	Cluster         string   // Context of the cluster the records come from, used to label the metrics
+	Dir             string   // Spill files go in a new directory under this one.  Empty uses the system temp dir
+	MemoryRecords   int      // Records kept in memory before we start spilling to disk
+	MaxSpillBytes   int64    // Once this much is waiting on disk new records are dropped.  0 = never spill
+	SegmentBytes    int64    // Size of each spill file.  0 = 16MB
+	DropUpdateKinds []string // Globs or /regex/.  Once memory is full, updates for these kinds are dropped instead of spilled
+}
+
+// SpillQueue sits between ingress and processing so a slow store never pushes back on the kube watchers.  It drains
+// inChan as fast as records arrive, keeps up to MemoryRecords of them in memory, and writes the rest to files on
+// disk until processing catches up.  Order is preserved.  When inChan is closed it delivers everything still queued,
+// closes outChan and removes its spill files.
+//
+// There is one goroutine pushing and one popping.  Only the pushing one writes to the spill files and only the popping
+// one reads them, so neither holds the lock while it does file I/O and a slow disk does not hold up the other.
+type SpillQueue struct {
+	config    SpillQueueConfig
+	dropKinds *nameMatcher
+	inChan    chan typed.KubeWatchResult
+	outChan   chan typed.KubeWatchResult
+	dir       string
+
+	lock       *sync.Mutex
+	cond       *sync.Cond
+	memory     []typed.KubeWatchResult
+	segments   []*spillSegment
+	spillBytes int64 // Written to disk but not read back yet
+	spillCount int
+	closed     bool
+	nextSeq    int
+}
+
+// One spill file.  Records are appended by the writer handle and read back in order by the reader handle
+type spillSegment struct {
+	filename string
+	writer   *os.File
+	reader   *os.File
+	decoder  *protobufDecoder
+	size     int64
+	read     int64
+	records  int
+	released bool // Closed and deleted, possibly while the pushing goroutine was still writing to it
+}
+
+func NewSpillQueue(config SpillQueueConfig, inChan chan typed.KubeWatchResult, outChan chan typed.KubeWatchResult) (*SpillQueue, error) {
+	if config.MemoryRecords <= 0 {
+		return nil, fmt.Errorf("spill queue needs room for at least one record in memory, got %v", config.MemoryRecords)
+	}
+	if config.SegmentBytes <= 0 {
+		config.SegmentBytes = defaultSpillSegmentBytes
+	}
+	dropKinds, err := newNameMatcher(config.DropUpdateKinds)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid DropUpdateKinds")
+	}
+	q := &SpillQueue{config: config, dropKinds: dropKinds, inChan: inChan, outChan: outChan, lock: &sync.Mutex{}}
+	q.cond = sync.NewCond(q.lock)
+	if config.MaxSpillBytes > 0 {
+		q.dir, err = ioutil.TempDir(config.Dir, "sloop-spill-")
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create spill directory")
+		}
+	}
+	return q, nil
+}
+
+func (q *SpillQueue) Start() {
+	go func() {
+		for rec := range q.inChan {
+			q.push(rec)
+		}
+		q.lock.Lock()
+		q.closed = true
+		q.cond.Broadcast()
+		q.lock.Unlock()
+	}()
+	go func() {
+		for {
+			rec, more := q.pop()
+			if !more {
+				break
+			}
+			q.outChan <- rec
+		}
+		close(q.outChan)
+		q.removeSpillDir()
+	}()
+}
+
+func (q *SpillQueue) push(rec typed.KubeWatchResult) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	defer q.updateDepthMetric()
+
+	// Once anything is on disk new records have to go behind it to keep the order
+	if q.spillCount == 0 && len(q.memory) < q.config.MemoryRecords {
+		q.memory = append(q.memory, rec)
+		q.cond.Signal()
+		return
+	}
+	if rec.WatchType == typed.KubeWatchResult_UPDATE && q.dropKinds.matches(rec.Kind) {
+		q.drop(&rec, "policy")
+		return
+	}
+	err := q.spill(&rec)
+	if err != nil {
+		glog.Errorf("Failed to spill %v to disk: %v", rec.Kind, err)
+		q.drop(&rec, "spillerror")
+		return
+	}
+	q.cond.Signal()
+}
+
+// Blocks until there is a record to return.  Returns false once the queue is closed and empty
+func (q *SpillQueue) pop() (typed.KubeWatchResult, bool) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	defer q.updateDepthMetric()
+
+	for {
+		if len(q.memory) > 0 {
+			rec := q.memory[0]
+			q.memory[0] = typed.KubeWatchResult{}
+			q.memory = q.memory[1:]
+			return rec, true
+		}
+		if q.spillCount > 0 {
+			rec, err := q.unspill()
+			if err != nil {
+				glog.Errorf("Failed to read back spilled records: %v", err)
+				continue
+			}
+			return *rec, true
+		}
+		if q.closed {
+			return typed.KubeWatchResult{}, false
+		}
+		q.cond.Wait()
+	}
+}
+
+func (q *SpillQueue) drop(rec *typed.KubeWatchResult, reason string) {
+	metricIngressQueueDropcount.WithLabelValues(q.config.Cluster, rec.Kind, reason).Inc()
+	glog.V(common.GlogVerbose).Infof("Ingress queue is full, dropping %v %v (%v)", rec.Kind, rec.WatchType, reason)
+}
+
+// Called with the lock held, which it lets go of while it writes
+func (q *SpillQueue) spill(rec *typed.KubeWatchResult) error {
+	if q.dir == "" {
+		q.drop(rec, "full")
+		return nil
+	}
+	size := int64(proto.Size(rec))
+	if q.spillBytes+size > q.config.MaxSpillBytes {
+		q.drop(rec, "full")
+		return nil
+	}
+	var segment *spillSegment
+	if len(q.segments) > 0 && q.segments[len(q.segments)-1].size < q.config.SegmentBytes {
+		segment = q.segments[len(q.segments)-1]
+	}
+
+	q.lock.Unlock()
+	isNew := segment == nil
+	var err error
+	if isNew {
+		segment, err = q.newSegment()
+	}
+	var n int
+	if err == nil {
+		n, err = (&protobufEncoder{}).Encode(segment.writer, rec)
+	}
+	q.lock.Lock()
+
+	if segment == nil {
+		return err
+	}
+	if isNew {
+		q.segments = append(q.segments, segment)
+	}
+	segment.size += int64(n)
+	if err != nil {
+		// A partial write would corrupt everything after it, so stop appending to this file
+		segment.size = q.config.SegmentBytes
+		return errors.Wrapf(err, "failed to write to %v", segment.filename)
+	}
+	if segment.released {
+		// The reader gave up on this file while we were writing to it, taking its records with it
+		return fmt.Errorf("%v was dropped while writing to it", segment.filename)
+	}
+	segment.records++
+	q.spillCount++
+	q.spillBytes += int64(n)
+	metricIngressQueueSpilledbytes.WithLabelValues(q.config.Cluster).Add(float64(n))
+	return nil
+}
+
+func (q *SpillQueue) newSegment() (*spillSegment, error) {
+	q.nextSeq++
+	filename := path.Join(q.dir, fmt.Sprintf("segment-%08d", q.nextSeq))
+	writer, err := os.OpenFile(filename, os.O_CREATE|os.O_WRONLY|os.O_APPEND|os.O_EXCL, 0600)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create spill file")
+	}
+	reader, err := os.Open(filename)
+	if err != nil {
+		writer.Close()
+		return nil, errors.Wrap(err, "failed to open spill file for reading")
+	}
+	return &spillSegment{filename: filename, writer: writer, reader: reader, decoder: &protobufDecoder{reader: bufio.NewReader(reader)}}, nil
+}
+
+// Reads the oldest record on disk.  Files are deleted as soon as everything in them has been read.  If a file can not
+// be read the records left in it are dropped
+// Called with the lock held, which it lets go of while it reads
+func (q *SpillQueue) unspill() (*typed.KubeWatchResult, error) {
+	segment := q.segments[0]
+	// The record count tells us the whole record is on disk, so the decoder never sees a partial write.  Only this
+	// goroutine releases segments, so segment is still the oldest one when we get the lock back
+	q.lock.Unlock()
+	rec, err := segment.decoder.Decode()
+	q.lock.Lock()
+	if err != nil {
+		for i := 0; i < segment.records; i++ {
+			metricIngressQueueDropcount.WithLabelValues(q.config.Cluster, "unknown", "spillerror").Inc()
+		}
+		q.releaseSegment(segment.records, segment.size-segment.read)
+		return nil, errors.Wrapf(err, "dropped %v records from %v", segment.records, segment.filename)
+	}
+	read := int64(proto.Size(rec))
+	read += int64(uvarintLen(uint64(read)))
+	segment.read += read
+	segment.records--
+	q.spillCount--
+	q.spillBytes -= read
+	if segment.records == 0 && segment.size >= q.config.SegmentBytes {
+		q.releaseSegment(0, segment.size-segment.read)
+	}
+	return rec, nil
+}
+
+// Closes and deletes the oldest segment, along with the records and bytes that were still in it
+func (q *SpillQueue) releaseSegment(records int, bytes int64) {
+	segment := q.segments[0]
+	q.segments[0] = nil
+	q.segments = q.segments[1:]
+	segment.released = true
+	q.spillCount -= records
+	q.spillBytes -= bytes
+	segment.writer.Close()
+	segment.reader.Close()
+	err := os.Remove(segment.filename)
+	if err != nil {
+		glog.Errorf("Failed to remove spill file %v: %v", segment.filename, err)
+	}
+}
+
+func (q *SpillQueue) removeSpillDir() {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	for len(q.segments) > 0 {
+		q.releaseSegment(q.segments[0].records, q.segments[0].size-q.segments[0].read)
+	}
+	if q.dir != "" {
+		err := os.RemoveAll(q.dir)
+		if err != nil {
+			glog.Errorf("Failed to remove spill directory %v: %v", q.dir, err)
+		}
+	}
+}
+
+func (q *SpillQueue) updateDepthMetric() {
+	metricIngressQueueDepth.WithLabelValues(q.config.Cluster, "memory").Set(float64(len(q.memory)))
+	metricIngressQueueDepth.WithLabelValues(q.config.Cluster, "disk").Set(float64(q.spillCount))
+}
+
+func uvarintLen(x uint64) int {
+	n := 1
+	for x >= 0x80 {
+		x >>= 7
+		n++
+	}
+	return n
+}
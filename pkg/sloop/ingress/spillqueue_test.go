@@ -0,0 +1,172 @@
+/*
+ * Copyright (c) 2021, salesforce.com, inc.
+ * All rights reserved.
+ * SPDX-License-Identifier: BSD-3-Clause
+ * For full license text, see LICENSE.txt file in the repo root or https://opensource.org/licenses/BSD-3-Clause
+ */
+
+package ingress
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/salesforce/sloop/pkg/sloop/store/typed"
+	"github.com/stretchr/testify/assert"
+)
+
+func helper_newSpillQueue(t *testing.T, config SpillQueueConfig) (*SpillQueue, func()) {
+	dir, err := ioutil.TempDir("", "spillqueue_test")
+	assert.Nil(t, err)
+	config.Dir = dir
+	q, err := NewSpillQueue(config, make(chan typed.KubeWatchResult), make(chan typed.KubeWatchResult))
+	assert.Nil(t, err)
+	return q, func() { os.RemoveAll(dir) }
+}
+
+// Closes the queue and returns everything left in it, in order
+func helper_drain(q *SpillQueue) []typed.KubeWatchResult {
+	q.lock.Lock()
+	q.closed = true
+	q.lock.Unlock()
+	var recs []typed.KubeWatchResult
+	for {
+		rec, more := q.pop()
+		if !more {
+			return recs
+		}
+		recs = append(recs, rec)
+	}
+}
+
+func Test_SpillQueue_SpillsInOrder(t *testing.T) {
+	q, cleanup := helper_newSpillQueue(t, SpillQueueConfig{MemoryRecords: 2, MaxSpillBytes: 1024 * 1024, SegmentBytes: 1})
+	defer cleanup()
+	recs := helper_makeRecords(t, 10)
+	for _, rec := range recs[:5] {
+		q.push(rec)
+	}
+	assert.Len(t, q.memory, 2)
+	assert.Equal(t, 3, q.spillCount)
+	files, _ := filepath.Glob(filepath.Join(q.dir, "segment-*"))
+	// Every record fills a segment
+	assert.Len(t, files, 3)
+
+	// Memory frees up but new records still have to wait behind the ones on disk
+	first, _ := q.pop()
+	helper_assertRecordsEqual(t, recs[:1], []typed.KubeWatchResult{first})
+	q.push(recs[5])
+	assert.Len(t, q.memory, 1)
+	for _, rec := range recs[6:] {
+		q.push(rec)
+	}
+
+	helper_assertRecordsEqual(t, recs[1:], helper_drain(q))
+	assert.Equal(t, int64(0), q.spillBytes)
+	files, _ = filepath.Glob(filepath.Join(q.dir, "segment-*"))
+	assert.Len(t, files, 0)
+}
+
+func Test_SpillQueue_DropPolicy(t *testing.T) {
+	q, cleanup := helper_newSpillQueue(t, SpillQueueConfig{MemoryRecords: 1, MaxSpillBytes: 1024 * 1024, DropUpdateKinds: []string{"Endpoint"}})
+	defer cleanup()
+	q.push(typed.KubeWatchResult{Kind: "Pod", WatchType: typed.KubeWatchResult_UPDATE})
+	q.push(typed.KubeWatchResult{Kind: "Endpoint", WatchType: typed.KubeWatchResult_UPDATE})
+	q.push(typed.KubeWatchResult{Kind: "Endpoint", WatchType: typed.KubeWatchResult_ADD})
+	q.push(typed.KubeWatchResult{Kind: "Pod", WatchType: typed.KubeWatchResult_UPDATE})
+
+	recs := helper_drain(q)
+	assert.Len(t, recs, 3)
+	assert.Equal(t, "Endpoint", recs[1].Kind)
+	assert.Equal(t, typed.KubeWatchResult_ADD, recs[1].WatchType)
+}
+
+func Test_SpillQueue_DefaultDropPolicyMatchesWatcherKind(t *testing.T) {
+	var endpointKind string
+	for _, reg := range builtinInformerRegistry {
+		if reg.resource == "endpoints" {
+			endpointKind = reg.kind
+		}
+	}
+	q, cleanup := helper_newSpillQueue(t, SpillQueueConfig{MemoryRecords: 1, MaxSpillBytes: 1024 * 1024, DropUpdateKinds: DefaultDropUpdateKinds})
+	defer cleanup()
+	q.push(typed.KubeWatchResult{Kind: "Pod", WatchType: typed.KubeWatchResult_UPDATE})
+	q.push(typed.KubeWatchResult{Kind: endpointKind, WatchType: typed.KubeWatchResult_UPDATE})
+
+	recs := helper_drain(q)
+	if assert.Len(t, recs, 1) {
+		assert.Equal(t, "Pod", recs[0].Kind)
+	}
+}
+
+func Test_SpillQueue_DropsWhenFull(t *testing.T) {
+	q, cleanup := helper_newSpillQueue(t, SpillQueueConfig{MemoryRecords: 1, MaxSpillBytes: 60})
+	defer cleanup()
+	for _, rec := range helper_makeRecords(t, 5) {
+		q.push(rec)
+	}
+	// One in memory and one on disk, the rest did not fit
+	assert.Len(t, helper_drain(q), 2)
+
+	noSpill, cleanup2 := helper_newSpillQueue(t, SpillQueueConfig{MemoryRecords: 1})
+	defer cleanup2()
+	assert.Equal(t, "", noSpill.dir)
+	for _, rec := range helper_makeRecords(t, 3) {
+		noSpill.push(rec)
+	}
+	assert.Len(t, helper_drain(noSpill), 1)
+}
+
+func Test_SpillQueue_MetricsAreLabelledWithCluster(t *testing.T) {
+	a, cleanupA := helper_newSpillQueue(t, SpillQueueConfig{Cluster: "metrics-a", MemoryRecords: 1})
+	defer cleanupA()
+	b, cleanupB := helper_newSpillQueue(t, SpillQueueConfig{Cluster: "metrics-b", MemoryRecords: 1})
+	defer cleanupB()
+	droppedA := testutil.ToFloat64(metricIngressQueueDropcount.WithLabelValues("metrics-a", "Pod", "full"))
+	droppedB := testutil.ToFloat64(metricIngressQueueDropcount.WithLabelValues("metrics-b", "Pod", "full"))
+	for _, rec := range helper_makeRecords(t, 3) {
+		a.push(rec)
+	}
+	b.push(helper_makeRecords(t, 1)[0])
+
+	assert.Equal(t, droppedA+2, testutil.ToFloat64(metricIngressQueueDropcount.WithLabelValues("metrics-a", "Pod", "full")))
+	assert.Equal(t, droppedB, testutil.ToFloat64(metricIngressQueueDropcount.WithLabelValues("metrics-b", "Pod", "full")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(metricIngressQueueDepth.WithLabelValues("metrics-a", "memory")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(metricIngressQueueDepth.WithLabelValues("metrics-b", "memory")))
+}
+
+func Test_SpillQueue_StartDeliversAllAndCleansUp(t *testing.T) {
+	dir, err := ioutil.TempDir("", "spillqueue_test")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	inChan := make(chan typed.KubeWatchResult, 100)
+	outChan := make(chan typed.KubeWatchResult)
+	q, err := NewSpillQueue(SpillQueueConfig{Dir: dir, MemoryRecords: 3, MaxSpillBytes: 1024 * 1024, SegmentBytes: 200}, inChan, outChan)
+	assert.Nil(t, err)
+	q.Start()
+
+	// Nobody reads outChan yet, so the queue has to absorb all of these without blocking the sender
+	recs := helper_makeRecords(t, 50)
+	for _, rec := range recs {
+		inChan <- rec
+	}
+	close(inChan)
+
+	var actual []typed.KubeWatchResult
+	for rec := range outChan {
+		actual = append(actual, rec)
+	}
+	helper_assertRecordsEqual(t, recs, actual)
+	_, err = os.Stat(q.dir)
+	assert.True(t, os.IsNotExist(err))
+}
+
+func Test_NewSpillQueue_Invalid(t *testing.T) {
+	_, err := NewSpillQueue(SpillQueueConfig{}, nil, nil)
+	assert.NotNil(t, err)
+	_, err = NewSpillQueue(SpillQueueConfig{MemoryRecords: 1, DropUpdateKinds: []string{"["}}, nil, nil)
+	assert.NotNil(t, err)
+}
@@ -16,6 +16,17 @@ type KubeResourceSource interface {
 	Stop()
 }
 
+// Legacy playback format.  The whole recording is a single yaml document, so it is only supported for playback
 type KubePlaybackFile struct {
 	Data []typed.KubeWatchResult `json:"Data"`
 }
+
+// Formats supported for recording and playback of watch data
+const (
+	// One jsonpb encoded KubeWatchResult per line
+	RecordFormatNdjson = "ndjson"
+	// Each KubeWatchResult is a protobuf message prefixed with its length as a uvarint
+	RecordFormatProtobuf = "protobuf"
+	// The legacy KubePlaybackFile yaml document
+	RecordFormatYaml = "yaml"
+)
@@ -0,0 +1,392 @@
+/*
+ * Copyright (c) 2021, salesforce.com, inc.
+ * All rights reserved.
+ * SPDX-License-Identifier: BSD-3-Clause
+ * For full license text, see LICENSE.txt file in the repo root or https://opensource.org/licenses/BSD-3-Clause
+ */
+
+package kubeextractor
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Health severities.  The UI colors a state by its severity, so states of different kinds line up
+const (
+	HealthHealthy     = "healthy"
+	HealthProgressing = "progressing"
+	HealthWarning     = "warning"
+	HealthError       = "error"
+	HealthUnknown     = "unknown"
+)
+
+// Health is the state of an object as its status describes it, like Running or CrashLoopBackOff for a pod
+type Health struct {
+	State    string
+	Severity string
+	// Why the object is in this state, when the status says
+	Message string
+}
+
+type healthExtractor func(payload string) (*Health, error)
+
+var healthExtractors = map[string]healthExtractor{
+	PodKind:                   podHealth,
+	DeploymentKind:            deploymentHealth,
+	StatefulSetKind:           statefulSetHealth,
+	DaemonSetKind:             daemonSetHealth,
+	NodeKind:                  nodeHealth,
+	PersistentVolumeClaimKind: persistentVolumeClaimHealth,
+	JobKind:                   jobHealth,
+}
+
+// ExtractHealth returns the state of the object in payload, or nil for kinds that do not have one
+func ExtractHealth(kind string, payload string) (*Health, error) {
+	extractor, ok := healthExtractors[kind]
+	if !ok {
+		return nil, nil
+	}
+	health, err := extractor(payload)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to extract health of %v", kind)
+	}
+	return health, nil
+}
+
+type kubeCondition struct {
+	Type    string
+	Status  string
+	Reason  string
+	Message string
+}
+
+func findCondition(conditions []kubeCondition, conditionType string) *kubeCondition {
+	for idx := range conditions {
+		if conditions[idx].Type == conditionType {
+			return &conditions[idx]
+		}
+	}
+	return nil
+}
+
+func conditionMessage(condition *kubeCondition) string {
+	if condition.Reason == "" {
+		return condition.Message
+	}
+	if condition.Message == "" {
+		return condition.Reason
+	}
+	return fmt.Sprintf("%v: %v", condition.Reason, condition.Message)
+}
+
+type containerStatus struct {
+	Name  string
+	Ready bool
+	State struct {
+		Waiting *struct {
+			Reason  string
+			Message string
+		}
+	}
+}
+
+// Waiting reasons the kubelet does not get over by itself, like CrashLoopBackOff, ErrImagePull or
+// CreateContainerConfigError
+func isFailedWaitingReason(reason string) bool {
+	return strings.HasSuffix(reason, "BackOff") || strings.HasPrefix(reason, "Err") || strings.HasSuffix(reason, "Error") || reason == "InvalidImageName"
+}
+
+func podHealth(payload string) (*Health, error) {
+	pod := struct {
+		Metadata struct {
+			DeletionTimestamp string
+		}
+		Status struct {
+			Phase                 string
+			Reason                string
+			Message               string
+			Conditions            []kubeCondition
+			InitContainerStatuses []containerStatus
+			ContainerStatuses     []containerStatus
+		}
+	}{}
+	err := json.Unmarshal([]byte(payload), &pod)
+	if err != nil {
+		return nil, err
+	}
+	status := pod.Status
+
+	if pod.Metadata.DeletionTimestamp != "" {
+		return &Health{State: "Terminating", Severity: HealthWarning}, nil
+	}
+	switch status.Phase {
+	case "Succeeded":
+		return &Health{State: "Succeeded", Severity: HealthHealthy}, nil
+	case "Failed":
+		return &Health{State: "Failed", Severity: HealthError, Message: conditionMessage(&kubeCondition{Reason: status.Reason, Message: status.Message})}, nil
+	case "", "Pending", "Running":
+	default:
+		return &Health{State: status.Phase, Severity: HealthUnknown, Message: status.Message}, nil
+	}
+
+	// A container that keeps failing matters more than the phase of the pod
+	var waiting *Health
+	for _, container := range append(status.InitContainerStatuses, status.ContainerStatuses...) {
+		if container.State.Waiting == nil || container.State.Waiting.Reason == "" {
+			continue
+		}
+		reason := container.State.Waiting.Reason
+		message := "container " + container.Name
+		if container.State.Waiting.Message != "" {
+			message += ": " + container.State.Waiting.Message
+		}
+		if isFailedWaitingReason(reason) {
+			return &Health{State: reason, Severity: HealthError, Message: message}, nil
+		}
+		if waiting == nil {
+			waiting = &Health{State: reason, Severity: HealthProgressing, Message: message}
+		}
+	}
+
+	if status.Phase != "Running" {
+		if scheduled := findCondition(status.Conditions, "PodScheduled"); scheduled != nil && scheduled.Status == "False" {
+			return &Health{State: "Unschedulable", Severity: HealthError, Message: scheduled.Message}, nil
+		}
+		if waiting != nil {
+			return waiting, nil
+		}
+		return &Health{State: "Pending", Severity: HealthProgressing}, nil
+	}
+
+	notReady := []string{}
+	for _, container := range status.ContainerStatuses {
+		if !container.Ready {
+			notReady = append(notReady, container.Name)
+		}
+	}
+	if len(notReady) > 0 {
+		return &Health{State: "NotReady", Severity: HealthWarning, Message: "containers not ready: " + strings.Join(notReady, ", ")}, nil
+	}
+	return &Health{State: "Running", Severity: HealthHealthy}, nil
+}
+
+type workloadMetadata struct {
+	Name        string
+	Generation  int64
+	Annotations map[string]string
+}
+
+// Replicas defaults to 1 when the spec leaves it out
+func desiredReplicas(replicas *int32) int32 {
+	if replicas == nil {
+		return 1
+	}
+	return *replicas
+}
+
+// The pod template is kept as it is, to be compared whole
+type deploymentObject struct {
+	Metadata workloadMetadata
+	Spec     struct {
+		Replicas *int32
+		Paused   bool
+		Template json.RawMessage
+	}
+	Status struct {
+		ObservedGeneration int64
+		Replicas           int32
+		UpdatedReplicas    int32
+		AvailableReplicas  int32
+		Conditions         []kubeCondition
+	}
+}
+
+// Follows what kubectl rollout status waits for
+func (d *deploymentObject) rolledOut() bool {
+	status := d.Status
+	return status.ObservedGeneration >= d.Metadata.Generation && status.UpdatedReplicas >= desiredReplicas(d.Spec.Replicas) &&
+		status.Replicas <= status.UpdatedReplicas && status.AvailableReplicas >= status.UpdatedReplicas
+}
+
+type statefulSetObject struct {
+	Metadata workloadMetadata
+	Spec     struct {
+		Replicas       *int32
+		Template       json.RawMessage
+		UpdateStrategy struct {
+			Type string
+		}
+	}
+	Status struct {
+		ObservedGeneration int64
+		ReadyReplicas      int32
+		UpdatedReplicas    int32
+		CurrentRevision    string
+		UpdateRevision     string
+	}
+}
+
+// The controller moves the current revision to the update revision once every pod runs it
+func (s *statefulSetObject) revisionUpdated() bool {
+	return s.Status.UpdateRevision == "" || s.Status.CurrentRevision == s.Status.UpdateRevision
+}
+
+type daemonSetObject struct {
+	Metadata workloadMetadata
+	Spec     struct {
+		Template       json.RawMessage
+		UpdateStrategy struct {
+			Type string
+		}
+	}
+	Status struct {
+		ObservedGeneration     int64
+		DesiredNumberScheduled int32
+		UpdatedNumberScheduled int32
+		NumberAvailable        int32
+	}
+}
+
+func deploymentHealth(payload string) (*Health, error) {
+	deployment := deploymentObject{}
+	err := json.Unmarshal([]byte(payload), &deployment)
+	if err != nil {
+		return nil, err
+	}
+	status := deployment.Status
+
+	if progressing := findCondition(status.Conditions, "Progressing"); progressing != nil && progressing.Reason == "ProgressDeadlineExceeded" {
+		return &Health{State: "ProgressDeadlineExceeded", Severity: HealthError, Message: progressing.Message}, nil
+	}
+	if deployment.Spec.Paused {
+		return &Health{State: "Paused", Severity: HealthWarning}, nil
+	}
+	if available := findCondition(status.Conditions, "Available"); available != nil && available.Status == "False" {
+		return &Health{State: "Unavailable", Severity: HealthError, Message: conditionMessage(available)}, nil
+	}
+	if !deployment.rolledOut() {
+		return &Health{State: "RollingOut", Severity: HealthProgressing,
+			Message: fmt.Sprintf("%v of %v updated replicas are available", status.AvailableReplicas, desiredReplicas(deployment.Spec.Replicas))}, nil
+	}
+	return &Health{State: "Available", Severity: HealthHealthy}, nil
+}
+
+func statefulSetHealth(payload string) (*Health, error) {
+	statefulSet := statefulSetObject{}
+	err := json.Unmarshal([]byte(payload), &statefulSet)
+	if err != nil {
+		return nil, err
+	}
+	status := statefulSet.Status
+	desired := desiredReplicas(statefulSet.Spec.Replicas)
+
+	// With OnDelete nothing rolls out until someone deletes the pods, so only readiness counts
+	rollingUpdate := statefulSet.Spec.UpdateStrategy.Type != "OnDelete"
+	if status.ObservedGeneration < statefulSet.Metadata.Generation || (rollingUpdate && !statefulSet.revisionUpdated()) {
+		return &Health{State: "RollingOut", Severity: HealthProgressing,
+			Message: fmt.Sprintf("%v of %v replicas are updated", status.UpdatedReplicas, desired)}, nil
+	}
+	if status.ReadyReplicas < desired {
+		return &Health{State: "Degraded", Severity: HealthWarning, Message: fmt.Sprintf("%v of %v replicas are ready", status.ReadyReplicas, desired)}, nil
+	}
+	return &Health{State: "Available", Severity: HealthHealthy}, nil
+}
+
+func daemonSetHealth(payload string) (*Health, error) {
+	daemonSet := daemonSetObject{}
+	err := json.Unmarshal([]byte(payload), &daemonSet)
+	if err != nil {
+		return nil, err
+	}
+	status := daemonSet.Status
+
+	rollingUpdate := daemonSet.Spec.UpdateStrategy.Type != "OnDelete"
+	if status.ObservedGeneration < daemonSet.Metadata.Generation || (rollingUpdate && status.UpdatedNumberScheduled < status.DesiredNumberScheduled) {
+		return &Health{State: "RollingOut", Severity: HealthProgressing,
+			Message: fmt.Sprintf("%v of %v scheduled pods are updated", status.UpdatedNumberScheduled, status.DesiredNumberScheduled)}, nil
+	}
+	if status.NumberAvailable < status.DesiredNumberScheduled {
+		return &Health{State: "Degraded", Severity: HealthWarning,
+			Message: fmt.Sprintf("%v of %v scheduled pods are available", status.NumberAvailable, status.DesiredNumberScheduled)}, nil
+	}
+	return &Health{State: "Available", Severity: HealthHealthy}, nil
+}
+
+func nodeHealth(payload string) (*Health, error) {
+	node := struct {
+		Spec struct {
+			Unschedulable bool
+		}
+		Status struct {
+			Conditions []kubeCondition
+		}
+	}{}
+	err := json.Unmarshal([]byte(payload), &node)
+	if err != nil {
+		return nil, err
+	}
+
+	ready := findCondition(node.Status.Conditions, "Ready")
+	switch {
+	case ready == nil:
+		return &Health{State: "Unknown", Severity: HealthUnknown}, nil
+	case ready.Status == "False":
+		return &Health{State: "NotReady", Severity: HealthError, Message: conditionMessage(ready)}, nil
+	case ready.Status != "True":
+		return &Health{State: "Unknown", Severity: HealthUnknown, Message: conditionMessage(ready)}, nil
+	case node.Spec.Unschedulable:
+		return &Health{State: "SchedulingDisabled", Severity: HealthWarning}, nil
+	}
+	return &Health{State: "Ready", Severity: HealthHealthy}, nil
+}
+
+func persistentVolumeClaimHealth(payload string) (*Health, error) {
+	claim := struct {
+		Status struct {
+			Phase string
+		}
+	}{}
+	err := json.Unmarshal([]byte(payload), &claim)
+	if err != nil {
+		return nil, err
+	}
+
+	switch claim.Status.Phase {
+	case "Bound":
+		return &Health{State: "Bound", Severity: HealthHealthy}, nil
+	case "", "Pending":
+		return &Health{State: "Pending", Severity: HealthProgressing}, nil
+	case "Lost":
+		return &Health{State: "Lost", Severity: HealthError}, nil
+	}
+	return &Health{State: claim.Status.Phase, Severity: HealthUnknown}, nil
+}
+
+func jobHealth(payload string) (*Health, error) {
+	job := struct {
+		Status struct {
+			Active     int32
+			Conditions []kubeCondition
+		}
+	}{}
+	err := json.Unmarshal([]byte(payload), &job)
+	if err != nil {
+		return nil, err
+	}
+
+	conditions := job.Status.Conditions
+	if failed := findCondition(conditions, "Failed"); failed != nil && failed.Status == "True" {
+		return &Health{State: "Failed", Severity: HealthError, Message: conditionMessage(failed)}, nil
+	}
+	if complete := findCondition(conditions, "Complete"); complete != nil && complete.Status == "True" {
+		return &Health{State: "Complete", Severity: HealthHealthy}, nil
+	}
+	if suspended := findCondition(conditions, "Suspended"); suspended != nil && suspended.Status == "True" {
+		return &Health{State: "Suspended", Severity: HealthWarning}, nil
+	}
+	return &Health{State: "Running", Severity: HealthProgressing, Message: fmt.Sprintf("%v active pods", job.Status.Active)}, nil
+}
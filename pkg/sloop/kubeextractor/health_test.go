@@ -0,0 +1,101 @@
+/*
+ * Copyright (c) 2021, salesforce.com, inc.
+ * All rights reserved.
+ * SPDX-License-Identifier: BSD-3-Clause
+ * For full license text, see LICENSE.txt file in the repo root or https://opensource.org/licenses/BSD-3-Clause
+ */
+
+package kubeextractor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func helper_extractHealth(t *testing.T, kind string, payload string) Health {
+	health, err := ExtractHealth(kind, payload)
+	assert.Nil(t, err)
+	if assert.NotNil(t, health) {
+		return *health
+	}
+	return Health{}
+}
+
+func Test_ExtractHealth_Pod(t *testing.T) {
+	assert.Equal(t, Health{State: "Pending", Severity: HealthProgressing}, helper_extractHealth(t, PodKind, `{"metadata": {"name": "p"}}`))
+	assert.Equal(t, Health{State: "Unschedulable", Severity: HealthError, Message: "0/3 nodes are available"},
+		helper_extractHealth(t, PodKind, `{"status": {"phase": "Pending", "conditions": [{"type": "PodScheduled", "status": "False", "reason": "Unschedulable", "message": "0/3 nodes are available"}]}}`))
+	assert.Equal(t, Health{State: "ContainerCreating", Severity: HealthProgressing, Message: "container app"},
+		helper_extractHealth(t, PodKind, `{"status": {"phase": "Pending", "containerStatuses": [{"name": "app", "state": {"waiting": {"reason": "ContainerCreating"}}}]}}`))
+	assert.Equal(t, Health{State: "ImagePullBackOff", Severity: HealthError, Message: "container init: Back-off pulling image"},
+		helper_extractHealth(t, PodKind, `{"status": {"phase": "Pending", "initContainerStatuses": [{"name": "init", "state": {"waiting": {"reason": "ImagePullBackOff", "message": "Back-off pulling image"}}}]}}`))
+	assert.Equal(t, Health{State: "CrashLoopBackOff", Severity: HealthError, Message: "container app: back-off 5m0s"},
+		helper_extractHealth(t, PodKind, `{"status": {"phase": "Running", "containerStatuses": [{"name": "sidecar", "ready": true, "state": {"running": {}}}, {"name": "app", "state": {"waiting": {"reason": "CrashLoopBackOff", "message": "back-off 5m0s"}}}]}}`))
+	assert.Equal(t, Health{State: "NotReady", Severity: HealthWarning, Message: "containers not ready: app"},
+		helper_extractHealth(t, PodKind, `{"status": {"phase": "Running", "containerStatuses": [{"name": "app", "ready": false, "state": {"running": {}}}]}}`))
+	assert.Equal(t, Health{State: "Running", Severity: HealthHealthy},
+		helper_extractHealth(t, PodKind, `{"status": {"phase": "Running", "containerStatuses": [{"name": "app", "ready": true, "state": {"running": {}}}]}}`))
+	assert.Equal(t, Health{State: "Terminating", Severity: HealthWarning},
+		helper_extractHealth(t, PodKind, `{"metadata": {"deletionTimestamp": "2019-03-04T03:04:05Z"}, "status": {"phase": "Running"}}`))
+	assert.Equal(t, Health{State: "Failed", Severity: HealthError, Message: "Evicted: The node was low on resource: memory."},
+		helper_extractHealth(t, PodKind, `{"status": {"phase": "Failed", "reason": "Evicted", "message": "The node was low on resource: memory."}}`))
+	assert.Equal(t, Health{State: "Succeeded", Severity: HealthHealthy}, helper_extractHealth(t, PodKind, `{"status": {"phase": "Succeeded"}}`))
+}
+
+func Test_ExtractHealth_Deployment(t *testing.T) {
+	rollingOut := `{"metadata": {"generation": 4}, "spec": {"replicas": 3}, "status": {"observedGeneration": 4, "replicas": 4, "updatedReplicas": 2, "availableReplicas": 3,
+  "conditions": [{"type": "Available", "status": "True"}, {"type": "Progressing", "status": "True", "reason": "ReplicaSetUpdated"}]}}`
+	assert.Equal(t, Health{State: "RollingOut", Severity: HealthProgressing, Message: "3 of 3 updated replicas are available"}, helper_extractHealth(t, DeploymentKind, rollingOut))
+	notObserved := `{"metadata": {"generation": 5}, "status": {"observedGeneration": 4, "replicas": 1, "updatedReplicas": 1, "availableReplicas": 1}}`
+	assert.Equal(t, "RollingOut", helper_extractHealth(t, DeploymentKind, notObserved).State)
+	available := `{"metadata": {"generation": 4}, "spec": {"replicas": 3}, "status": {"observedGeneration": 4, "replicas": 3, "updatedReplicas": 3, "availableReplicas": 3,
+  "conditions": [{"type": "Available", "status": "True"}]}}`
+	assert.Equal(t, Health{State: "Available", Severity: HealthHealthy}, helper_extractHealth(t, DeploymentKind, available))
+	unavailable := `{"spec": {"replicas": 3}, "status": {"conditions": [{"type": "Available", "status": "False", "reason": "MinimumReplicasUnavailable", "message": "Deployment does not have minimum availability."}]}}`
+	assert.Equal(t, Health{State: "Unavailable", Severity: HealthError, Message: "MinimumReplicasUnavailable: Deployment does not have minimum availability."}, helper_extractHealth(t, DeploymentKind, unavailable))
+	stuck := `{"status": {"conditions": [{"type": "Progressing", "status": "False", "reason": "ProgressDeadlineExceeded", "message": "ReplicaSet \"web-1\" has timed out progressing."}]}}`
+	assert.Equal(t, Health{State: "ProgressDeadlineExceeded", Severity: HealthError, Message: `ReplicaSet "web-1" has timed out progressing.`}, helper_extractHealth(t, DeploymentKind, stuck))
+	assert.Equal(t, "Paused", helper_extractHealth(t, DeploymentKind, `{"spec": {"paused": true}}`).State)
+}
+
+func Test_ExtractHealth_StatefulSetDaemonSet(t *testing.T) {
+	updating := `{"metadata": {"generation": 2}, "spec": {"replicas": 3}, "status": {"observedGeneration": 2, "readyReplicas": 3, "updatedReplicas": 1, "currentRevision": "web-1", "updateRevision": "web-2"}}`
+	assert.Equal(t, Health{State: "RollingOut", Severity: HealthProgressing, Message: "1 of 3 replicas are updated"}, helper_extractHealth(t, StatefulSetKind, updating))
+	onDelete := `{"metadata": {"generation": 2}, "spec": {"replicas": 3, "updateStrategy": {"type": "OnDelete"}}, "status": {"observedGeneration": 2, "readyReplicas": 3, "currentRevision": "web-1", "updateRevision": "web-2"}}`
+	assert.Equal(t, Health{State: "Available", Severity: HealthHealthy}, helper_extractHealth(t, StatefulSetKind, onDelete))
+	degraded := `{"spec": {"replicas": 3}, "status": {"readyReplicas": 2, "currentRevision": "web-2", "updateRevision": "web-2"}}`
+	assert.Equal(t, Health{State: "Degraded", Severity: HealthWarning, Message: "2 of 3 replicas are ready"}, helper_extractHealth(t, StatefulSetKind, degraded))
+
+	assert.Equal(t, "RollingOut", helper_extractHealth(t, DaemonSetKind, `{"status": {"desiredNumberScheduled": 5, "updatedNumberScheduled": 4, "numberAvailable": 5}}`).State)
+	assert.Equal(t, Health{State: "Degraded", Severity: HealthWarning, Message: "4 of 5 scheduled pods are available"},
+		helper_extractHealth(t, DaemonSetKind, `{"status": {"desiredNumberScheduled": 5, "updatedNumberScheduled": 5, "numberAvailable": 4}}`))
+	assert.Equal(t, "Available", helper_extractHealth(t, DaemonSetKind, `{"metadata": {"generation": 7}, "status": {"observedGeneration": 7, "desiredNumberScheduled": 5, "updatedNumberScheduled": 5, "numberAvailable": 5}}`).State)
+}
+
+func Test_ExtractHealth_NodeClaimJob(t *testing.T) {
+	assert.Equal(t, Health{State: "Ready", Severity: HealthHealthy}, helper_extractHealth(t, NodeKind, `{"status": {"conditions": [{"type": "MemoryPressure", "status": "False"}, {"type": "Ready", "status": "True"}]}}`))
+	assert.Equal(t, Health{State: "NotReady", Severity: HealthError, Message: "KubeletNotReady: PLEG is not healthy"},
+		helper_extractHealth(t, NodeKind, `{"status": {"conditions": [{"type": "Ready", "status": "False", "reason": "KubeletNotReady", "message": "PLEG is not healthy"}]}}`))
+	assert.Equal(t, Health{State: "Unknown", Severity: HealthUnknown, Message: "NodeStatusUnknown: Kubelet stopped posting node status."},
+		helper_extractHealth(t, NodeKind, `{"status": {"conditions": [{"type": "Ready", "status": "Unknown", "reason": "NodeStatusUnknown", "message": "Kubelet stopped posting node status."}]}}`))
+	assert.Equal(t, "SchedulingDisabled", helper_extractHealth(t, NodeKind, `{"spec": {"unschedulable": true}, "status": {"conditions": [{"type": "Ready", "status": "True"}]}}`).State)
+
+	assert.Equal(t, Health{State: "Bound", Severity: HealthHealthy}, helper_extractHealth(t, PersistentVolumeClaimKind, `{"status": {"phase": "Bound"}}`))
+	assert.Equal(t, Health{State: "Pending", Severity: HealthProgressing}, helper_extractHealth(t, PersistentVolumeClaimKind, `{"status": {"phase": "Pending"}}`))
+	assert.Equal(t, Health{State: "Lost", Severity: HealthError}, helper_extractHealth(t, PersistentVolumeClaimKind, `{"status": {"phase": "Lost"}}`))
+
+	assert.Equal(t, Health{State: "Running", Severity: HealthProgressing, Message: "2 active pods"}, helper_extractHealth(t, JobKind, `{"status": {"active": 2}}`))
+	assert.Equal(t, Health{State: "Complete", Severity: HealthHealthy}, helper_extractHealth(t, JobKind, `{"status": {"conditions": [{"type": "Complete", "status": "True"}]}}`))
+	assert.Equal(t, Health{State: "Failed", Severity: HealthError, Message: "BackoffLimitExceeded: Job has reached the specified backoff limit"},
+		helper_extractHealth(t, JobKind, `{"status": {"conditions": [{"type": "Failed", "status": "True", "reason": "BackoffLimitExceeded", "message": "Job has reached the specified backoff limit"}]}}`))
+}
+
+func Test_ExtractHealth_OtherKindsAndBadPayloads(t *testing.T) {
+	health, err := ExtractHealth(ConfigMapKind, `{"data": {}}`)
+	assert.Nil(t, err)
+	assert.Nil(t, health)
+
+	_, err = ExtractHealth(PodKind, `{"status": "Running"}`)
+	assert.NotNil(t, err)
+}
@@ -8,8 +8,21 @@
 package kubeextractor
 
 const (
-	NodeKind      = "Node"
-	NamespaceKind = "Namespace"
-	PodKind       = "Pod"
-	EventKind     = "Event"
+	NodeKind                    = "Node"
+	NamespaceKind               = "Namespace"
+	PodKind                     = "Pod"
+	EventKind                   = "Event"
+	ServiceKind                 = "Service"
+	ServiceAccountKind          = "ServiceAccount"
+	ConfigMapKind               = "ConfigMap"
+	SecretKind                  = "Secret"
+	PersistentVolumeKind        = "PersistentVolume"
+	PersistentVolumeClaimKind   = "PersistentVolumeClaim"
+	IngressKind                 = "Ingress"
+	HorizontalPodAutoscalerKind = "HorizontalPodAutoscaler"
+	DeploymentKind              = "Deployment"
+	StatefulSetKind             = "StatefulSet"
+	DaemonSetKind               = "DaemonSet"
+	JobKind                     = "Job"
+	ReplicaSetKind              = "ReplicaSet"
 )
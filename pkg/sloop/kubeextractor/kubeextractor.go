@@ -19,6 +19,8 @@ type KubeMetadata struct {
 	ResourceVersion   string
 	CreationTimestamp string
 	OwnerReferences   []KubeMetadataOwnerReference
+	Labels            map[string]string
+	Annotations       map[string]string
 }
 
 type KubeInvolvedObject struct {
@@ -0,0 +1,330 @@
+/*
+ * Copyright (c) 2021, salesforce.com, inc.
+ * All rights reserved.
+ * SPDX-License-Identifier: BSD-3-Clause
+ * For full license text, see LICENSE.txt file in the repo root or https://opensource.org/licenses/BSD-3-Clause
+ */
+
+package kubeextractor
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Relationship types say which part of the payload points at the target
+const (
+	RelationshipOwner           = "owner"
+	RelationshipNodeName        = "nodeName"
+	RelationshipServiceAccount  = "serviceAccount"
+	RelationshipVolume          = "volume"
+	RelationshipVolumeClaim     = "volumeClaim"
+	RelationshipVolumeName      = "volumeName"
+	RelationshipEnv             = "env"
+	RelationshipImagePullSecret = "imagePullSecret"
+	RelationshipSelector        = "selector"
+	RelationshipBackend         = "backend"
+	RelationshipScaleTarget     = "scaleTarget"
+	// Points from an object at an event about it.  Events get no resource summary, so processing keeps this edge on
+	// the summary of the object when it counts the event.  Queries turn it around
+	RelationshipInvolvedObject = "involvedObject"
+)
+
+// Relationship is an edge from an object to another one it names in its payload
+type Relationship struct {
+	Type      string
+	Kind      string
+	Namespace string
+	Name      string
+	// Empty when the payload only has the name, like spec.nodeName
+	Uid string
+	// Set instead of Name for targets picked by labels, like the pods of a service.  Sorted key=value pairs joined
+	// by commas
+	Selector string
+}
+
+// RelationshipExtractor finds the relationships in the payload of an object of one kind.  metadata is already
+// extracted from the same payload
+type RelationshipExtractor func(payload string, metadata KubeMetadata) ([]Relationship, error)
+
+// Events have none here.  They get no resource summary, so their edge is added to the summary of the object they are
+// about instead.  See RelationshipInvolvedObject
+var relationshipExtractors = map[string][]RelationshipExtractor{
+	PodKind:                     {podRelationships},
+	PersistentVolumeClaimKind:   {persistentVolumeClaimRelationships},
+	ServiceKind:                 {serviceRelationships},
+	IngressKind:                 {ingressRelationships},
+	HorizontalPodAutoscalerKind: {horizontalPodAutoscalerRelationships},
+}
+
+// RegisterRelationshipExtractor adds an extractor for kind next to the ones that are already there.  Not safe to
+// call once processing has started
+func RegisterRelationshipExtractor(kind string, extractor RelationshipExtractor) {
+	relationshipExtractors[kind] = append(relationshipExtractors[kind], extractor)
+}
+
+// ExtractRelationships returns the owner references of any kind, followed by what the extractors for kind find.
+// Each relationship is only returned once
+func ExtractRelationships(kind string, payload string, metadata KubeMetadata) ([]Relationship, error) {
+	relationships := []Relationship{}
+	for _, ref := range metadata.OwnerReferences {
+		relationships = append(relationships, Relationship{Type: RelationshipOwner, Kind: ref.Kind, Namespace: metadata.Namespace, Name: ref.Name, Uid: ref.Uid})
+	}
+	for _, extractor := range relationshipExtractors[kind] {
+		found, err := extractor(payload, metadata)
+		if err != nil {
+			return relationships, errors.Wrapf(err, "failed to extract relationships for %v %v/%v", kind, metadata.Namespace, metadata.Name)
+		}
+		relationships = append(relationships, found...)
+	}
+	return dedupeRelationships(relationships), nil
+}
+
+func dedupeRelationships(relationships []Relationship) []Relationship {
+	seen := map[Relationship]bool{}
+	ret := []Relationship{}
+	for _, relationship := range relationships {
+		if !seen[relationship] {
+			seen[relationship] = true
+			ret = append(ret, relationship)
+		}
+	}
+	return ret
+}
+
+type nameRef struct {
+	Name string
+}
+
+type containerRefs struct {
+	EnvFrom []struct {
+		ConfigMapRef *nameRef
+		SecretRef    *nameRef
+	}
+	Env []struct {
+		ValueFrom *struct {
+			ConfigMapKeyRef *nameRef
+			SecretKeyRef    *nameRef
+		}
+	}
+}
+
+func podRelationships(payload string, metadata KubeMetadata) ([]Relationship, error) {
+	pod := struct {
+		Spec struct {
+			NodeName           string
+			ServiceAccountName string
+			ImagePullSecrets   []nameRef
+			Volumes            []struct {
+				PersistentVolumeClaim *struct{ ClaimName string }
+				ConfigMap             *nameRef
+				Secret                *struct{ SecretName string }
+				Projected             *struct {
+					Sources []struct {
+						ConfigMap *nameRef
+						Secret    *nameRef
+					}
+				}
+			}
+			InitContainers []containerRefs
+			Containers     []containerRefs
+		}
+	}{}
+	err := json.Unmarshal([]byte(payload), &pod)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := []Relationship{}
+	add := func(relType string, kind string, namespace string, name string) {
+		if name != "" {
+			ret = append(ret, Relationship{Type: relType, Kind: kind, Namespace: namespace, Name: name})
+		}
+	}
+	spec := pod.Spec
+	add(RelationshipNodeName, NodeKind, "", spec.NodeName)
+	add(RelationshipServiceAccount, ServiceAccountKind, metadata.Namespace, spec.ServiceAccountName)
+	for _, secret := range spec.ImagePullSecrets {
+		add(RelationshipImagePullSecret, SecretKind, metadata.Namespace, secret.Name)
+	}
+	for _, volume := range spec.Volumes {
+		if volume.PersistentVolumeClaim != nil {
+			add(RelationshipVolumeClaim, PersistentVolumeClaimKind, metadata.Namespace, volume.PersistentVolumeClaim.ClaimName)
+		}
+		if volume.ConfigMap != nil {
+			add(RelationshipVolume, ConfigMapKind, metadata.Namespace, volume.ConfigMap.Name)
+		}
+		if volume.Secret != nil {
+			add(RelationshipVolume, SecretKind, metadata.Namespace, volume.Secret.SecretName)
+		}
+		if volume.Projected != nil {
+			for _, source := range volume.Projected.Sources {
+				if source.ConfigMap != nil {
+					add(RelationshipVolume, ConfigMapKind, metadata.Namespace, source.ConfigMap.Name)
+				}
+				if source.Secret != nil {
+					add(RelationshipVolume, SecretKind, metadata.Namespace, source.Secret.Name)
+				}
+			}
+		}
+	}
+	for _, container := range append(spec.InitContainers, spec.Containers...) {
+		for _, envFrom := range container.EnvFrom {
+			if envFrom.ConfigMapRef != nil {
+				add(RelationshipEnv, ConfigMapKind, metadata.Namespace, envFrom.ConfigMapRef.Name)
+			}
+			if envFrom.SecretRef != nil {
+				add(RelationshipEnv, SecretKind, metadata.Namespace, envFrom.SecretRef.Name)
+			}
+		}
+		for _, env := range container.Env {
+			if env.ValueFrom == nil {
+				continue
+			}
+			if env.ValueFrom.ConfigMapKeyRef != nil {
+				add(RelationshipEnv, ConfigMapKind, metadata.Namespace, env.ValueFrom.ConfigMapKeyRef.Name)
+			}
+			if env.ValueFrom.SecretKeyRef != nil {
+				add(RelationshipEnv, SecretKind, metadata.Namespace, env.ValueFrom.SecretKeyRef.Name)
+			}
+		}
+	}
+	return ret, nil
+}
+
+// The claim is bound to a volume, which is not namespaced
+func persistentVolumeClaimRelationships(payload string, metadata KubeMetadata) ([]Relationship, error) {
+	claim := struct {
+		Spec struct {
+			VolumeName string
+		}
+	}{}
+	err := json.Unmarshal([]byte(payload), &claim)
+	if err != nil {
+		return nil, err
+	}
+	if claim.Spec.VolumeName == "" {
+		return nil, nil
+	}
+	return []Relationship{{Type: RelationshipVolumeName, Kind: PersistentVolumeKind, Name: claim.Spec.VolumeName}}, nil
+}
+
+// The pods of a service are only known by their labels, so this is a selector that is matched at query time
+func serviceRelationships(payload string, metadata KubeMetadata) ([]Relationship, error) {
+	service := struct {
+		Spec struct {
+			Selector map[string]string
+		}
+	}{}
+	err := json.Unmarshal([]byte(payload), &service)
+	if err != nil {
+		return nil, err
+	}
+	if len(service.Spec.Selector) == 0 {
+		return nil, nil
+	}
+	return []Relationship{{Type: RelationshipSelector, Kind: PodKind, Namespace: metadata.Namespace, Selector: FormatSelector(service.Spec.Selector)}}, nil
+}
+
+// FormatSelector writes labels the way Relationship.Selector keeps them
+func FormatSelector(labels map[string]string) string {
+	pairs := []string{}
+	for key, value := range labels {
+		pairs = append(pairs, fmt.Sprintf("%v=%v", key, value))
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+// ParseSelector reads back a selector written by FormatSelector
+func ParseSelector(selector string) map[string]string {
+	labels := map[string]string{}
+	if selector == "" {
+		return labels
+	}
+	for _, pair := range strings.Split(selector, ",") {
+		parts := strings.SplitN(pair, "=", 2)
+		if len(parts) == 2 {
+			labels[parts[0]] = parts[1]
+		}
+	}
+	return labels
+}
+
+// Ingress backends are spec.backend.serviceName up to extensions/v1beta1 and networking.k8s.io/v1beta1, and
+// spec.defaultBackend.service.name from networking.k8s.io/v1
+type ingressBackend struct {
+	ServiceName string
+	Service     *nameRef
+}
+
+func (b *ingressBackend) serviceName() string {
+	if b == nil {
+		return ""
+	}
+	if b.Service != nil {
+		return b.Service.Name
+	}
+	return b.ServiceName
+}
+
+func ingressRelationships(payload string, metadata KubeMetadata) ([]Relationship, error) {
+	ingress := struct {
+		Spec struct {
+			Backend        *ingressBackend
+			DefaultBackend *ingressBackend
+			Rules          []struct {
+				Http *struct {
+					Paths []struct {
+						Backend *ingressBackend
+					}
+				}
+			}
+		}
+	}{}
+	err := json.Unmarshal([]byte(payload), &ingress)
+	if err != nil {
+		return nil, err
+	}
+
+	backends := []*ingressBackend{ingress.Spec.Backend, ingress.Spec.DefaultBackend}
+	for _, rule := range ingress.Spec.Rules {
+		if rule.Http == nil {
+			continue
+		}
+		for _, path := range rule.Http.Paths {
+			backends = append(backends, path.Backend)
+		}
+	}
+	ret := []Relationship{}
+	for _, backend := range backends {
+		if name := backend.serviceName(); name != "" {
+			ret = append(ret, Relationship{Type: RelationshipBackend, Kind: ServiceKind, Namespace: metadata.Namespace, Name: name})
+		}
+	}
+	return ret, nil
+}
+
+func horizontalPodAutoscalerRelationships(payload string, metadata KubeMetadata) ([]Relationship, error) {
+	hpa := struct {
+		Spec struct {
+			ScaleTargetRef struct {
+				Kind string
+				Name string
+			}
+		}
+	}{}
+	err := json.Unmarshal([]byte(payload), &hpa)
+	if err != nil {
+		return nil, err
+	}
+	target := hpa.Spec.ScaleTargetRef
+	if target.Kind == "" || target.Name == "" {
+		return nil, nil
+	}
+	return []Relationship{{Type: RelationshipScaleTarget, Kind: target.Kind, Namespace: metadata.Namespace, Name: target.Name}}, nil
+}
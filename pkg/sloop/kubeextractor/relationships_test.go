@@ -0,0 +1,118 @@
+/*
+ * Copyright (c) 2021, salesforce.com, inc.
+ * All rights reserved.
+ * SPDX-License-Identifier: BSD-3-Clause
+ * For full license text, see LICENSE.txt file in the repo root or https://opensource.org/licenses/BSD-3-Clause
+ */
+
+package kubeextractor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func helper_extractRelationships(t *testing.T, kind string, payload string) []Relationship {
+	metadata, err := ExtractMetadata(payload)
+	assert.Nil(t, err)
+	relationships, err := ExtractRelationships(kind, payload, metadata)
+	assert.Nil(t, err)
+	return relationships
+}
+
+func Test_ExtractRelationships_Pod(t *testing.T) {
+	payload := `{
+  "metadata": {"name": "web-0", "namespace": "ns", "uid": "uid-web-0",
+    "ownerReferences": [{"kind": "StatefulSet", "name": "web", "uid": "uid-web"}]},
+  "spec": {
+    "nodeName": "node1",
+    "serviceAccountName": "web-sa",
+    "imagePullSecrets": [{"name": "registry"}],
+    "volumes": [
+      {"name": "data", "persistentVolumeClaim": {"claimName": "data-web-0"}},
+      {"name": "config", "configMap": {"name": "web-config"}},
+      {"name": "certs", "secret": {"secretName": "web-certs"}},
+      {"name": "token", "projected": {"sources": [{"secret": {"name": "token"}}, {"configMap": {"name": "ca"}}, {"serviceAccountToken": {"path": "t"}}]}},
+      {"name": "tmp", "emptyDir": {}}
+    ],
+    "initContainers": [{"name": "init", "envFrom": [{"configMapRef": {"name": "web-config"}}]}],
+    "containers": [
+      {"name": "web", "envFrom": [{"secretRef": {"name": "web-env"}}],
+        "env": [{"name": "A", "value": "a"}, {"name": "B", "valueFrom": {"configMapKeyRef": {"name": "web-config", "key": "b"}}}]},
+      {"name": "sidecar", "env": [{"name": "C", "valueFrom": {"secretKeyRef": {"name": "web-env", "key": "c"}}}]}
+    ]
+  }
+}`
+	expected := []Relationship{
+		{Type: RelationshipOwner, Kind: "StatefulSet", Namespace: "ns", Name: "web", Uid: "uid-web"},
+		{Type: RelationshipNodeName, Kind: NodeKind, Name: "node1"},
+		{Type: RelationshipServiceAccount, Kind: ServiceAccountKind, Namespace: "ns", Name: "web-sa"},
+		{Type: RelationshipImagePullSecret, Kind: SecretKind, Namespace: "ns", Name: "registry"},
+		{Type: RelationshipVolumeClaim, Kind: PersistentVolumeClaimKind, Namespace: "ns", Name: "data-web-0"},
+		{Type: RelationshipVolume, Kind: ConfigMapKind, Namespace: "ns", Name: "web-config"},
+		{Type: RelationshipVolume, Kind: SecretKind, Namespace: "ns", Name: "web-certs"},
+		{Type: RelationshipVolume, Kind: SecretKind, Namespace: "ns", Name: "token"},
+		{Type: RelationshipVolume, Kind: ConfigMapKind, Namespace: "ns", Name: "ca"},
+		{Type: RelationshipEnv, Kind: ConfigMapKind, Namespace: "ns", Name: "web-config"},
+		{Type: RelationshipEnv, Kind: SecretKind, Namespace: "ns", Name: "web-env"},
+	}
+	assert.Equal(t, expected, helper_extractRelationships(t, PodKind, payload))
+}
+
+func Test_ExtractRelationships_ClaimServiceIngressAutoscaler(t *testing.T) {
+	claim := `{"metadata": {"name": "data-web-0", "namespace": "ns"}, "spec": {"volumeName": "pvc-1234"}}`
+	assert.Equal(t, []Relationship{{Type: RelationshipVolumeName, Kind: PersistentVolumeKind, Name: "pvc-1234"}}, helper_extractRelationships(t, PersistentVolumeClaimKind, claim))
+	pending := `{"metadata": {"name": "data-web-1", "namespace": "ns"}, "spec": {}}`
+	assert.Equal(t, []Relationship{}, helper_extractRelationships(t, PersistentVolumeClaimKind, pending))
+
+	service := `{"metadata": {"name": "web", "namespace": "ns"}, "spec": {"selector": {"tier": "front", "app": "web"}}}`
+	assert.Equal(t, []Relationship{{Type: RelationshipSelector, Kind: PodKind, Namespace: "ns", Selector: "app=web,tier=front"}}, helper_extractRelationships(t, ServiceKind, service))
+	headless := `{"metadata": {"name": "external", "namespace": "ns"}, "spec": {"type": "ExternalName"}}`
+	assert.Equal(t, []Relationship{}, helper_extractRelationships(t, ServiceKind, headless))
+
+	ingressV1beta1 := `{"metadata": {"name": "web", "namespace": "ns"}, "spec": {"backend": {"serviceName": "default", "servicePort": 80},
+  "rules": [{"host": "a.example.com", "http": {"paths": [{"path": "/", "backend": {"serviceName": "web", "servicePort": 80}}]}}, {"host": "b.example.com"}]}}`
+	assert.Equal(t, []Relationship{
+		{Type: RelationshipBackend, Kind: ServiceKind, Namespace: "ns", Name: "default"},
+		{Type: RelationshipBackend, Kind: ServiceKind, Namespace: "ns", Name: "web"},
+	}, helper_extractRelationships(t, IngressKind, ingressV1beta1))
+	ingressV1 := `{"metadata": {"name": "web", "namespace": "ns"}, "spec": {"defaultBackend": {"service": {"name": "default", "port": {"number": 80}}},
+  "rules": [{"http": {"paths": [{"path": "/", "pathType": "Prefix", "backend": {"service": {"name": "web", "port": {"number": 80}}}}, {"path": "/static", "backend": {"resource": {"kind": "StorageBucket", "name": "static"}}}]}}]}}`
+	assert.Equal(t, []Relationship{
+		{Type: RelationshipBackend, Kind: ServiceKind, Namespace: "ns", Name: "default"},
+		{Type: RelationshipBackend, Kind: ServiceKind, Namespace: "ns", Name: "web"},
+	}, helper_extractRelationships(t, IngressKind, ingressV1))
+
+	hpa := `{"metadata": {"name": "web", "namespace": "ns"}, "spec": {"scaleTargetRef": {"apiVersion": "apps/v1", "kind": "Deployment", "name": "web"}, "maxReplicas": 10}}`
+	assert.Equal(t, []Relationship{{Type: RelationshipScaleTarget, Kind: "Deployment", Namespace: "ns", Name: "web"}}, helper_extractRelationships(t, HorizontalPodAutoscalerKind, hpa))
+}
+
+func Test_ExtractRelationships_OtherKindsOnlyHaveOwners(t *testing.T) {
+	replicaSet := `{"metadata": {"name": "web-1234", "namespace": "ns", "ownerReferences": [{"kind": "Deployment", "name": "web", "uid": "uid-web"}]}, "spec": {"nodeName": "ignored"}}`
+	assert.Equal(t, []Relationship{{Type: RelationshipOwner, Kind: "Deployment", Namespace: "ns", Name: "web", Uid: "uid-web"}}, helper_extractRelationships(t, "ReplicaSet", replicaSet))
+}
+
+func Test_ExtractRelationships_BadPayloadKeepsOwners(t *testing.T) {
+	metadata := KubeMetadata{Name: "web", Namespace: "ns", OwnerReferences: []KubeMetadataOwnerReference{{Kind: "Deployment", Name: "web", Uid: "uid-web"}}}
+	relationships, err := ExtractRelationships(ServiceKind, `{"spec": {"selector": "app=web"}}`, metadata)
+	assert.NotNil(t, err)
+	assert.Equal(t, []Relationship{{Type: RelationshipOwner, Kind: "Deployment", Namespace: "ns", Name: "web", Uid: "uid-web"}}, relationships)
+}
+
+func Test_RegisterRelationshipExtractor(t *testing.T) {
+	kind := "Certificate"
+	defer delete(relationshipExtractors, kind)
+	RegisterRelationshipExtractor(kind, func(payload string, metadata KubeMetadata) ([]Relationship, error) {
+		return []Relationship{{Type: "secretName", Kind: SecretKind, Namespace: metadata.Namespace, Name: metadata.Name + "-tls"}}, nil
+	})
+	certificate := `{"metadata": {"name": "web", "namespace": "ns"}}`
+	assert.Equal(t, []Relationship{{Type: "secretName", Kind: SecretKind, Namespace: "ns", Name: "web-tls"}}, helper_extractRelationships(t, kind, certificate))
+}
+
+func Test_FormatSelector_ParseSelector(t *testing.T) {
+	labels := map[string]string{"tier": "front", "app": "web"}
+	assert.Equal(t, "app=web,tier=front", FormatSelector(labels))
+	assert.Equal(t, labels, ParseSelector(FormatSelector(labels)))
+	assert.Equal(t, map[string]string{}, ParseSelector(""))
+}
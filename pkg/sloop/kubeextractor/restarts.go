@@ -0,0 +1,146 @@
+/*
+ * Copyright (c) 2021, salesforce.com, inc.
+ * All rights reserved.
+ * SPDX-License-Identifier: BSD-3-Clause
+ * For full license text, see LICENSE.txt file in the repo root or https://opensource.org/licenses/BSD-3-Clause
+ */
+
+package kubeextractor
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ContainerTermination is a container of a pod that stopped, either to be restarted or for good
+type ContainerTermination struct {
+	Container     string
+	InitContainer bool
+	// False for a container that stays terminated, like the one of a failed job
+	Restarted    bool
+	RestartCount int32
+	// Like OOMKilled, Error or Completed.  Empty when the restart count went up but the kubelet no longer had the
+	// last state
+	Reason      string
+	ExitCode    int32
+	Signal      int32
+	Message     string
+	StartedAt   time.Time
+	FinishedAt  time.Time
+	ContainerId string
+}
+
+type terminatedState struct {
+	ExitCode    int32
+	Signal      int32
+	Reason      string
+	Message     string
+	StartedAt   time.Time
+	FinishedAt  time.Time
+	ContainerID string
+}
+
+type restartStatus struct {
+	Name         string
+	RestartCount int32
+	State        struct {
+		Terminated *terminatedState
+	}
+	LastState struct {
+		Terminated *terminatedState
+	}
+}
+
+type podRestartStatus struct {
+	Metadata struct {
+		DeletionTimestamp string
+	}
+	Status struct {
+		InitContainerStatuses []restartStatus
+		ContainerStatuses     []restartStatus
+	}
+}
+
+// The same termination shows up in the state and later in the last state, and again on every update until the next one
+func sameTermination(a *terminatedState, b *terminatedState) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.ContainerID == b.ContainerID && a.FinishedAt.Equal(b.FinishedAt) && a.ExitCode == b.ExitCode && a.Reason == b.Reason
+}
+
+func newContainerTermination(status *restartStatus, init bool, restarted bool, terminated *terminatedState) ContainerTermination {
+	ret := ContainerTermination{Container: status.Name, InitContainer: init, Restarted: restarted, RestartCount: status.RestartCount}
+	if terminated != nil {
+		ret.Reason = terminated.Reason
+		ret.ExitCode = terminated.ExitCode
+		ret.Signal = terminated.Signal
+		ret.Message = terminated.Message
+		ret.StartedAt = terminated.StartedAt
+		ret.FinishedAt = terminated.FinishedAt
+		ret.ContainerId = terminated.ContainerID
+	}
+	return ret
+}
+
+// ExtractContainerTerminations compares the container statuses of two copies of a pod, and returns the containers
+// that restarted or terminated in between.  A restart is a higher restart count or a new last state.  A container that
+// terminated without a restart only counts when it failed, since init containers and the containers of a job
+// complete on every run, and when the pod is not being deleted, which stops every container.  Without an earlier copy
+// nothing can be told apart from what happened before, so there is nothing to return
+func ExtractContainerTerminations(prevPayload string, payload string) ([]ContainerTermination, error) {
+	if prevPayload == "" {
+		return nil, nil
+	}
+	prevPod := podRestartStatus{}
+	err := json.Unmarshal([]byte(prevPayload), &prevPod)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to extract container statuses of previous pod")
+	}
+	pod := podRestartStatus{}
+	err = json.Unmarshal([]byte(payload), &pod)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to extract container statuses")
+	}
+
+	deleting := pod.Metadata.DeletionTimestamp != ""
+	ret := []ContainerTermination{}
+	ret = append(ret, containerTerminations(prevPod.Status.InitContainerStatuses, pod.Status.InitContainerStatuses, true, deleting)...)
+	ret = append(ret, containerTerminations(prevPod.Status.ContainerStatuses, pod.Status.ContainerStatuses, false, deleting)...)
+	return ret, nil
+}
+
+func containerTerminations(prevStatuses []restartStatus, statuses []restartStatus, init bool, deleting bool) []ContainerTermination {
+	prevByName := map[string]*restartStatus{}
+	for idx := range prevStatuses {
+		prevByName[prevStatuses[idx].Name] = &prevStatuses[idx]
+	}
+
+	ret := []ContainerTermination{}
+	for idx := range statuses {
+		status := &statuses[idx]
+		// A pod gets its container statuses once it is scheduled, which is no restart
+		prev, ok := prevByName[status.Name]
+		if !ok {
+			prev = &restartStatus{}
+		}
+
+		lastState := status.LastState.Terminated
+		if status.RestartCount > prev.RestartCount || (lastState != nil && !sameTermination(lastState, prev.LastState.Terminated)) {
+			if sameTermination(lastState, prev.LastState.Terminated) {
+				// The count went up but the last state is the one from before, so we do not know why
+				lastState = nil
+			}
+			ret = append(ret, newContainerTermination(status, init, true, lastState))
+			continue
+		}
+
+		state := status.State.Terminated
+		if state != nil && !deleting && !sameTermination(state, prev.State.Terminated) && (state.ExitCode != 0 || state.Reason != "Completed") {
+			ret = append(ret, newContainerTermination(status, init, false, state))
+		}
+	}
+	return ret
+}
@@ -0,0 +1,83 @@
+/*
+ * Copyright (c) 2021, salesforce.com, inc.
+ * All rights reserved.
+ * SPDX-License-Identifier: BSD-3-Clause
+ * For full license text, see LICENSE.txt file in the repo root or https://opensource.org/licenses/BSD-3-Clause
+ */
+
+package kubeextractor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	somePodRunning   = `{"status": {"containerStatuses": [{"name": "app", "restartCount": 0, "state": {"running": {}}}]}}`
+	somePodOOMKilled = `{"status": {"containerStatuses": [{"name": "app", "restartCount": 1, "state": {"waiting": {"reason": "CrashLoopBackOff"}},
+  "lastState": {"terminated": {"exitCode": 137, "reason": "OOMKilled", "startedAt": "2019-03-04T03:00:00Z", "finishedAt": "2019-03-04T03:04:05Z", "containerID": "docker://abc"}}}]}}`
+)
+
+func Test_ExtractContainerTerminations_Restart(t *testing.T) {
+	terminations, err := ExtractContainerTerminations(somePodRunning, somePodOOMKilled)
+	assert.Nil(t, err)
+	assert.Equal(t, []ContainerTermination{{
+		Container:    "app",
+		Restarted:    true,
+		RestartCount: 1,
+		Reason:       "OOMKilled",
+		ExitCode:     137,
+		StartedAt:    time.Date(2019, 3, 4, 3, 0, 0, 0, time.UTC),
+		FinishedAt:   time.Date(2019, 3, 4, 3, 4, 5, 0, time.UTC),
+		ContainerId:  "docker://abc",
+	}}, terminations)
+
+	// The next update still has the same last state
+	terminations, err = ExtractContainerTerminations(somePodOOMKilled, somePodOOMKilled)
+	assert.Nil(t, err)
+	assert.Len(t, terminations, 0)
+
+	// Without the copy before, the restart can not be told apart from older ones
+	terminations, err = ExtractContainerTerminations("", somePodOOMKilled)
+	assert.Nil(t, err)
+	assert.Len(t, terminations, 0)
+}
+
+func Test_ExtractContainerTerminations_CountWithoutLastState(t *testing.T) {
+	prev := `{"status": {"containerStatuses": [{"name": "app", "restartCount": 3, "lastState": {"terminated": {"exitCode": 1, "reason": "Error", "containerID": "docker://abc"}}}]}}`
+	cur := `{"status": {"containerStatuses": [{"name": "app", "restartCount": 5, "lastState": {"terminated": {"exitCode": 1, "reason": "Error", "containerID": "docker://abc"}}}]}}`
+	terminations, err := ExtractContainerTerminations(prev, cur)
+	assert.Nil(t, err)
+	assert.Equal(t, []ContainerTermination{{Container: "app", Restarted: true, RestartCount: 5}}, terminations)
+}
+
+func Test_ExtractContainerTerminations_TerminatedForGood(t *testing.T) {
+	prev := `{"status": {"initContainerStatuses": [{"name": "init", "state": {"running": {}}}], "containerStatuses": [{"name": "job", "state": {"running": {}}}]}}`
+	// The init container completed, which is how it should be, and the job was killed by a signal
+	cur := `{"status": {"initContainerStatuses": [{"name": "init", "state": {"terminated": {"exitCode": 0, "reason": "Completed"}}}],
+  "containerStatuses": [{"name": "job", "state": {"terminated": {"exitCode": 143, "signal": 15, "reason": "Error", "message": "stopped", "containerID": "docker://def"}}}]}}`
+	terminations, err := ExtractContainerTerminations(prev, cur)
+	assert.Nil(t, err)
+	assert.Equal(t, []ContainerTermination{{Container: "job", Reason: "Error", ExitCode: 143, Signal: 15, Message: "stopped", ContainerId: "docker://def"}}, terminations)
+
+	failedInit := `{"status": {"initContainerStatuses": [{"name": "init", "state": {"terminated": {"exitCode": 2, "reason": "Error"}}}]}}`
+	terminations, err = ExtractContainerTerminations(prev, failedInit)
+	assert.Nil(t, err)
+	assert.Equal(t, []ContainerTermination{{Container: "init", InitContainer: true, Reason: "Error", ExitCode: 2}}, terminations)
+
+	// Deleting the pod stops the job too, which is not what failed it
+	deleted := `{"metadata": {"deletionTimestamp": "2019-03-04T03:04:05Z"}, ` + cur[1:]
+	terminations, err = ExtractContainerTerminations(prev, deleted)
+	assert.Nil(t, err)
+	assert.Len(t, terminations, 0)
+
+	// A container that is new to the statuses has not restarted
+	terminations, err = ExtractContainerTerminations(`{"status": {"phase": "Pending"}}`, somePodRunning)
+	assert.Nil(t, err)
+	assert.Len(t, terminations, 0)
+
+	_, err = ExtractContainerTerminations(somePodRunning, `{"status": []}`)
+	assert.NotNil(t, err)
+}
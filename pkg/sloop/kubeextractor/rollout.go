@@ -0,0 +1,180 @@
+/*
+ * Copyright (c) 2021, salesforce.com, inc.
+ * All rights reserved.
+ * SPDX-License-Identifier: BSD-3-Clause
+ * For full license text, see LICENSE.txt file in the repo root or https://opensource.org/licenses/BSD-3-Clause
+ */
+
+package kubeextractor
+
+import (
+	"encoding/json"
+	"reflect"
+
+	"github.com/pkg/errors"
+)
+
+// Rollout outcomes.  A workload only says whether its latest rollout is progressing, complete or failed.  Superseded
+// and deleted are for rollouts that ended without that, because the template changed again or the workload went away
+const (
+	RolloutProgressing = "progressing"
+	RolloutComplete    = "complete"
+	RolloutFailed      = "failed"
+	RolloutSuperseded  = "superseded"
+	RolloutDeleted     = "deleted"
+)
+
+const (
+	DeploymentRevisionAnnotation  = "deployment.kubernetes.io/revision"
+	daemonSetGenerationAnnotation = "deprecated.daemonset.template.generation"
+	// Replica sets of a deployment are named after the deployment and this label
+	PodTemplateHashLabel = "pod-template-hash"
+)
+
+// Workload is what a rollout of a deployment, stateful set or daemon set is about
+type Workload struct {
+	Generation int64
+	// The deployment revision, the stateful set update revision or the daemon set template generation.  The
+	// controller sets it once it sees a new template, so it can lag behind
+	Revision string
+	// Only a stateful set tells its pod template hash, as the controller-revision-hash of its pods.  The one of a
+	// deployment is the pod-template-hash of its replica sets
+	TemplateHash string
+	// As container=image, init containers first
+	Images []string
+	// One of progressing, complete or failed
+	Outcome string
+	// Why the rollout failed
+	Message  string
+	template interface{}
+}
+
+// SameTemplate tells whether two copies of a workload have the same pod template, which is what a rollout is made
+// of.  Scaling changes the generation but not the template
+func (w *Workload) SameTemplate(other *Workload) bool {
+	return reflect.DeepEqual(w.template, other.template)
+}
+
+type workloadExtractor func(payload string) (*Workload, error)
+
+var workloadExtractors = map[string]workloadExtractor{
+	DeploymentKind:  deploymentWorkload,
+	StatefulSetKind: statefulSetWorkload,
+	DaemonSetKind:   daemonSetWorkload,
+}
+
+// ExtractWorkload returns the rollout state of the object in payload, or nil for kinds that do not roll out
+func ExtractWorkload(kind string, payload string) (*Workload, error) {
+	extractor, ok := workloadExtractors[kind]
+	if !ok {
+		return nil, nil
+	}
+	workload, err := extractor(payload)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to extract rollout of %v", kind)
+	}
+	return workload, nil
+}
+
+type templateContainer struct {
+	Name  string
+	Image string
+}
+
+func newWorkload(generation int64, rawTemplate json.RawMessage) (*Workload, error) {
+	workload := &Workload{Generation: generation, Images: []string{}}
+	if len(rawTemplate) == 0 {
+		return workload, nil
+	}
+	err := json.Unmarshal(rawTemplate, &workload.template)
+	if err != nil {
+		return nil, err
+	}
+	template := struct {
+		Spec struct {
+			InitContainers []templateContainer
+			Containers     []templateContainer
+		}
+	}{}
+	err = json.Unmarshal(rawTemplate, &template)
+	if err != nil {
+		return nil, err
+	}
+	for _, container := range append(template.Spec.InitContainers, template.Spec.Containers...) {
+		workload.Images = append(workload.Images, container.Name+"="+container.Image)
+	}
+	return workload, nil
+}
+
+func deploymentWorkload(payload string) (*Workload, error) {
+	deployment := deploymentObject{}
+	err := json.Unmarshal([]byte(payload), &deployment)
+	if err != nil {
+		return nil, err
+	}
+	workload, err := newWorkload(deployment.Metadata.Generation, deployment.Spec.Template)
+	if err != nil {
+		return nil, err
+	}
+	workload.Revision = deployment.Metadata.Annotations[DeploymentRevisionAnnotation]
+
+	// Until the controller sees the new template, the conditions are still about the rollout before
+	caughtUp := deployment.Status.ObservedGeneration >= deployment.Metadata.Generation
+	switch progressing := findCondition(deployment.Status.Conditions, "Progressing"); {
+	case caughtUp && progressing != nil && progressing.Reason == "ProgressDeadlineExceeded":
+		workload.Outcome = RolloutFailed
+		workload.Message = progressing.Message
+	case deployment.rolledOut():
+		workload.Outcome = RolloutComplete
+	default:
+		workload.Outcome = RolloutProgressing
+	}
+	return workload, nil
+}
+
+// A stateful set has no deadline, so its rollouts do not fail.  They are complete once every pod runs the update
+// revision and is ready, which is what kubectl rollout status waits for
+func statefulSetWorkload(payload string) (*Workload, error) {
+	statefulSet := statefulSetObject{}
+	err := json.Unmarshal([]byte(payload), &statefulSet)
+	if err != nil {
+		return nil, err
+	}
+	workload, err := newWorkload(statefulSet.Metadata.Generation, statefulSet.Spec.Template)
+	if err != nil {
+		return nil, err
+	}
+	status := statefulSet.Status
+	workload.Revision = status.UpdateRevision
+	// Pods get the whole revision name as their controller-revision-hash label
+	workload.TemplateHash = status.UpdateRevision
+
+	workload.Outcome = RolloutProgressing
+	if status.ObservedGeneration >= statefulSet.Metadata.Generation && statefulSet.revisionUpdated() &&
+		status.ReadyReplicas >= desiredReplicas(statefulSet.Spec.Replicas) {
+		workload.Outcome = RolloutComplete
+	}
+	return workload, nil
+}
+
+// Like a stateful set, a daemon set has no deadline
+func daemonSetWorkload(payload string) (*Workload, error) {
+	daemonSet := daemonSetObject{}
+	err := json.Unmarshal([]byte(payload), &daemonSet)
+	if err != nil {
+		return nil, err
+	}
+	workload, err := newWorkload(daemonSet.Metadata.Generation, daemonSet.Spec.Template)
+	if err != nil {
+		return nil, err
+	}
+	status := daemonSet.Status
+	workload.Revision = daemonSet.Metadata.Annotations[daemonSetGenerationAnnotation]
+
+	workload.Outcome = RolloutProgressing
+	if status.ObservedGeneration >= daemonSet.Metadata.Generation && status.UpdatedNumberScheduled >= status.DesiredNumberScheduled &&
+		status.NumberAvailable >= status.DesiredNumberScheduled {
+		workload.Outcome = RolloutComplete
+	}
+	return workload, nil
+}
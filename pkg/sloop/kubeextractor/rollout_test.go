@@ -0,0 +1,71 @@
+/*
+ * Copyright (c) 2021, salesforce.com, inc.
+ * All rights reserved.
+ * SPDX-License-Identifier: BSD-3-Clause
+ * For full license text, see LICENSE.txt file in the repo root or https://opensource.org/licenses/BSD-3-Clause
+ */
+
+package kubeextractor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	someDeploymentRollingOut = `{"metadata": {"name": "web", "generation": 4, "annotations": {"deployment.kubernetes.io/revision": "3"}},
+  "spec": {"replicas": 2, "template": {"metadata": {"labels": {"app": "web"}}, "spec": {"initContainers": [{"name": "init", "image": "busybox"}], "containers": [{"name": "app", "image": "web:2"}]}}},
+  "status": {"observedGeneration": 4, "replicas": 3, "updatedReplicas": 1, "availableReplicas": 2}}`
+	someDeploymentScaled = `{"metadata": {"name": "web", "generation": 5, "annotations": {"deployment.kubernetes.io/revision": "3"}},
+  "spec": {"replicas": 3, "template": {"metadata": {"labels": {"app": "web"}}, "spec": {"initContainers": [{"name": "init", "image": "busybox"}], "containers": [{"name": "app", "image": "web:2"}]}}},
+  "status": {"observedGeneration": 5, "replicas": 3, "updatedReplicas": 3, "availableReplicas": 3}}`
+	someDeploymentFailed = `{"metadata": {"name": "web", "generation": 6},
+  "spec": {"template": {"metadata": {"labels": {"app": "web"}}, "spec": {"containers": [{"name": "app", "image": "web:3"}]}}},
+  "status": {"observedGeneration": 6, "conditions": [{"type": "Progressing", "status": "False", "reason": "ProgressDeadlineExceeded", "message": "ReplicaSet \"web-5d8f\" has timed out progressing."}]}}`
+)
+
+func Test_ExtractWorkload_Deployment(t *testing.T) {
+	rollingOut, err := ExtractWorkload(DeploymentKind, someDeploymentRollingOut)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(4), rollingOut.Generation)
+	assert.Equal(t, "3", rollingOut.Revision)
+	assert.Equal(t, []string{"init=busybox", "app=web:2"}, rollingOut.Images)
+	assert.Equal(t, RolloutProgressing, rollingOut.Outcome)
+
+	// Scaling is no rollout
+	scaled, err := ExtractWorkload(DeploymentKind, someDeploymentScaled)
+	assert.Nil(t, err)
+	assert.Equal(t, RolloutComplete, scaled.Outcome)
+	assert.True(t, rollingOut.SameTemplate(scaled))
+
+	failed, err := ExtractWorkload(DeploymentKind, someDeploymentFailed)
+	assert.Nil(t, err)
+	assert.Equal(t, RolloutFailed, failed.Outcome)
+	assert.Equal(t, `ReplicaSet "web-5d8f" has timed out progressing.`, failed.Message)
+	assert.False(t, scaled.SameTemplate(failed))
+}
+
+func Test_ExtractWorkload_StatefulSetAndDaemonSet(t *testing.T) {
+	statefulSet, err := ExtractWorkload(StatefulSetKind, `{"metadata": {"name": "db", "generation": 2}, "spec": {"replicas": 2, "template": {"spec": {"containers": [{"name": "db", "image": "db:1"}]}}},
+  "status": {"observedGeneration": 2, "readyReplicas": 2, "currentRevision": "db-6c7f", "updateRevision": "db-7d4b"}}`)
+	assert.Nil(t, err)
+	assert.Equal(t, "db-7d4b", statefulSet.Revision)
+	assert.Equal(t, "db-7d4b", statefulSet.TemplateHash)
+	assert.Equal(t, RolloutProgressing, statefulSet.Outcome)
+
+	daemonSet, err := ExtractWorkload(DaemonSetKind, `{"metadata": {"name": "agent", "generation": 3, "annotations": {"deprecated.daemonset.template.generation": "3"}},
+  "spec": {"template": {"spec": {"containers": [{"name": "agent", "image": "agent:1"}]}}},
+  "status": {"observedGeneration": 3, "desiredNumberScheduled": 4, "updatedNumberScheduled": 4, "numberAvailable": 4}}`)
+	assert.Nil(t, err)
+	assert.Equal(t, "3", daemonSet.Revision)
+	assert.Equal(t, []string{"agent=agent:1"}, daemonSet.Images)
+	assert.Equal(t, RolloutComplete, daemonSet.Outcome)
+
+	pod, err := ExtractWorkload(PodKind, somePodRunning)
+	assert.Nil(t, err)
+	assert.Nil(t, pod)
+
+	_, err = ExtractWorkload(DeploymentKind, `{"spec": {"template": []}}`)
+	assert.NotNil(t, err)
+}
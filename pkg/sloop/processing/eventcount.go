@@ -8,7 +8,10 @@
 package processing
 
 import (
+	"github.com/dgraph-io/badger/v2"
 	"github.com/golang/glog"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/pkg/errors"
 	"github.com/salesforce/sloop/pkg/sloop/common"
@@ -20,11 +23,10 @@ import (
 	"time"
 )
 
-// TODO: We are only looking for the previous event in the current partiton, but we need to look back in cases where we cross the boundary
-
 func updateEventCountTable(
 	tables typed.Tables,
 	txn badgerwrap.Txn,
+	counts *processingCounts,
 	watchRec *typed.KubeWatchResult,
 	metadata *kubeextractor.KubeMetadata,
 	involvedObject *kubeextractor.KubeInvolvedObject,
@@ -34,14 +36,14 @@ func updateEventCountTable(
 		return nil
 	}
 
-	prevEventInfo, err := getPreviousEventInfo(tables, txn, watchRec.Timestamp, watchRec.Kind, metadata.Namespace, metadata.Name)
+	newEventInfo, err := kubeextractor.ExtractEventInfo(watchRec.Payload)
 	if err != nil {
-		return errors.Wrap(err, "Could not get event info for previous event instance")
+		return errors.Wrap(err, "Could not extract reason")
 	}
 
-	newEventInfo, err := kubeextractor.ExtractEventInfo(watchRec.Payload)
+	prevEventInfo, err := getPreviousEventInfo(tables, txn, watchRec.Timestamp, watchRec.Kind, metadata.Namespace, metadata.Name, newEventInfo.FirstTimestamp, maxLookback)
 	if err != nil {
-		return errors.Wrap(err, "Could not extract reason")
+		return errors.Wrap(err, "Could not get event info for previous event instance")
 	}
 
 	computedFirstTs, computedLastTs, computedCount := computeEventsDiff(prevEventInfo, newEventInfo)
@@ -83,8 +85,12 @@ func updateEventCountTable(
 	if err != nil {
 		return err
 	}
+	err = addInvolvedObjectEdge(tables, txn, watchRec, metadata, involvedObject)
+	if err != nil {
+		return err
+	}
 
-	metricIngestionSuccessCount.Inc()
+	counts.success++
 	return nil
 }
 
@@ -134,6 +140,37 @@ func storeMinutes(tables typed.Tables, txn badgerwrap.Txn, minToCount map[int64]
 	return nil
 }
 
+// Events get no resource summary, so the edge to the event goes on the summary of the object it is about, in the
+// partition the event was seen in.  An object that has no summary there has nothing to keep it on
+func addInvolvedObjectEdge(tables typed.Tables, txn badgerwrap.Txn, watchRec *typed.KubeWatchResult, metadata *kubeextractor.KubeMetadata, involvedObject *kubeextractor.KubeInvolvedObject) error {
+	ts, err := ptypes.Timestamp(watchRec.Timestamp)
+	if err != nil {
+		return errors.Wrap(err, "could not convert timestamp")
+	}
+	key := typed.NewResourceSummaryKey(ts, involvedObject.Kind, involvedObject.Namespace, involvedObject.Name, involvedObject.Uid).String()
+	value, err := tables.ResourceSummaryTable().Get(txn, key)
+	if err == badger.ErrKeyNotFound {
+		glog.V(common.GlogVerbose).Infof("No resource summary %v to add event %v to", key, metadata.Name)
+		return nil
+	}
+	if err != nil {
+		return errors.Wrapf(err, "could not get record for key %v", key)
+	}
+
+	edge := &typed.Relationship{Type: kubeextractor.RelationshipInvolvedObject, Kind: kubeextractor.EventKind, Namespace: metadata.Namespace, Name: metadata.Name, Uid: metadata.Uid}
+	for _, existing := range value.Edges {
+		if proto.Equal(existing, edge) {
+			return nil
+		}
+	}
+	value.Edges = append(value.Edges, edge)
+	err = tables.ResourceSummaryTable().Set(txn, key, value)
+	if err != nil {
+		return errors.Wrapf(err, "put for the key %v failed", key)
+	}
+	return nil
+}
+
 func distributeValue(value int, buckets int) []int {
 	if buckets == 0 {
 		return []int{}
@@ -175,9 +212,20 @@ func spreadOutEvents(firstTs time.Time, lastTs time.Time, count int) map[int64]i
 	return ret
 }
 
-func getPreviousEventInfo(tables typed.Tables, txn badgerwrap.Txn, ts *timestamp.Timestamp, kind string, namespace string, name string) (*kubeextractor.EventInfo, error) {
+// A long lived event keeps its name while the count goes up, so the previous copy can be in any partition since the
+// event first happened.  Missing it would count every occurrence again
+func getPreviousEventInfo(tables typed.Tables, txn badgerwrap.Txn, ts *timestamp.Timestamp, kind string, namespace string, name string, firstTimestamp time.Time, maxLookback time.Duration) (*kubeextractor.EventInfo, error) {
+	timestamp, err := ptypes.Timestamp(ts)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Could not convert timestamp %v", ts.String())
+	}
+	since := firstTimestamp.Add(-clockSkew)
+	if oldest := timestamp.Add(-maxLookback); since.Before(oldest) {
+		since = oldest
+	}
+
 	// Find the most recent copy of this event in the store so we can figure out what is new
-	prevWatch, err := getLastKubeWatchResult(tables, txn, ts, kind, namespace, name)
+	prevWatch, err := getLastKubeWatchResultSince(tables, txn, timestamp, kind, namespace, name, since)
 	if err != nil {
 		return nil, err
 	}
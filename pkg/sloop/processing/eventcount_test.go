@@ -12,6 +12,7 @@ import (
 	"github.com/dgraph-io/badger/v2"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/salesforce/sloop/pkg/sloop/ingress"
 	"github.com/salesforce/sloop/pkg/sloop/kubeextractor"
 	"github.com/salesforce/sloop/pkg/sloop/store/typed"
 	"github.com/salesforce/sloop/pkg/sloop/store/untyped"
@@ -74,7 +75,7 @@ func Test_EventCountTable_NonEvent(t *testing.T) {
 	}
 
 	err = tables.Db().Update(func(txn badgerwrap.Txn) error {
-		return updateEventCountTable(tables, txn, &watchRec, nil, nil, someMaxLookback)
+		return updateEventCountTable(tables, txn, newProcessingCounts(), &watchRec, nil, nil, someMaxLookback)
 	})
 	assert.Nil(t, err)
 
@@ -241,16 +242,16 @@ func addEventCount(t *testing.T, tables typed.Tables, timeStamp *timestamp.Times
 
 	metadata := &kubeextractor.KubeMetadata{Name: "someName", Namespace: "someNamespace"}
 	err = tables.Db().Update(func(txn badgerwrap.Txn) error {
-		updateKubeWatchTable(tables, txn, &watchRec, metadata, true)
+		updateKubeWatchTable(tables, txn, newProcessingCounts(), &watchRec, metadata, false, false, false)
 		// For dedupe to work we need a record written to the watch table
-		err2 := updateEventCountTable(tables, txn, &watchRec, &resourceMetadata, &involvedObject, someMaxLookback)
+		err2 := updateEventCountTable(tables, txn, newProcessingCounts(), &watchRec, &resourceMetadata, &involvedObject, someMaxLookback)
 		if err2 != nil {
 			return err2
 		}
 
 		kubeMetadata, err := kubeextractor.ExtractMetadata(watchRec.Payload)
 		assert.Nil(t, err)
-		err2 = updateKubeWatchTable(tables, txn, &watchRec, &kubeMetadata, false)
+		err2 = updateKubeWatchTable(tables, txn, newProcessingCounts(), &watchRec, &kubeMetadata, false, false, false)
 		return err2
 	})
 	assert.Nil(t, err)
@@ -522,7 +523,7 @@ func Test_updateEventCountTable_NoUid_Success(t *testing.T) {
 	watchRec := typed.KubeWatchResult{Kind: kubeextractor.PodKind, WatchType: typed.KubeWatchResult_UPDATE, Timestamp: ts, Payload: someNamePodPayload}
 	metadata := &kubeextractor.KubeMetadata{Name: "somePodName", Namespace: "someNamespace"}
 	err = tables.Db().Update(func(txn badgerwrap.Txn) error {
-		return updateKubeWatchTable(tables, txn, &watchRec, metadata, true)
+		return updateKubeWatchTable(tables, txn, newProcessingCounts(), &watchRec, metadata, false, false, false)
 	})
 	assert.Nil(t, err)
 
@@ -538,7 +539,7 @@ func Test_updateEventCountTable_NoUid_Success(t *testing.T) {
 	assert.Nil(t, err)
 
 	err = tables.Db().Update(func(txn badgerwrap.Txn) error {
-		err2 := updateEventCountTable(tables, txn, &eventWatchRec, &resourceMetadata, &involvedObject, someMaxLookback)
+		err2 := updateEventCountTable(tables, txn, newProcessingCounts(), &eventWatchRec, &resourceMetadata, &involvedObject, someMaxLookback)
 		if err2 != nil {
 			return err2
 		}
@@ -549,6 +550,57 @@ func Test_updateEventCountTable_NoUid_Success(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func Test_updateEventCountTable_AddsEdgeToInvolvedObjectSummary(t *testing.T) {
+	untyped.TestHookSetPartitionDuration(time.Hour)
+	db, err := (&badgerwrap.MockFactory{}).Open(badger.DefaultOptions(""))
+	assert.Nil(t, err)
+	tables := typed.NewTableList(db)
+
+	podPayload := `{"metadata":{"name":"somePodName","namespace":"someNamespace","uid":"somePodUid","creationTimestamp":"2019-08-29T21:00:00Z"},"spec":{"nodeName":"node1"}}`
+	podMetadata, err := kubeextractor.ExtractMetadata(podPayload)
+	assert.Nil(t, err)
+	podWatchRec := &typed.KubeWatchResult{Kind: kubeextractor.PodKind, WatchType: typed.KubeWatchResult_UPDATE, Timestamp: someEventWatchPTime, Payload: podPayload}
+	eventWatchRec := &typed.KubeWatchResult{Kind: kubeextractor.EventKind, Timestamp: someEventWatchPTime, Payload: get_event_pay_load(firstTimeStamp, lastTimeStamp, "somePodUid")}
+	eventMetadata, err := kubeextractor.ExtractMetadata(eventWatchRec.Payload)
+	assert.Nil(t, err)
+	involvedObject, err := kubeextractor.ExtractInvolvedObject(eventWatchRec.Payload)
+	assert.Nil(t, err)
+
+	podKey := typed.NewResourceSummaryKey(someEventWatchTs, kubeextractor.PodKind, "someNamespace", "somePodName", "somePodUid").String()
+	var edges []*typed.Relationship
+	err = tables.Db().Update(func(txn badgerwrap.Txn) error {
+		err2 := updateResourceSummaryTable(tables, txn, newProcessingCounts(), podWatchRec, &podMetadata, false)
+		if err2 != nil {
+			return err2
+		}
+		err2 = updateEventCountTable(tables, txn, newProcessingCounts(), eventWatchRec, &eventMetadata, &involvedObject, someMaxLookback)
+		if err2 != nil {
+			return err2
+		}
+		// The next update of the pod keeps the edge, as it does not come from the pod payload
+		err2 = updateResourceSummaryTable(tables, txn, newProcessingCounts(), podWatchRec, &podMetadata, false)
+		if err2 != nil {
+			return err2
+		}
+		value, err2 := tables.ResourceSummaryTable().Get(txn, podKey)
+		if err2 != nil {
+			return err2
+		}
+		edges = value.Edges
+		return nil
+	})
+	assert.Nil(t, err)
+
+	if assert.Len(t, edges, 2) {
+		assert.Equal(t, kubeextractor.RelationshipNodeName, edges[0].Type)
+		assert.Equal(t, kubeextractor.RelationshipInvolvedObject, edges[1].Type)
+		assert.Equal(t, kubeextractor.EventKind, edges[1].Kind)
+		assert.Equal(t, "someNamespace", edges[1].Namespace)
+		assert.Equal(t, "somePodName.xx", edges[1].Name)
+		assert.Equal(t, "someEventUid", edges[1].Uid)
+	}
+}
+
 func Test_updateEventCountTable_NoUid_Failure(t *testing.T) {
 	untyped.TestHookSetPartitionDuration(time.Hour)
 	db, err := (&badgerwrap.MockFactory{}).Open(badger.DefaultOptions(""))
@@ -561,7 +613,7 @@ func Test_updateEventCountTable_NoUid_Failure(t *testing.T) {
 	watchRec := typed.KubeWatchResult{Kind: kubeextractor.PodKind, WatchType: typed.KubeWatchResult_UPDATE, Timestamp: ts, Payload: somePodPayload}
 	metadata := &kubeextractor.KubeMetadata{Name: "RandomName", Namespace: "someNamespace"}
 	err = tables.Db().Update(func(txn badgerwrap.Txn) error {
-		return updateKubeWatchTable(tables, txn, &watchRec, metadata, true)
+		return updateKubeWatchTable(tables, txn, newProcessingCounts(), &watchRec, metadata, false, false, false)
 	})
 	assert.Nil(t, err)
 
@@ -577,7 +629,7 @@ func Test_updateEventCountTable_NoUid_Failure(t *testing.T) {
 	assert.Nil(t, err)
 
 	err = tables.Db().Update(func(txn badgerwrap.Txn) error {
-		err2 := updateEventCountTable(tables, txn, &eventWatchRec, &resourceMetadata, &involvedObject, someMaxLookback)
+		err2 := updateEventCountTable(tables, txn, newProcessingCounts(), &eventWatchRec, &resourceMetadata, &involvedObject, someMaxLookback)
 		if err2 != nil {
 			return err2
 		}
@@ -587,3 +639,48 @@ func Test_updateEventCountTable_NoUid_Failure(t *testing.T) {
 	})
 	assert.NotNil(t, err)
 }
+
+// Plays back a recording through the runner and adds up the counts stored for every reason between startTime and endTime
+func helper_playbackEventCounts(t *testing.T, filename string, startTime time.Time, endTime time.Time) map[string]int32 {
+	untyped.TestHookSetPartitionDuration(time.Hour)
+	db, err := (&badgerwrap.MockFactory{}).Open(badger.DefaultOptions(""))
+	assert.Nil(t, err)
+	tables := typed.NewTableList(db)
+
+	inChan := make(chan typed.KubeWatchResult, 100)
+	err = ingress.PlayFile(inChan, filename, ingress.PlaybackConfig{})
+	assert.Nil(t, err)
+	close(inChan)
+	runner := NewProcessing(Config{MaxLookback: someMaxLookback, MaxBatchRecords: 1, Workers: 1}, inChan, tables)
+	runner.Start()
+	runner.Wait()
+
+	counts := map[string]int32{}
+	err = db.View(func(txn badgerwrap.Txn) error {
+		results, _, err := tables.EventCountTable().RangeRead(txn, nil, nil, nil, startTime, endTime)
+		if err != nil {
+			return err
+		}
+		for _, result := range results {
+			for _, eventCounts := range result.MapMinToEvents {
+				for reason, count := range eventCounts.MapReasonToCount {
+					counts[reason] += count
+				}
+			}
+		}
+		return nil
+	})
+	assert.Nil(t, err)
+	return counts
+}
+
+func Test_EventCountTable_PreviousEventInEarlierPartition(t *testing.T) {
+	day := time.Date(2019, 3, 4, 0, 0, 0, 0, time.UTC)
+	counts := helper_playbackEventCounts(t, "testdata/event_across_partitions.ndjson", day, day.Add(24*time.Hour))
+
+	// The back off happened 120 times.  Only the occurrences since the copy before each update are added, even when
+	// that copy is partitions back, and the ones past the newest partition at the time are dropped.  Counting the
+	// whole cumulative count again after each boundary gave 241
+	assert.Equal(t, int32(108), counts["BackOff:Warning"])
+	assert.Equal(t, int32(5), counts["Unhealthy:Warning"])
+}
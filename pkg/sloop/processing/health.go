@@ -0,0 +1,61 @@
+/*
+ * Copyright (c) 2021, salesforce.com, inc.
+ * All rights reserved.
+ * SPDX-License-Identifier: BSD-3-Clause
+ * For full license text, see LICENSE.txt file in the repo root or https://opensource.org/licenses/BSD-3-Clause
+ */
+
+package processing
+
+import (
+	"github.com/golang/glog"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/pkg/errors"
+	"github.com/salesforce/sloop/pkg/sloop/kubeextractor"
+	"github.com/salesforce/sloop/pkg/sloop/store/typed"
+	"github.com/salesforce/sloop/pkg/sloop/store/untyped"
+	"github.com/salesforce/sloop/pkg/sloop/store/untyped/badgerwrap"
+)
+
+// Only a change of state is stored, so a resync of a pod that is still Running costs a read and no write.  The first
+// record of a resource in a partition always starts with its state, so each partition can be read on its own
+func updateHealthTable(tables typed.Tables, txn badgerwrap.Txn, counts *processingCounts, watchRec *typed.KubeWatchResult, metadata *kubeextractor.KubeMetadata) error {
+	// A deleted resource has no state after that.  Its timeline row ends there
+	if watchRec.Kind == kubeextractor.EventKind || watchRec.WatchType == typed.KubeWatchResult_DELETE {
+		return nil
+	}
+	health, err := kubeextractor.ExtractHealth(watchRec.Kind, watchRec.Payload)
+	if err != nil {
+		glog.Errorf("Could not extract health of %v/%v: %v", metadata.Namespace, metadata.Name, err)
+		return nil
+	}
+	if health == nil {
+		return nil
+	}
+
+	timestamp, err := ptypes.Timestamp(watchRec.Timestamp)
+	if err != nil {
+		return errors.Wrapf(err, "Could not convert timestamp %v", watchRec.Timestamp)
+	}
+	key := typed.NewHealthKey(untyped.GetPartitionId(timestamp), watchRec.Kind, metadata.Namespace, metadata.Name, metadata.Uid)
+	healthRecord, err := tables.HealthTable().GetOrDefault(txn, key.String())
+	if err != nil {
+		return errors.Wrap(err, "Could not get health record")
+	}
+
+	// The message can change on every update, like a count of ready replicas, so it does not make a new state
+	if len(healthRecord.Changes) > 0 {
+		last := healthRecord.Changes[len(healthRecord.Changes)-1]
+		if last.State == health.State && last.Severity == health.Severity {
+			return nil
+		}
+	}
+	healthRecord.Changes = append(healthRecord.Changes, &typed.HealthChange{Timestamp: timestamp.Unix(), State: health.State, Severity: health.Severity, Message: health.Message})
+
+	err = tables.HealthTable().Set(txn, key.String(), healthRecord)
+	if err != nil {
+		return errors.Wrap(err, "Failed to put health record")
+	}
+	counts.success++
+	return nil
+}
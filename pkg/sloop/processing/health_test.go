@@ -0,0 +1,98 @@
+/*
+ * Copyright (c) 2021, salesforce.com, inc.
+ * All rights reserved.
+ * SPDX-License-Identifier: BSD-3-Clause
+ * For full license text, see LICENSE.txt file in the repo root or https://opensource.org/licenses/BSD-3-Clause
+ */
+
+package processing
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/dgraph-io/badger/v2"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/salesforce/sloop/pkg/sloop/kubeextractor"
+	"github.com/salesforce/sloop/pkg/sloop/store/typed"
+	"github.com/salesforce/sloop/pkg/sloop/store/untyped"
+	"github.com/salesforce/sloop/pkg/sloop/store/untyped/badgerwrap"
+	"github.com/stretchr/testify/assert"
+)
+
+const somePodHealthPayload = `{"metadata": {"name": "web-0", "namespace": "ns", "uid": "uid-web-0", "resourceVersion": "%v"},
+  "status": {"phase": "%v", "containerStatuses": [{"name": "app", "ready": %v, "state": {%v}}]}}`
+
+func Test_updateHealthTable_StoresStateChanges(t *testing.T) {
+	untyped.TestHookSetPartitionDuration(time.Hour)
+	db, err := (&badgerwrap.MockFactory{}).Open(badger.DefaultOptions(""))
+	assert.Nil(t, err)
+	tables := typed.NewTableList(db)
+
+	start := time.Date(2019, 3, 4, 3, 40, 0, 0, time.UTC)
+	payloads := []string{
+		fmt.Sprintf(somePodHealthPayload, 1, "Pending", false, `"waiting": {"reason": "ContainerCreating"}`),
+		fmt.Sprintf(somePodHealthPayload, 2, "Running", true, `"running": {}`),
+		fmt.Sprintf(somePodHealthPayload, 3, "Running", true, `"running": {}`),
+		fmt.Sprintf(somePodHealthPayload, 4, "Running", false, `"waiting": {"reason": "CrashLoopBackOff", "message": "back-off 10s"}`),
+		// The next partition starts with the state the pod is in
+		fmt.Sprintf(somePodHealthPayload, 5, "Running", false, `"waiting": {"reason": "CrashLoopBackOff", "message": "back-off 20s"}`),
+	}
+	err = tables.Db().Update(func(txn badgerwrap.Txn) error {
+		for idx, payload := range payloads {
+			ts, err := ptypes.TimestampProto(start.Add(time.Duration(idx) * 5 * time.Minute))
+			assert.Nil(t, err)
+			watchRec := &typed.KubeWatchResult{Kind: kubeextractor.PodKind, WatchType: typed.KubeWatchResult_UPDATE, Timestamp: ts, Payload: payload}
+			metadata, err := kubeextractor.ExtractMetadata(payload)
+			assert.Nil(t, err)
+			assert.Nil(t, updateHealthTable(tables, txn, newProcessingCounts(), watchRec, &metadata))
+		}
+		return nil
+	})
+	assert.Nil(t, err)
+
+	err = tables.Db().View(func(txn badgerwrap.Txn) error {
+		first, err := tables.HealthTable().Get(txn, typed.NewHealthKey(untyped.GetPartitionId(start), kubeextractor.PodKind, "ns", "web-0", "uid-web-0").String())
+		assert.Nil(t, err)
+		assert.Equal(t, []*typed.HealthChange{
+			{Timestamp: start.Unix(), State: "ContainerCreating", Severity: kubeextractor.HealthProgressing, Message: "container app"},
+			{Timestamp: start.Add(5 * time.Minute).Unix(), State: "Running", Severity: kubeextractor.HealthHealthy},
+			{Timestamp: start.Add(15 * time.Minute).Unix(), State: "CrashLoopBackOff", Severity: kubeextractor.HealthError, Message: "container app: back-off 10s"},
+		}, first.Changes)
+
+		next := start.Add(20 * time.Minute)
+		second, err := tables.HealthTable().Get(txn, typed.NewHealthKey(untyped.GetPartitionId(next), kubeextractor.PodKind, "ns", "web-0", "uid-web-0").String())
+		assert.Nil(t, err)
+		assert.Equal(t, []*typed.HealthChange{
+			{Timestamp: next.Unix(), State: "CrashLoopBackOff", Severity: kubeextractor.HealthError, Message: "container app: back-off 20s"},
+		}, second.Changes)
+		return nil
+	})
+	assert.Nil(t, err)
+}
+
+func Test_updateHealthTable_SkipsKindsWithoutHealth(t *testing.T) {
+	untyped.TestHookSetPartitionDuration(time.Hour)
+	db, err := (&badgerwrap.MockFactory{}).Open(badger.DefaultOptions(""))
+	assert.Nil(t, err)
+	tables := typed.NewTableList(db)
+
+	ts, err := ptypes.TimestampProto(someWatchTime)
+	assert.Nil(t, err)
+	payload := `{"metadata": {"name": "config", "namespace": "ns", "uid": "uid-config"}, "data": {}}`
+	watchRec := &typed.KubeWatchResult{Kind: kubeextractor.ConfigMapKind, WatchType: typed.KubeWatchResult_ADD, Timestamp: ts, Payload: payload}
+	metadata, err := kubeextractor.ExtractMetadata(payload)
+	assert.Nil(t, err)
+	err = tables.Db().Update(func(txn badgerwrap.Txn) error {
+		return updateHealthTable(tables, txn, newProcessingCounts(), watchRec, &metadata)
+	})
+	assert.Nil(t, err)
+
+	err = tables.Db().View(func(txn badgerwrap.Txn) error {
+		ok, _ := tables.HealthTable().GetMinKey(txn)
+		assert.False(t, ok)
+		return nil
+	})
+	assert.Nil(t, err)
+}
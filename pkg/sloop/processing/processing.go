@@ -8,9 +8,15 @@
 package processing
 
 import (
+	"fmt"
+	"hash/fnv"
+
+	"github.com/dgraph-io/badger/v2"
 	"github.com/golang/glog"
+	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/salesforce/sloop/pkg/sloop/ingress"
 	"github.com/salesforce/sloop/pkg/sloop/kubeextractor"
 	"github.com/salesforce/sloop/pkg/sloop/store/typed"
 	"github.com/salesforce/sloop/pkg/sloop/store/untyped/badgerwrap"
@@ -18,22 +24,84 @@ import (
 	"time"
 )
 
+// Conflicts only happen when two workers touch the same key, like the event counts of one pod, so they clear quickly
+const (
+	maxConflictRetries = 10
+	conflictRetryDelay = 10 * time.Millisecond
+)
+
+type Config struct {
+	MinorUpdates *ingress.MinorUpdates // nil = store every update
+	MaxLookback  time.Duration
+	// A batch is committed once it has this many records, or its first record has waited MaxBatchLatency.  Less
+	// than 1 = 1, and a latency of 0 = commit whatever is there without waiting
+	MaxBatchRecords int
+	MaxBatchLatency time.Duration
+	Workers         int  // Less than 1 = 1
+	PayloadPatches  bool // Store updates as patches against an earlier full payload of the object
+}
+
 type Runner struct {
-	kubeWatchChan        chan typed.KubeWatchResult
-	tables               typed.Tables
-	inputWg              *sync.WaitGroup
-	keepMinorNodeUpdates bool
-	maxLookback          time.Duration
+	kubeWatchChan chan typed.KubeWatchResult
+	tables        typed.Tables
+	inputWg       *sync.WaitGroup
+	config        Config
+}
+
+// Metadata is extracted once, to pick the worker
+type workItem struct {
+	watchRec       typed.KubeWatchResult
+	metadata       kubeextractor.KubeMetadata
+	involvedObject kubeextractor.KubeInvolvedObject
 }
 
 var (
 	metricProcessingWatchtableUpdatecount = promauto.NewCounter(prometheus.CounterOpts{Name: "sloop_processing_watchtable_updatecount"})
 	metricIngestionFailureCount           = promauto.NewCounter(prometheus.CounterOpts{Name: "sloop_ingestion_failure_count"})
 	metricIngestionSuccessCount           = promauto.NewCounter(prometheus.CounterOpts{Name: "sloop_ingestion_success_count"})
+	metricProcessingReplayCount           = promauto.NewCounter(prometheus.CounterOpts{Name: "sloop_processing_replay_count"})
+	metricProcessingMinorUpdateCount      = promauto.NewCounterVec(prometheus.CounterOpts{Name: "sloop_processing_minor_update_count"}, []string{"kind"})
+	metricProcessingRecordCount           = promauto.NewCounter(prometheus.CounterOpts{Name: "sloop_processing_record_count"})
+	metricProcessingBatchRecords          = promauto.NewHistogram(prometheus.HistogramOpts{Name: "sloop_processing_batch_records", Buckets: prometheus.ExponentialBuckets(1, 2, 12)})
+	metricProcessingBatchSec              = promauto.NewHistogram(prometheus.HistogramOpts{Name: "sloop_processing_batch_sec"})
+	metricProcessingBatchSplitCount       = promauto.NewCounter(prometheus.CounterOpts{Name: "sloop_processing_batch_split_count"})
+	metricProcessingConflictCount         = promauto.NewCounter(prometheus.CounterOpts{Name: "sloop_processing_conflict_count"})
+	metricProcessingWorkerQueued          = promauto.NewGaugeVec(prometheus.GaugeOpts{Name: "sloop_processing_worker_queued"}, []string{"worker"})
 )
 
-func NewProcessing(kubeWatchChan chan typed.KubeWatchResult, tables typed.Tables, keepMinorNodeUpdates bool, maxLookback time.Duration) *Runner {
-	return &Runner{kubeWatchChan: kubeWatchChan, tables: tables, inputWg: &sync.WaitGroup{}, keepMinorNodeUpdates: keepMinorNodeUpdates, maxLookback: maxLookback}
+// What one run of a batch transaction did.  The transaction runs again after a conflict, and its records run again
+// in smaller batches after a failure, so these only go to the metrics once it commits
+type processingCounts struct {
+	success           int
+	watchTableUpdates int
+	replays           int
+	minorUpdates      map[string]int // By kind
+}
+
+func newProcessingCounts() *processingCounts {
+	return &processingCounts{minorUpdates: map[string]int{}}
+}
+
+func (c *processingCounts) publish() {
+	metricIngestionSuccessCount.Add(float64(c.success))
+	metricProcessingWatchtableUpdatecount.Add(float64(c.watchTableUpdates))
+	metricProcessingReplayCount.Add(float64(c.replays))
+	for kind, count := range c.minorUpdates {
+		metricProcessingMinorUpdateCount.WithLabelValues(kind).Add(float64(count))
+	}
+}
+
+// Records are spread over workers by kind, namespace and name, so records for one object are still processed in
+// the order they arrived while different objects are processed in parallel.  Events go to the worker of the object
+// they are about, which is the one that updates their counts
+func NewProcessing(config Config, kubeWatchChan chan typed.KubeWatchResult, tables typed.Tables) *Runner {
+	if config.MaxBatchRecords < 1 {
+		config.MaxBatchRecords = 1
+	}
+	if config.Workers < 1 {
+		config.Workers = 1
+	}
+	return &Runner{kubeWatchChan: kubeWatchChan, tables: tables, inputWg: &sync.WaitGroup{}, config: config}
 }
 
 func (r *Runner) processingFailed(name string, err error) {
@@ -42,56 +110,205 @@ func (r *Runner) processingFailed(name string, err error) {
 }
 
 func (r *Runner) Start() {
+	var workChans []chan workItem
+	for idx := 0; idx < r.config.Workers; idx++ {
+		workChan := make(chan workItem, r.config.MaxBatchRecords)
+		workChans = append(workChans, workChan)
+		r.inputWg.Add(1)
+		go r.runWorker(idx, workChan)
+	}
+
 	r.inputWg.Add(1)
 	go func() {
-		for {
-			watchRec, more := <-r.kubeWatchChan
-			if !more {
-				r.inputWg.Done()
-				return
-			}
-
-			resourceMetadata, err := kubeextractor.ExtractMetadata(watchRec.Payload)
+		defer r.inputWg.Done()
+		for watchRec := range r.kubeWatchChan {
+			item := workItem{watchRec: watchRec}
+			var err error
+			item.metadata, err = kubeextractor.ExtractMetadata(watchRec.Payload)
 			if err != nil {
 				r.processingFailed("cannot extract resource metadata", err)
 			}
-			glog.V(99).Infof("watchRec metadata: %v", resourceMetadata)
-			involvedObject, err := kubeextractor.ExtractInvolvedObject(watchRec.Payload)
+			item.involvedObject, err = kubeextractor.ExtractInvolvedObject(watchRec.Payload)
 			if err != nil {
 				r.processingFailed("cannot extract involved object", err)
 			}
+			workChans[r.shard(&item)] <- item
+		}
+		for _, workChan := range workChans {
+			close(workChan)
+		}
+	}()
+}
 
-			// Processing event count first so it can easily find the previous copy of the event
-			// If we update watchTable first then this will see the new event and think it is a dupe
-			err = r.tables.Db().Update(func(txn badgerwrap.Txn) error {
-				return updateEventCountTable(r.tables, txn, &watchRec, &resourceMetadata, &involvedObject, r.maxLookback)
-			})
-			if err != nil {
-				r.processingFailed("updateEventCountTable", err)
-			}
+func (r *Runner) shard(item *workItem) int {
+	if r.config.Workers == 1 {
+		return 0
+	}
+	object := item.watchRec.Kind + "/" + item.metadata.Namespace + "/" + item.metadata.Name
+	if item.watchRec.Kind == kubeextractor.EventKind && item.involvedObject.Name != "" {
+		object = item.involvedObject.Kind + "/" + item.involvedObject.Namespace + "/" + item.involvedObject.Name
+	}
+	hash := fnv.New32a()
+	hash.Write([]byte(object))
+	return int(hash.Sum32() % uint32(r.config.Workers))
+}
 
-			err = r.tables.Db().Update(func(txn badgerwrap.Txn) error {
-				return updateWatchActivityTable(r.tables, txn, &watchRec, &resourceMetadata)
-			})
-			if err != nil {
-				r.processingFailed("updateWatchActivityTable", err)
-			}
+func (r *Runner) runWorker(idx int, workChan chan workItem) {
+	defer r.inputWg.Done()
+	queued := metricProcessingWorkerQueued.WithLabelValues(fmt.Sprint(idx))
+	for {
+		batch, more := r.nextBatch(workChan)
+		queued.Set(float64(len(workChan)))
+		if len(batch) > 0 {
+			r.processBatch(batch)
+		}
+		if !more {
+			return
+		}
+	}
+}
 
-			err = r.tables.Db().Update(func(txn badgerwrap.Txn) error {
-				return updateKubeWatchTable(r.tables, txn, &watchRec, &resourceMetadata, r.keepMinorNodeUpdates)
-			})
-			if err != nil {
-				r.processingFailed("updateKubeWatchTable", err)
+// Blocks for the first record, then takes whatever else shows up within maxBatchLatency.  Returns false once the
+// channel is closed
+func (r *Runner) nextBatch(workChan chan workItem) ([]workItem, bool) {
+	item, more := <-workChan
+	if !more {
+		return nil, false
+	}
+	batch := []workItem{item}
+	var deadline <-chan time.Time
+	if r.config.MaxBatchLatency > 0 {
+		timer := time.NewTimer(r.config.MaxBatchLatency)
+		defer timer.Stop()
+		deadline = timer.C
+	}
+	for len(batch) < r.config.MaxBatchRecords {
+		if deadline == nil {
+			// No waiting, but take what is already queued
+			select {
+			case item, more = <-workChan:
+			default:
+				return batch, true
+			}
+		} else {
+			select {
+			case item, more = <-workChan:
+			case <-deadline:
+				return batch, true
 			}
+		}
+		if !more {
+			return batch, false
+		}
+		batch = append(batch, item)
+	}
+	return batch, true
+}
 
-			err = r.tables.Db().Update(func(txn badgerwrap.Txn) error {
-				return updateResourceSummaryTable(r.tables, txn, &watchRec, &resourceMetadata)
-			})
-			if err != nil {
-				r.processingFailed("updateResourceSummaryTable", err)
+// Commits the whole batch in one transaction.  When that fails, either because one record failed or because badger
+// says the transaction got too big, the halves are tried on their own until only the bad record is left out.  Every
+// record is all or nothing, so the tables always agree with each other.  A conflict with another worker retries the
+// same batch, since reading again sees what the other worker committed
+func (r *Runner) processBatch(batch []workItem) {
+	start := time.Now()
+	var err error
+	var counts *processingCounts
+	for attempt := 0; ; attempt++ {
+		err = r.tables.Db().Update(func(txn badgerwrap.Txn) error {
+			counts = newProcessingCounts()
+			for idx := range batch {
+				err := r.processRecord(txn, counts, &batch[idx])
+				if err != nil {
+					return err
+				}
 			}
+			return nil
+		})
+		if errors.Cause(err) != badger.ErrConflict || attempt >= maxConflictRetries {
+			break
 		}
-	}()
+		metricProcessingConflictCount.Inc()
+		time.Sleep(conflictRetryDelay)
+	}
+	if err == nil {
+		counts.publish()
+		metricProcessingRecordCount.Add(float64(len(batch)))
+		metricProcessingBatchRecords.Observe(float64(len(batch)))
+		metricProcessingBatchSec.Observe(time.Since(start).Seconds())
+		return
+	}
+	if len(batch) == 1 {
+		r.processingFailed(batch[0].watchRec.Kind, err)
+		return
+	}
+	glog.V(2).Infof("Splitting batch of %v records after: %v", len(batch), err)
+	metricProcessingBatchSplitCount.Inc()
+	half := len(batch) / 2
+	r.processBatch(batch[:half])
+	r.processBatch(batch[half:])
+}
+
+// Timestamps in payloads come from the api server or the kubelet, watch timestamps from us.  Lookups by a payload
+// timestamp go back this much further so they still find what they are after when the clocks do not agree
+const clockSkew = 5 * time.Minute
+
+// Reads in the transaction see what earlier records of the batch wrote, so records are processed as if one by one.
+// A payload the extractors for the derived tables (relationships, health, restarts, rollouts) can not make sense of
+// is logged and skipped by that table, and does not hold up the others
+func (r *Runner) processRecord(txn badgerwrap.Txn, counts *processingCounts, item *workItem) error {
+	watchRec := &item.watchRec
+	resourceMetadata := item.metadata
+	involvedObject := item.involvedObject
+	glog.V(99).Infof("watchRec metadata: %v", resourceMetadata)
+
+	// Checked before anything is written, because once the watch table has this record it looks like a replay
+	replay, err := isReplayOfStoredVersion(r.tables, txn, watchRec, &resourceMetadata)
+	if err != nil {
+		return errors.Wrap(err, "isReplayOfStoredVersion")
+	}
+
+	minorUpdate, err := isMinorUpdate(r.tables, txn, watchRec, &resourceMetadata, r.config.MinorUpdates)
+	if err != nil {
+		return errors.Wrap(err, "isMinorUpdate")
+	}
+
+	// Processing event count first so it can easily find the previous copy of the event
+	// If we update watchTable first then this will see the new event and think it is a dupe
+	err = updateEventCountTable(r.tables, txn, counts, watchRec, &resourceMetadata, &involvedObject, r.config.MaxLookback)
+	if err != nil {
+		return errors.Wrap(err, "updateEventCountTable")
+	}
+
+	err = updateWatchActivityTable(r.tables, txn, counts, watchRec, &resourceMetadata, minorUpdate)
+	if err != nil {
+		return errors.Wrap(err, "updateWatchActivityTable")
+	}
+
+	err = updateRestartTable(r.tables, txn, counts, watchRec, &resourceMetadata, replay, r.config.MaxLookback)
+	if err != nil {
+		return errors.Wrap(err, "updateRestartTable")
+	}
+
+	err = updateRolloutTable(r.tables, txn, counts, watchRec, &resourceMetadata, replay, r.config.MaxLookback)
+	if err != nil {
+		return errors.Wrap(err, "updateRolloutTable")
+	}
+
+	err = updateKubeWatchTable(r.tables, txn, counts, watchRec, &resourceMetadata, replay, minorUpdate, r.config.PayloadPatches)
+	if err != nil {
+		return errors.Wrap(err, "updateKubeWatchTable")
+	}
+
+	err = updateResourceSummaryTable(r.tables, txn, counts, watchRec, &resourceMetadata, replay)
+	if err != nil {
+		return errors.Wrap(err, "updateResourceSummaryTable")
+	}
+
+	err = updateHealthTable(r.tables, txn, counts, watchRec, &resourceMetadata)
+	if err != nil {
+		return errors.Wrap(err, "updateHealthTable")
+	}
+	return nil
 }
 
 func (r *Runner) Wait() {
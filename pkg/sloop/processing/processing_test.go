@@ -0,0 +1,398 @@
+/*
+ * Copyright (c) 2021, salesforce.com, inc.
+ * All rights reserved.
+ * SPDX-License-Identifier: BSD-3-Clause
+ * For full license text, see LICENSE.txt file in the repo root or https://opensource.org/licenses/BSD-3-Clause
+ */
+
+package processing
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dgraph-io/badger/v2"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/salesforce/sloop/pkg/sloop/ingress"
+	"github.com/salesforce/sloop/pkg/sloop/kubeextractor"
+	"github.com/salesforce/sloop/pkg/sloop/store/typed"
+	"github.com/salesforce/sloop/pkg/sloop/store/untyped"
+	"github.com/salesforce/sloop/pkg/sloop/store/untyped/badgerwrap"
+	"github.com/stretchr/testify/assert"
+)
+
+func helper_podWatchRecord(t *testing.T, name string, creationTimestamp string, offset time.Duration) typed.KubeWatchResult {
+	ts, err := ptypes.TimestampProto(someWatchTime.Add(offset))
+	assert.Nil(t, err)
+	payload := fmt.Sprintf(`{"metadata":{"name":%q,"namespace":"someNamespace","uid":"uid-%v","resourceVersion":"1","creationTimestamp":%q}}`, name, name, creationTimestamp)
+	return typed.KubeWatchResult{Kind: someKind, WatchType: typed.KubeWatchResult_ADD, Timestamp: ts, Payload: payload}
+}
+
+// Returns the kind, namespace and name parts of every key, grouped by table
+func helper_tableKeys(t *testing.T, tables typed.Tables) map[string][]string {
+	keys := map[string][]string{}
+	err := tables.Db().View(func(txn badgerwrap.Txn) error {
+		itr := txn.NewIterator(badger.DefaultIteratorOptions)
+		defer itr.Close()
+		for itr.Rewind(); itr.Valid(); itr.Next() {
+			parts := strings.Split(string(itr.Item().Key()), "/")
+			keys[parts[1]] = append(keys[parts[1]], parts[5])
+		}
+		return nil
+	})
+	assert.Nil(t, err)
+	return keys
+}
+
+func helper_workItem(t *testing.T, name string) workItem {
+	watchRec := helper_podWatchRecord(t, name, "2019-03-04T03:04:05Z", 0)
+	metadata, err := kubeextractor.ExtractMetadata(watchRec.Payload)
+	assert.Nil(t, err)
+	return workItem{watchRec: watchRec, metadata: metadata}
+}
+
+func Test_Runner_nextBatch(t *testing.T) {
+	workChan := make(chan workItem, 10)
+	for idx := 0; idx < 5; idx++ {
+		workChan <- helper_workItem(t, fmt.Sprintf("p%v", idx))
+	}
+	close(workChan)
+	runner := NewProcessing(Config{MaxLookback: time.Hour, MaxBatchRecords: 2, MaxBatchLatency: time.Hour, Workers: 1}, nil, nil)
+
+	var sizes []int
+	for {
+		batch, more := runner.nextBatch(workChan)
+		sizes = append(sizes, len(batch))
+		if !more {
+			break
+		}
+	}
+	assert.Equal(t, []int{2, 2, 1}, sizes)
+}
+
+func Test_Runner_nextBatch_WaitsAtMostLatency(t *testing.T) {
+	workChan := make(chan workItem, 10)
+	workChan <- helper_workItem(t, "p")
+	runner := NewProcessing(Config{MaxLookback: time.Hour, MaxBatchRecords: 100, MaxBatchLatency: 20 * time.Millisecond, Workers: 1}, nil, nil)
+
+	start := time.Now()
+	batch, more := runner.nextBatch(workChan)
+	assert.True(t, more)
+	assert.Len(t, batch, 1)
+	assert.True(t, time.Since(start) >= 20*time.Millisecond)
+
+	runner = NewProcessing(Config{MaxLookback: time.Hour, MaxBatchRecords: 100, Workers: 1}, nil, nil)
+	workChan <- helper_workItem(t, "p")
+	batch, _ = runner.nextBatch(workChan)
+	assert.Len(t, batch, 1)
+}
+
+func Test_Runner_FailedRecordCommitsNothing(t *testing.T) {
+	untyped.TestHookSetPartitionDuration(time.Hour)
+	db, err := (&badgerwrap.MockFactory{}).Open(badger.DefaultOptions(""))
+	assert.Nil(t, err)
+	tables := typed.NewTableList(db)
+
+	inChan := make(chan typed.KubeWatchResult, 10)
+	inChan <- helper_podWatchRecord(t, "a", "2019-03-04T03:04:05Z", 0)
+	// The resource summary can not parse this, which happens after the other tables were updated
+	inChan <- helper_podWatchRecord(t, "b", "yesterday", time.Second)
+	inChan <- helper_podWatchRecord(t, "c", "2019-03-04T03:04:05Z", 2*time.Second)
+	close(inChan)
+	watchUpdates := testutil.ToFloat64(metricProcessingWatchtableUpdatecount)
+	runner := NewProcessing(Config{MaxLookback: time.Hour, MaxBatchRecords: 10, Workers: 1}, inChan, tables)
+	runner.Start()
+	runner.Wait()
+
+	// Only what was committed counts, not the attempts that were rolled back
+	assert.Equal(t, watchUpdates+2, testutil.ToFloat64(metricProcessingWatchtableUpdatecount))
+	keys := helper_tableKeys(t, tables)
+	assert.Equal(t, []string{"a", "c"}, keys["watch"])
+	assert.Equal(t, []string{"a", "c"}, keys["watchactivity"])
+	assert.Equal(t, []string{"a", "c"}, keys["ressum"])
+}
+
+func Test_Runner_shard_SameObjectSameWorker(t *testing.T) {
+	runner := NewProcessing(Config{MaxLookback: time.Hour, MaxBatchRecords: 10, Workers: 4}, nil, nil)
+	used := map[int]bool{}
+	for idx := 0; idx < 100; idx++ {
+		item := helper_workItem(t, fmt.Sprintf("p%v", idx))
+		shard := runner.shard(&item)
+		assert.Equal(t, shard, runner.shard(&item))
+		assert.True(t, shard >= 0 && shard < 4)
+		used[shard] = true
+
+		// Events about the pod go with the pod
+		event := workItem{
+			watchRec:       typed.KubeWatchResult{Kind: kubeextractor.EventKind},
+			metadata:       kubeextractor.KubeMetadata{Name: fmt.Sprintf("p%v.abc", idx), Namespace: "someNamespace"},
+			involvedObject: kubeextractor.KubeInvolvedObject{Kind: someKind, Name: fmt.Sprintf("p%v", idx), Namespace: "someNamespace"},
+		}
+		assert.Equal(t, shard, runner.shard(&event))
+	}
+	assert.Len(t, used, 4)
+}
+
+func Test_Runner_Workers_KeepOrderPerObject(t *testing.T) {
+	untyped.TestHookSetPartitionDuration(time.Hour)
+	db, err := (&badgerwrap.MockFactory{}).Open(badger.DefaultOptions(""))
+	assert.Nil(t, err)
+	tables := typed.NewTableList(db)
+
+	inChan := make(chan typed.KubeWatchResult, 100)
+	go func() {
+		for update := 0; update < 5; update++ {
+			for pod := 0; pod < 20; pod++ {
+				ts, _ := ptypes.TimestampProto(someWatchTime.Add(time.Duration(update) * time.Second))
+				payload := fmt.Sprintf(`{"metadata":{"name":"p%v","namespace":"someNamespace","uid":"uid-p%v","resourceVersion":"%v","creationTimestamp":"2019-03-04T03:04:05Z"},"status":{"update":%v}}`, pod, pod, update+1, update)
+				inChan <- typed.KubeWatchResult{Kind: someKind, WatchType: typed.KubeWatchResult_UPDATE, Timestamp: ts, Payload: payload}
+			}
+		}
+		close(inChan)
+	}()
+	runner := NewProcessing(Config{MaxLookback: time.Hour, MaxBatchRecords: 3, Workers: 4}, inChan, tables)
+	runner.Start()
+	runner.Wait()
+
+	last, _ := ptypes.TimestampProto(someWatchTime.Add(4 * time.Second))
+	err = db.View(func(txn badgerwrap.Txn) error {
+		for pod := 0; pod < 20; pod++ {
+			key := typed.NewResourceSummaryKey(someWatchTime, someKind, "someNamespace", fmt.Sprintf("p%v", pod), fmt.Sprintf("uid-p%v", pod)).String()
+			value, err := tables.ResourceSummaryTable().Get(txn, key)
+			assert.Nil(t, err)
+			// The last update of every object was processed last
+			assert.Equal(t, last, value.LastSeen, key)
+		}
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Len(t, helper_tableKeys(t, tables)["watch"], 100)
+}
+
+// Fails the first commits with a conflict, after running the transaction like badger does
+type conflictingDb struct {
+	badgerwrap.DB
+	conflicts int
+}
+
+func (d *conflictingDb) Update(fn func(txn badgerwrap.Txn) error) error {
+	return d.DB.Update(func(txn badgerwrap.Txn) error {
+		err := fn(txn)
+		if err != nil {
+			return err
+		}
+		if d.conflicts > 0 {
+			d.conflicts--
+			return badger.ErrConflict
+		}
+		return nil
+	})
+}
+
+func Test_Runner_RetriesConflicts(t *testing.T) {
+	untyped.TestHookSetPartitionDuration(time.Hour)
+	mockDb, err := (&badgerwrap.MockFactory{}).Open(badger.DefaultOptions(""))
+	assert.Nil(t, err)
+	db := &conflictingDb{DB: mockDb, conflicts: 2}
+	tables := typed.NewTableList(db)
+
+	inChan := make(chan typed.KubeWatchResult, 10)
+	inChan <- helper_podWatchRecord(t, "a", "2019-03-04T03:04:05Z", 0)
+	inChan <- helper_podWatchRecord(t, "b", "2019-03-04T03:04:05Z", time.Second)
+	close(inChan)
+	watchUpdates := testutil.ToFloat64(metricProcessingWatchtableUpdatecount)
+	runner := NewProcessing(Config{MaxLookback: time.Hour, MaxBatchRecords: 10, Workers: 1}, inChan, tables)
+	runner.Start()
+	runner.Wait()
+
+	assert.Equal(t, 0, db.conflicts)
+	assert.Equal(t, watchUpdates+2, testutil.ToFloat64(metricProcessingWatchtableUpdatecount))
+	keys := helper_tableKeys(t, tables)
+	assert.Equal(t, []string{"a", "b"}, keys["watch"])
+	assert.Equal(t, []string{"a", "b"}, keys["ressum"])
+}
+
+// Rolls out pods in a few namespaces: each is added, updated a few times and gets events along the way
+func helper_recordRollout(b *testing.B, filename string) {
+	recChan := make(chan typed.KubeWatchResult, 1000)
+	recorder, err := ingress.NewFileRecorder(ingress.FileRecorderConfig{Filename: filename}, recChan)
+	if err != nil {
+		b.Fatal(err)
+	}
+	recorder.Start()
+	for pod := 0; pod < 300; pod++ {
+		namespace := fmt.Sprintf("namespace%v", pod%10)
+		name := fmt.Sprintf("app-%v", pod)
+		for update := 0; update < 5; update++ {
+			ts, _ := ptypes.TimestampProto(someWatchTime.Add(time.Duration(pod*5+update) * time.Second))
+			watchType := typed.KubeWatchResult_UPDATE
+			if update == 0 {
+				watchType = typed.KubeWatchResult_ADD
+			}
+			payload := fmt.Sprintf(`{"metadata":{"name":%q,"namespace":%q,"uid":"uid-%v","resourceVersion":"%v","creationTimestamp":"2019-03-04T03:04:05Z"},"status":{"phase":"Pending","restarts":%v}}`, name, namespace, name, update+1, update)
+			recChan <- typed.KubeWatchResult{Kind: someKind, WatchType: watchType, Timestamp: ts, Payload: payload}
+
+			if update%2 == 1 {
+				event := fmt.Sprintf(`{"metadata":{"name":"%v.%v","namespace":%q,"uid":"uid-%v-%v","resourceVersion":"1"},"involvedObject":{"kind":"Pod","namespace":%q,"name":%q,"uid":"uid-%v"},"reason":"Pulled","firstTimestamp":"2019-03-04T03:04:05Z","lastTimestamp":"2019-03-04T03:04:05Z","count":1,"type":"Normal"}`,
+					name, update, namespace, name, update, namespace, name, name)
+				recChan <- typed.KubeWatchResult{Kind: kubeextractor.EventKind, WatchType: typed.KubeWatchResult_ADD, Timestamp: ts, Payload: event}
+			}
+		}
+	}
+	close(recChan)
+	err = recorder.Close()
+	if err != nil {
+		b.Fatal(err)
+	}
+}
+
+// Plays back SLOOP_BENCH_PLAYBACK_FILE, or a generated rollout when it is not set
+func helper_benchmarkRecords(b *testing.B) []typed.KubeWatchResult {
+	filename := os.Getenv("SLOOP_BENCH_PLAYBACK_FILE")
+	if filename == "" {
+		dir, err := ioutil.TempDir("", "sloop-bench-recording")
+		if err != nil {
+			b.Fatal(err)
+		}
+		defer os.RemoveAll(dir)
+		filename = path.Join(dir, "rollout.ndjson")
+		helper_recordRollout(b, filename)
+	}
+
+	var records []typed.KubeWatchResult
+	playChan := make(chan typed.KubeWatchResult, 1000)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for watchRec := range playChan {
+			records = append(records, watchRec)
+		}
+	}()
+	err := ingress.PlayFile(playChan, filename, ingress.PlaybackConfig{})
+	close(playChan)
+	<-done
+	if err != nil {
+		b.Fatal(err)
+	}
+	return records
+}
+
+func BenchmarkRunner_Workers(b *testing.B) {
+	untyped.TestHookSetPartitionDuration(time.Hour)
+	records := helper_benchmarkRecords(b)
+	for _, workers := range []int{1, 2, 4, 8} {
+		b.Run(fmt.Sprintf("workers=%v", workers), func(b *testing.B) {
+			var elapsed time.Duration
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				dir, err := ioutil.TempDir("", "sloop-bench-store")
+				if err != nil {
+					b.Fatal(err)
+				}
+				db, err := (&badgerwrap.BadgerFactory{}).Open(badger.DefaultOptions(dir).WithLogger(nil))
+				if err != nil {
+					b.Fatal(err)
+				}
+				inChan := make(chan typed.KubeWatchResult, len(records))
+				for _, watchRec := range records {
+					inChan <- watchRec
+				}
+				close(inChan)
+				runner := NewProcessing(Config{MaxLookback: 14 * 24 * time.Hour, MaxBatchRecords: 100, Workers: workers}, inChan, typed.NewTableList(db))
+
+				start := time.Now()
+				b.StartTimer()
+				runner.Start()
+				runner.Wait()
+				b.StopTimer()
+				elapsed += time.Since(start)
+
+				db.Close()
+				os.RemoveAll(dir)
+			}
+			b.ReportMetric(float64(len(records)*b.N)/elapsed.Seconds(), "records/s")
+		})
+	}
+}
+
+func Test_Runner_MinorUpdateOnlyCountsAsNoChange(t *testing.T) {
+	untyped.TestHookSetPartitionDuration(time.Hour)
+	db, err := (&badgerwrap.MockFactory{}).Open(badger.DefaultOptions(""))
+	assert.Nil(t, err)
+	tables := typed.NewTableList(db)
+	minorUpdates, err := ingress.NewMinorUpdates([]ingress.MinorUpdateRule{{Kind: "Lease", Path: "spec.renewTime"}})
+	assert.Nil(t, err)
+
+	inChan := make(chan typed.KubeWatchResult, 10)
+	for idx, spec := range []string{`"holderIdentity":"a","renewTime":"1"`, `"holderIdentity":"a","renewTime":"2"`, `"holderIdentity":"b","renewTime":"3"`} {
+		ts, _ := ptypes.TimestampProto(someWatchTime.Add(time.Duration(idx) * time.Second))
+		payload := fmt.Sprintf(`{"metadata":{"name":"l","namespace":"someNamespace","uid":"uid-l","resourceVersion":"%v","creationTimestamp":"2019-03-04T03:04:05Z"},"spec":{%v}}`, idx+1, spec)
+		inChan <- typed.KubeWatchResult{Kind: "Lease", WatchType: typed.KubeWatchResult_UPDATE, Timestamp: ts, Payload: payload}
+	}
+	close(inChan)
+	runner := NewProcessing(Config{MinorUpdates: minorUpdates, MaxLookback: time.Hour, MaxBatchRecords: 10, Workers: 1}, inChan, tables)
+	runner.Start()
+	runner.Wait()
+
+	assert.Len(t, helper_tableKeys(t, tables)["watch"], 2)
+	err = db.View(func(txn badgerwrap.Txn) error {
+		key := typed.NewWatchActivityKey(untyped.GetPartitionId(someWatchTime), "Lease", "someNamespace", "l", "uid-l").String()
+		activity, err := tables.WatchActivityTable().Get(txn, key)
+		assert.Nil(t, err)
+		assert.Equal(t, []int64{someWatchTime.Add(2 * time.Second).Unix()}, activity.ChangedAt)
+		assert.Equal(t, []int64{someWatchTime.Unix(), someWatchTime.Add(time.Second).Unix()}, activity.NoChangeAt)
+		return nil
+	})
+	assert.Nil(t, err)
+}
+
+func Test_Runner_PayloadPatches_ReadBackWhole(t *testing.T) {
+	untyped.TestHookSetPartitionDuration(time.Hour)
+	db, err := (&badgerwrap.MockFactory{}).Open(badger.DefaultOptions(""))
+	assert.Nil(t, err)
+	tables := typed.NewTableList(db)
+
+	nodePayload := func(resourceVersion int, ready string) string {
+		// Big enough for the update to be stored as a patch
+		return fmt.Sprintf(`{"metadata":{"name":"somehostname","uid":"uid-n","resourceVersion":"%v","creationTimestamp":"2019-03-04T03:04:05Z","annotations":{"a":%q}},"status":{"conditions":[{"type":"Ready","status":%q}]}}`,
+			resourceVersion, strings.Repeat("x", 500), ready)
+	}
+	payloads := []string{nodePayload(1, "True"), nodePayload(2, "False"), nodePayload(2, "False")}
+	inChan := make(chan typed.KubeWatchResult, 10)
+	for idx, payload := range payloads {
+		ts, _ := ptypes.TimestampProto(someWatchTime.Add(time.Duration(idx) * time.Second))
+		inChan <- typed.KubeWatchResult{Kind: kubeextractor.NodeKind, WatchType: typed.KubeWatchResult_UPDATE, Timestamp: ts, Payload: payload}
+	}
+	close(inChan)
+	runner := NewProcessing(Config{MaxLookback: time.Hour, MaxBatchRecords: 10, Workers: 1, PayloadPatches: true}, inChan, tables)
+	runner.Start()
+	runner.Wait()
+
+	err = db.View(func(txn badgerwrap.Txn) error {
+		results, _, err := tables.WatchTable().RangeRead(txn, typed.NewWatchTableKeyComparator(kubeextractor.NodeKind, "", "somehostname", time.Time{}), nil, nil, someWatchTime, someWatchTime.Add(time.Minute))
+		assert.Nil(t, err)
+		// The replay of the stored resource version was still recognized
+		assert.Len(t, results, 2)
+		for key, value := range results {
+			expected := payloads[key.Timestamp.Sub(someWatchTime)/time.Second]
+			assert.JSONEq(t, expected, value.Payload)
+		}
+
+		item, err := txn.Get([]byte(typed.NewWatchTableKey(untyped.GetPartitionId(someWatchTime), kubeextractor.NodeKind, "", "somehostname", someWatchTime.Add(time.Second)).String()))
+		assert.Nil(t, err)
+		valueBytes, err := item.ValueCopy([]byte{})
+		assert.Nil(t, err)
+		stored := &typed.KubeWatchResult{}
+		assert.Nil(t, proto.Unmarshal(valueBytes, stored))
+		assert.NotNil(t, stored.PayloadKeyframe)
+		return nil
+	})
+	assert.Nil(t, err)
+}
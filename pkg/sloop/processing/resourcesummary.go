@@ -20,7 +20,8 @@ import (
 
 // TODO: Split this up and add unit tests
 
-func updateResourceSummaryTable(tables typed.Tables, txn badgerwrap.Txn, watchRec *typed.KubeWatchResult, metadata *kubeextractor.KubeMetadata) error {
+// A replay of a stored resource version only moves LastSeen
+func updateResourceSummaryTable(tables typed.Tables, txn badgerwrap.Txn, counts *processingCounts, watchRec *typed.KubeWatchResult, metadata *kubeextractor.KubeMetadata, replay bool) error {
 	if watchRec.Kind == kubeextractor.EventKind {
 		glog.V(2).Infof("Skipping resource summary table update as kubewatch result is an event(selfLink: %v)", metadata.SelfLink)
 		return nil
@@ -32,23 +33,24 @@ func updateResourceSummaryTable(tables typed.Tables, txn badgerwrap.Txn, watchRe
 
 	key := typed.NewResourceSummaryKey(ts, watchRec.Kind, metadata.Namespace, metadata.Name, metadata.Uid).String()
 
-	value, err := getResourceSummaryValue(tables, txn, key, metadata, watchRec)
+	value, err := getResourceSummaryValue(tables, txn, key, metadata, watchRec, replay)
 	if err != nil {
 		return errors.Wrapf(err, "could not get record for key %v", key)
 	}
 
 	value.Relationships = getRelationships(ts, metadata)
+	value.Edges = append(getEdges(watchRec, metadata), getEventEdges(value.Edges)...)
 
 	err = tables.ResourceSummaryTable().Set(txn, key, value)
 	if err != nil {
 		return errors.Wrapf(err, "put for the key %v failed", key)
 	}
 
-	metricIngestionSuccessCount.Inc()
+	counts.success++
 	return nil
 }
 
-func getResourceSummaryValue(tables typed.Tables, txn badgerwrap.Txn, key string, metadata *kubeextractor.KubeMetadata, watchRec *typed.KubeWatchResult) (*typed.ResourceSummary, error) {
+func getResourceSummaryValue(tables typed.Tables, txn badgerwrap.Txn, key string, metadata *kubeextractor.KubeMetadata, watchRec *typed.KubeWatchResult, replay bool) (*typed.ResourceSummary, error) {
 	value, err := tables.ResourceSummaryTable().Get(txn, key)
 	if err != nil {
 		if err != badger.ErrKeyNotFound {
@@ -62,7 +64,8 @@ func getResourceSummaryValue(tables typed.Tables, txn badgerwrap.Txn, key string
 			FirstSeen:    watchRec.Timestamp,
 			CreateTime:   createTimeProto,
 			DeletedAtEnd: false}
-	} else if watchRec.WatchType == typed.KubeWatchResult_ADD {
+	} else if watchRec.WatchType == typed.KubeWatchResult_ADD && !watchRec.Replay && !replay {
+		// Listing objects that were already there is not a creation
 		value.FirstSeen = watchRec.Timestamp
 	}
 	value.LastSeen = watchRec.Timestamp
@@ -80,3 +83,27 @@ func getRelationships(timestamp time.Time, metadata *kubeextractor.KubeMetadata)
 	}
 	return relationships
 }
+
+// A payload we can not make sense of still gets a summary, with whatever edges were found before the problem
+func getEdges(watchRec *typed.KubeWatchResult, metadata *kubeextractor.KubeMetadata) []*typed.Relationship {
+	relationships, err := kubeextractor.ExtractRelationships(watchRec.Kind, watchRec.Payload, *metadata)
+	if err != nil {
+		glog.Errorf("Could not extract all relationships: %v", err)
+	}
+	edges := []*typed.Relationship{}
+	for _, rel := range relationships {
+		edges = append(edges, &typed.Relationship{Type: rel.Type, Kind: rel.Kind, Namespace: rel.Namespace, Name: rel.Name, Uid: rel.Uid, Selector: rel.Selector})
+	}
+	return edges
+}
+
+// Edges to events come from the event count update, not the payload, so they are kept when the payload changes
+func getEventEdges(edges []*typed.Relationship) []*typed.Relationship {
+	ret := []*typed.Relationship{}
+	for _, edge := range edges {
+		if edge.Type == kubeextractor.RelationshipInvolvedObject {
+			ret = append(ret, edge)
+		}
+	}
+	return ret
+}
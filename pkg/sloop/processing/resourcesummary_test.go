@@ -0,0 +1,92 @@
+/*
+ * Copyright (c) 2021, salesforce.com, inc.
+ * All rights reserved.
+ * SPDX-License-Identifier: BSD-3-Clause
+ * For full license text, see LICENSE.txt file in the repo root or https://opensource.org/licenses/BSD-3-Clause
+ */
+
+package processing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dgraph-io/badger/v2"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/salesforce/sloop/pkg/sloop/kubeextractor"
+	"github.com/salesforce/sloop/pkg/sloop/store/typed"
+	"github.com/salesforce/sloop/pkg/sloop/store/untyped"
+	"github.com/salesforce/sloop/pkg/sloop/store/untyped/badgerwrap"
+	"github.com/salesforce/sloop/pkg/sloop/test/assertex"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_updateResourceSummaryTable_ReplaysKeepFirstSeen(t *testing.T) {
+	untyped.TestHookSetPartitionDuration(time.Hour)
+	db, err := (&badgerwrap.MockFactory{}).Open(badger.DefaultOptions(""))
+	assert.Nil(t, err)
+	tables := typed.NewTableList(db)
+	metadata, err := kubeextractor.ExtractMetadata(somePodPayload)
+	assert.Nil(t, err)
+
+	update := func(offset time.Duration, watchType typed.KubeWatchResult_WatchType, flagged bool, replay bool) *typed.ResourceSummary {
+		ts, err := ptypes.TimestampProto(someWatchTime.Add(offset))
+		assert.Nil(t, err)
+		watchRec := &typed.KubeWatchResult{Kind: someKind, WatchType: watchType, Timestamp: ts, Payload: somePodPayload, Replay: flagged}
+		var value *typed.ResourceSummary
+		err = tables.Db().Update(func(txn badgerwrap.Txn) error {
+			err := updateResourceSummaryTable(tables, txn, newProcessingCounts(), watchRec, &metadata, replay)
+			if err != nil {
+				return err
+			}
+			value, err = tables.ResourceSummaryTable().Get(txn, typed.NewResourceSummaryKey(someWatchTime, someKind, metadata.Namespace, metadata.Name, metadata.Uid).String())
+			return err
+		})
+		assert.Nil(t, err)
+		return value
+	}
+
+	first, _ := ptypes.TimestampProto(someWatchTime)
+	update(0, typed.KubeWatchResult_ADD, false, false)
+	value := update(time.Minute, typed.KubeWatchResult_ADD, false, true)
+	assertex.ProtoEqual(t, first, value.FirstSeen)
+	value = update(2*time.Minute, typed.KubeWatchResult_ADD, true, false)
+	assertex.ProtoEqual(t, first, value.FirstSeen)
+	last, _ := ptypes.TimestampProto(someWatchTime.Add(2 * time.Minute))
+	assertex.ProtoEqual(t, last, value.LastSeen)
+
+	// Without either it really was added again
+	value = update(3*time.Minute, typed.KubeWatchResult_ADD, false, false)
+	assertex.ProtoEqual(t, value.LastSeen, value.FirstSeen)
+}
+
+func Test_updateResourceSummaryTable_StoresEdges(t *testing.T) {
+	untyped.TestHookSetPartitionDuration(time.Hour)
+	db, err := (&badgerwrap.MockFactory{}).Open(badger.DefaultOptions(""))
+	assert.Nil(t, err)
+	tables := typed.NewTableList(db)
+	payload := `{"metadata":{"name":"web-0","namespace":"ns","uid":"uid-web-0","creationTimestamp":"2019-03-04T03:04:05Z",` +
+		`"ownerReferences":[{"kind":"StatefulSet","name":"web","uid":"uid-web"}]},"spec":{"nodeName":"node1"}}`
+	metadata, err := kubeextractor.ExtractMetadata(payload)
+	assert.Nil(t, err)
+	ts, err := ptypes.TimestampProto(someWatchTime)
+	assert.Nil(t, err)
+	watchRec := &typed.KubeWatchResult{Kind: kubeextractor.PodKind, WatchType: typed.KubeWatchResult_ADD, Timestamp: ts, Payload: payload}
+
+	err = tables.Db().Update(func(txn badgerwrap.Txn) error {
+		err := updateResourceSummaryTable(tables, txn, newProcessingCounts(), watchRec, &metadata, false)
+		if err != nil {
+			return err
+		}
+		value, err := tables.ResourceSummaryTable().Get(txn, typed.NewResourceSummaryKey(someWatchTime, kubeextractor.PodKind, "ns", "web-0", "uid-web-0").String())
+		if err != nil {
+			return err
+		}
+		assert.Equal(t, []string{typed.NewResourceSummaryKey(someWatchTime, "StatefulSet", "ns", "web", "uid-web").String()}, value.Relationships)
+		assert.Len(t, value.Edges, 2)
+		assertex.ProtoEqual(t, &typed.Relationship{Type: kubeextractor.RelationshipOwner, Kind: "StatefulSet", Namespace: "ns", Name: "web", Uid: "uid-web"}, value.Edges[0])
+		assertex.ProtoEqual(t, &typed.Relationship{Type: kubeextractor.RelationshipNodeName, Kind: kubeextractor.NodeKind, Name: "node1"}, value.Edges[1])
+		return nil
+	})
+	assert.Nil(t, err)
+}
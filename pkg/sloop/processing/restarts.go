@@ -0,0 +1,152 @@
+/*
+ * Copyright (c) 2021, salesforce.com, inc.
+ * All rights reserved.
+ * SPDX-License-Identifier: BSD-3-Clause
+ * For full license text, see LICENSE.txt file in the repo root or https://opensource.org/licenses/BSD-3-Clause
+ */
+
+package processing
+
+import (
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/pkg/errors"
+	"github.com/salesforce/sloop/pkg/sloop/kubeextractor"
+	"github.com/salesforce/sloop/pkg/sloop/store/typed"
+	"github.com/salesforce/sloop/pkg/sloop/store/untyped"
+	"github.com/salesforce/sloop/pkg/sloop/store/untyped/badgerwrap"
+)
+
+// Compares the pod with the copy stored before it, so this has to run before the watch table gets the new copy.  A
+// replay carries a version we already compared
+func updateRestartTable(tables typed.Tables, txn badgerwrap.Txn, counts *processingCounts, watchRec *typed.KubeWatchResult, metadata *kubeextractor.KubeMetadata, replay bool, maxLookback time.Duration) error {
+	if watchRec.Kind != kubeextractor.PodKind || replay {
+		return nil
+	}
+	timestamp, err := ptypes.Timestamp(watchRec.Timestamp)
+	if err != nil {
+		return errors.Wrapf(err, "Could not convert timestamp %v", watchRec.Timestamp)
+	}
+
+	prevPayload, err := getPreviousPayload(tables, txn, timestamp, watchRec.Kind, metadata, maxLookback)
+	if err != nil {
+		return err
+	}
+	terminations, err := kubeextractor.ExtractContainerTerminations(prevPayload, watchRec.Payload)
+	if err != nil {
+		glog.Errorf("Could not extract container restarts of %v/%v: %v", metadata.Namespace, metadata.Name, err)
+		return nil
+	}
+
+	for _, termination := range terminations {
+		key := typed.NewRestartKey(untyped.GetPartitionId(timestamp), metadata.Namespace, metadata.Name, metadata.Uid, termination.Container)
+		restartRecord, err := tables.RestartTable().GetOrDefault(txn, key.String())
+		if err != nil {
+			return errors.Wrap(err, "Could not get container restart record")
+		}
+		// A restart can be seen on both sides of a partition boundary, so the partition before is checked too
+		prevKey := typed.NewRestartKey(untyped.GetPartitionId(timestamp.Add(-untyped.GetPartitionDuration())), metadata.Namespace, metadata.Name, metadata.Uid, termination.Container)
+		prevRecord, err := tables.RestartTable().GetOrDefault(txn, prevKey.String())
+		if err != nil {
+			return errors.Wrap(err, "Could not get container restart record of the previous partition")
+		}
+		restart := toContainerRestart(timestamp, &termination)
+		if isStoredRestart(prevRecord, restart) || isStoredRestart(restartRecord, restart) {
+			continue
+		}
+
+		// A container that failed is seen terminated first, and restarted with that as its last state on a later
+		// update.  That is one restart, which stays where the termination was stored
+		count := len(restartRecord.Restarts)
+		prevCount := len(prevRecord.Restarts)
+		if count > 0 && isSameTermination(restartRecord.Restarts[count-1], restart) {
+			restart.Timestamp = restartRecord.Restarts[count-1].Timestamp
+			restartRecord.Restarts[count-1] = restart
+		} else if count == 0 && prevCount > 0 && isSameTermination(prevRecord.Restarts[prevCount-1], restart) {
+			restart.Timestamp = prevRecord.Restarts[prevCount-1].Timestamp
+			prevRecord.Restarts[prevCount-1] = restart
+			key, restartRecord = prevKey, prevRecord
+		} else {
+			restartRecord.Restarts = append(restartRecord.Restarts, restart)
+		}
+
+		err = tables.RestartTable().Set(txn, key.String(), restartRecord)
+		if err != nil {
+			return errors.Wrap(err, "Failed to put container restart record")
+		}
+	}
+	if len(terminations) > 0 {
+		counts.success++
+	}
+	return nil
+}
+
+// The resource can have been quiet for a long time, so earlier partitions are searched too, back to when it was
+// created.  When it was created within that window and we have no copy, or the copy is of an older resource with the
+// same name, it is compared with an empty one.  When it is older than the window there is nothing to compare with
+func getPreviousPayload(tables typed.Tables, txn badgerwrap.Txn, timestamp time.Time, kind string, metadata *kubeextractor.KubeMetadata, maxLookback time.Duration) (string, error) {
+	since := timestamp.Add(-maxLookback)
+	createdInWindow := false
+	created, err := time.Parse(time.RFC3339, metadata.CreationTimestamp)
+	if err == nil && created.Add(-clockSkew).After(since) {
+		since = created.Add(-clockSkew)
+		createdInWindow = true
+	}
+
+	prevWatch, err := getLastKubeWatchResultSince(tables, txn, timestamp, kind, metadata.Namespace, metadata.Name, since)
+	if err != nil {
+		return "", errors.Wrapf(err, "Could not get previous copy of %v", kind)
+	}
+	if prevWatch != nil {
+		prevMetadata, err := kubeextractor.ExtractMetadata(prevWatch.Payload)
+		if err != nil {
+			return "", errors.Wrap(err, "Cannot extract resource metadata")
+		}
+		if prevMetadata.Uid == metadata.Uid {
+			return prevWatch.Payload, nil
+		}
+		createdInWindow = true
+	}
+	if createdInWindow {
+		return "{}", nil
+	}
+	return "", nil
+}
+
+func toContainerRestart(timestamp time.Time, termination *kubeextractor.ContainerTermination) *typed.ContainerRestart {
+	restart := &typed.ContainerRestart{
+		Timestamp:     timestamp.Unix(),
+		InitContainer: termination.InitContainer,
+		Restarted:     termination.Restarted,
+		RestartCount:  termination.RestartCount,
+		Reason:        termination.Reason,
+		ExitCode:      termination.ExitCode,
+		Signal:        termination.Signal,
+		Message:       termination.Message,
+		ContainerId:   termination.ContainerId,
+	}
+	if !termination.StartedAt.IsZero() {
+		restart.StartedAt = termination.StartedAt.Unix()
+	}
+	if !termination.FinishedAt.IsZero() {
+		restart.FinishedAt = termination.FinishedAt.Unix()
+	}
+	return restart
+}
+
+// Updates dropped by the minor update rules are not stored, so the update after one is compared with the same older
+// copy again, and finds the restart that was already stored
+func isStoredRestart(restartRecord *typed.ContainerRestarts, restart *typed.ContainerRestart) bool {
+	for _, stored := range restartRecord.Restarts {
+		if stored.Restarted == restart.Restarted && stored.RestartCount == restart.RestartCount && stored.ContainerId == restart.ContainerId && stored.FinishedAt == restart.FinishedAt {
+			return true
+		}
+	}
+	return false
+}
+
+func isSameTermination(stored *typed.ContainerRestart, restart *typed.ContainerRestart) bool {
+	return !stored.Restarted && restart.Restarted && stored.ContainerId != "" && stored.ContainerId == restart.ContainerId && stored.FinishedAt == restart.FinishedAt
+}
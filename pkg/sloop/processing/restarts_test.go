@@ -0,0 +1,135 @@
+/*
+ * Copyright (c) 2021, salesforce.com, inc.
+ * All rights reserved.
+ * SPDX-License-Identifier: BSD-3-Clause
+ * For full license text, see LICENSE.txt file in the repo root or https://opensource.org/licenses/BSD-3-Clause
+ */
+
+package processing
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/dgraph-io/badger/v2"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/salesforce/sloop/pkg/sloop/kubeextractor"
+	"github.com/salesforce/sloop/pkg/sloop/store/typed"
+	"github.com/salesforce/sloop/pkg/sloop/store/untyped"
+	"github.com/salesforce/sloop/pkg/sloop/store/untyped/badgerwrap"
+	"github.com/stretchr/testify/assert"
+)
+
+const somePodRestartPayload = `{"metadata": {"name": "web-0", "namespace": "ns", "uid": "%v", "resourceVersion": "%v", "creationTimestamp": "%v"},
+  "status": {"containerStatuses": [{"name": "app", "restartCount": %v, "state": {%v}, "lastState": {%v}}]}}`
+
+const someOOMKilled = `"terminated": {"exitCode": 137, "reason": "OOMKilled", "finishedAt": "2019-03-04T03:10:00Z", "containerID": "docker://a"}`
+
+var someRestartStart = time.Date(2019, 3, 4, 3, 0, 0, 0, time.UTC)
+
+func helper_processPods(t *testing.T, tables typed.Tables, start time.Time, payloads []string) {
+	err := tables.Db().Update(func(txn badgerwrap.Txn) error {
+		for idx, payload := range payloads {
+			ts, err := ptypes.TimestampProto(start.Add(time.Duration(idx) * 5 * time.Minute))
+			assert.Nil(t, err)
+			watchRec := &typed.KubeWatchResult{Kind: kubeextractor.PodKind, WatchType: typed.KubeWatchResult_UPDATE, Timestamp: ts, Payload: payload}
+			metadata, err := kubeextractor.ExtractMetadata(payload)
+			assert.Nil(t, err)
+			assert.Nil(t, updateRestartTable(tables, txn, newProcessingCounts(), watchRec, &metadata, false, 24*time.Hour))
+			assert.Nil(t, updateKubeWatchTable(tables, txn, newProcessingCounts(), watchRec, &metadata, false, false, false))
+		}
+		return nil
+	})
+	assert.Nil(t, err)
+}
+
+func helper_getRestarts(t *testing.T, tables typed.Tables, uid string) []*typed.ContainerRestart {
+	return helper_getRestartsAt(t, tables, uid, someRestartStart)
+}
+
+func helper_getRestartsAt(t *testing.T, tables typed.Tables, uid string, ts time.Time) []*typed.ContainerRestart {
+	var restarts []*typed.ContainerRestart
+	err := tables.Db().View(func(txn badgerwrap.Txn) error {
+		key := typed.NewRestartKey(untyped.GetPartitionId(ts), "ns", "web-0", uid, "app")
+		rec, err := tables.RestartTable().GetOrDefault(txn, key.String())
+		restarts = rec.Restarts
+		return err
+	})
+	assert.Nil(t, err)
+	return restarts
+}
+
+func Test_updateRestartTable_OneRecordPerRestart(t *testing.T) {
+	untyped.TestHookSetPartitionDuration(time.Hour)
+	db, err := (&badgerwrap.MockFactory{}).Open(badger.DefaultOptions(""))
+	assert.Nil(t, err)
+	tables := typed.NewTableList(db)
+
+	created := someRestartStart.Format(time.RFC3339)
+	helper_processPods(t, tables, someRestartStart, []string{
+		fmt.Sprintf(somePodRestartPayload, "uid-1", 1, created, 0, `"running": {}`, ""),
+		// Seen terminated, then restarted with the same termination as its last state
+		fmt.Sprintf(somePodRestartPayload, "uid-1", 2, created, 0, someOOMKilled, ""),
+		fmt.Sprintf(somePodRestartPayload, "uid-1", 3, created, 1, `"waiting": {"reason": "CrashLoopBackOff"}`, someOOMKilled),
+		fmt.Sprintf(somePodRestartPayload, "uid-1", 4, created, 1, `"running": {}`, someOOMKilled),
+	})
+
+	finishedAt := time.Date(2019, 3, 4, 3, 10, 0, 0, time.UTC)
+	assert.Equal(t, []*typed.ContainerRestart{{
+		Timestamp:    someRestartStart.Add(5 * time.Minute).Unix(),
+		Restarted:    true,
+		RestartCount: 1,
+		Reason:       "OOMKilled",
+		ExitCode:     137,
+		FinishedAt:   finishedAt.Unix(),
+		ContainerId:  "docker://a",
+	}}, helper_getRestarts(t, tables, "uid-1"))
+}
+
+func Test_updateRestartTable_ComparesWithThisPodOnly(t *testing.T) {
+	untyped.TestHookSetPartitionDuration(time.Hour)
+	db, err := (&badgerwrap.MockFactory{}).Open(badger.DefaultOptions(""))
+	assert.Nil(t, err)
+	tables := typed.NewTableList(db)
+
+	// Created long before the lookback and never seen, so the restart count is from before we watched
+	old := someRestartStart.Add(-48 * time.Hour).Format(time.RFC3339)
+	helper_processPods(t, tables, someRestartStart, []string{
+		fmt.Sprintf(somePodRestartPayload, "uid-1", 1, old, 4, `"running": {}`, someOOMKilled),
+	})
+	assert.Len(t, helper_getRestarts(t, tables, "uid-1"), 0)
+
+	// Re-created with the same name, and restarted before we got its first copy
+	created := someRestartStart.Format(time.RFC3339)
+	helper_processPods(t, tables, someRestartStart.Add(10*time.Minute), []string{
+		fmt.Sprintf(somePodRestartPayload, "uid-2", 2, created, 1, `"running": {}`, someOOMKilled),
+	})
+	restarts := helper_getRestarts(t, tables, "uid-2")
+	if assert.Len(t, restarts, 1) {
+		assert.Equal(t, "OOMKilled", restarts[0].Reason)
+		assert.Equal(t, int32(1), restarts[0].RestartCount)
+	}
+}
+
+func Test_updateRestartTable_OneRecordAcrossPartitions(t *testing.T) {
+	untyped.TestHookSetPartitionDuration(time.Hour)
+	db, err := (&badgerwrap.MockFactory{}).Open(badger.DefaultOptions(""))
+	assert.Nil(t, err)
+	tables := typed.NewTableList(db)
+
+	// Seen terminated just before the hour, and restarted just after it
+	created := someRestartStart.Format(time.RFC3339)
+	helper_processPods(t, tables, someRestartStart.Add(50*time.Minute), []string{
+		fmt.Sprintf(somePodRestartPayload, "uid-1", 1, created, 0, `"running": {}`, ""),
+		fmt.Sprintf(somePodRestartPayload, "uid-1", 2, created, 0, someOOMKilled, ""),
+		fmt.Sprintf(somePodRestartPayload, "uid-1", 3, created, 1, `"running": {}`, someOOMKilled),
+	})
+
+	restarts := helper_getRestarts(t, tables, "uid-1")
+	if assert.Len(t, restarts, 1) {
+		assert.True(t, restarts[0].Restarted)
+		assert.Equal(t, someRestartStart.Add(55*time.Minute).Unix(), restarts[0].Timestamp)
+	}
+	assert.Len(t, helper_getRestartsAt(t, tables, "uid-1", someRestartStart.Add(time.Hour)), 0)
+}
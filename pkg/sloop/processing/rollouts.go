@@ -0,0 +1,257 @@
+/*
+ * Copyright (c) 2021, salesforce.com, inc.
+ * All rights reserved.
+ * SPDX-License-Identifier: BSD-3-Clause
+ * For full license text, see LICENSE.txt file in the repo root or https://opensource.org/licenses/BSD-3-Clause
+ */
+
+package processing
+
+import (
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/pkg/errors"
+	"github.com/salesforce/sloop/pkg/sloop/kubeextractor"
+	"github.com/salesforce/sloop/pkg/sloop/store/typed"
+	"github.com/salesforce/sloop/pkg/sloop/store/untyped"
+	"github.com/salesforce/sloop/pkg/sloop/store/untyped/badgerwrap"
+)
+
+// A rollout starts when the pod template of a workload differs from the copy stored before it, so like restarts this
+// has to run before the watch table gets the new copy.  Every later update of the workload can move the rollout along,
+// until it completes, fails, is superseded by the next one or the workload is deleted.  A workload that was never seen
+// before the window has no copy to compare with, so its first rollout we can see is the next template change
+func updateRolloutTable(tables typed.Tables, txn badgerwrap.Txn, counts *processingCounts, watchRec *typed.KubeWatchResult, metadata *kubeextractor.KubeMetadata, replay bool, maxLookback time.Duration) error {
+	if replay {
+		return nil
+	}
+	workload, err := kubeextractor.ExtractWorkload(watchRec.Kind, watchRec.Payload)
+	if err != nil {
+		glog.Errorf("Could not extract rollout of %v/%v: %v", metadata.Namespace, metadata.Name, err)
+		return nil
+	}
+	if workload == nil {
+		return nil
+	}
+	timestamp, err := ptypes.Timestamp(watchRec.Timestamp)
+	if err != nil {
+		return errors.Wrapf(err, "Could not convert timestamp %v", watchRec.Timestamp)
+	}
+
+	keyComparator := typed.NewRolloutKeyComparator(watchRec.Kind, metadata.Namespace, metadata.Name, metadata.Uid)
+	_, lastRecord, err := tables.RolloutTable().GetLastValue(txn, keyComparator, timestamp.Add(-maxLookback), timestamp)
+	if err != nil {
+		return errors.Wrap(err, "Could not get last rollout record")
+	}
+	var last *typed.Rollout
+	if lastRecord != nil && len(lastRecord.Rollouts) > 0 {
+		last = lastRecord.Rollouts[len(lastRecord.Rollouts)-1]
+	}
+	changed := []*typed.Rollout{}
+
+	if watchRec.WatchType == typed.KubeWatchResult_DELETE {
+		if isRolloutOpen(last) {
+			ended := proto.Clone(last).(*typed.Rollout)
+			endRollout(ended, kubeextractor.RolloutDeleted, timestamp)
+			changed = append(changed, ended)
+		}
+		return setRollouts(tables, txn, counts, watchRec.Kind, metadata, timestamp, changed)
+	}
+
+	prevPayload, err := getPreviousPayload(tables, txn, timestamp, watchRec.Kind, metadata, maxLookback)
+	if err != nil {
+		return err
+	}
+	if prevPayload != "" {
+		prevWorkload, err := kubeextractor.ExtractWorkload(watchRec.Kind, prevPayload)
+		if err != nil {
+			glog.Errorf("Could not extract rollout of previous copy of %v/%v: %v", metadata.Namespace, metadata.Name, err)
+			return nil
+		}
+		if !prevWorkload.SameTemplate(workload) {
+			if isRolloutOpen(last) {
+				ended := proto.Clone(last).(*typed.Rollout)
+				endRollout(ended, kubeextractor.RolloutSuperseded, timestamp)
+				changed = append(changed, ended)
+			}
+			last = newRollout(last, prevWorkload, metadata, timestamp, prevPayload == "{}")
+			changed = append(changed, last)
+		}
+	}
+
+	if isRolloutOpen(last) {
+		rollout := proto.Clone(last).(*typed.Rollout)
+		updateRollout(rollout, workload, timestamp)
+		if watchRec.Kind == kubeextractor.DeploymentKind && (rollout.NewReplicaSet == "" || rollout.OldReplicaSet == "") {
+			err = findRolloutReplicaSets(tables, txn, rollout, metadata, timestamp)
+			if err != nil {
+				return err
+			}
+		}
+		if !proto.Equal(rollout, last) {
+			rollout.LastUpdate = timestamp.Unix()
+			if len(changed) > 0 && changed[len(changed)-1] == last {
+				changed[len(changed)-1] = rollout
+			} else {
+				changed = append(changed, rollout)
+			}
+		}
+	}
+	return setRollouts(tables, txn, counts, watchRec.Kind, metadata, timestamp, changed)
+}
+
+// Failed is not the end of it.  A deployment past its deadline still rolls out, and can complete after all
+func isRolloutOpen(rollout *typed.Rollout) bool {
+	return rollout != nil && (rollout.Outcome == kubeextractor.RolloutProgressing || rollout.Outcome == kubeextractor.RolloutFailed)
+}
+
+func endRollout(rollout *typed.Rollout, outcome string, timestamp time.Time) {
+	rollout.Outcome = outcome
+	rollout.LastUpdate = timestamp.Unix()
+	if rollout.End == 0 {
+		rollout.End = timestamp.Unix()
+	}
+}
+
+// The old side comes from the copy before the template changed.  A workload created within the window starts with a
+// rollout from nothing, at the time it was created
+func newRollout(last *typed.Rollout, prevWorkload *kubeextractor.Workload, metadata *kubeextractor.KubeMetadata, timestamp time.Time, created bool) *typed.Rollout {
+	start := timestamp
+	if created {
+		creationTime, err := time.Parse(time.RFC3339, metadata.CreationTimestamp)
+		if err == nil && creationTime.Before(timestamp) {
+			start = creationTime
+		}
+	}
+	rollout := &typed.Rollout{
+		Start:           start.Unix(),
+		LastUpdate:      timestamp.Unix(),
+		Outcome:         kubeextractor.RolloutProgressing,
+		OldGeneration:   prevWorkload.Generation,
+		OldRevision:     prevWorkload.Revision,
+		OldTemplateHash: prevWorkload.TemplateHash,
+		OldImages:       prevWorkload.Images,
+	}
+	// The replica set the last rollout moved to is the one this one moves away from
+	if last != nil && last.NewRevision != "" && last.NewRevision == rollout.OldRevision {
+		rollout.OldReplicaSet = last.NewReplicaSet
+		if rollout.OldTemplateHash == "" {
+			rollout.OldTemplateHash = last.NewTemplateHash
+		}
+	}
+	return rollout
+}
+
+func updateRollout(rollout *typed.Rollout, workload *kubeextractor.Workload, timestamp time.Time) {
+	if rollout.NewGeneration == 0 {
+		rollout.NewGeneration = workload.Generation
+	}
+	rollout.NewImages = workload.Images
+	// The controller gives the new template a revision once it sees it, so until then the workload still has the old one
+	if workload.Revision != "" && workload.Revision != rollout.OldRevision {
+		rollout.NewRevision = workload.Revision
+		if workload.TemplateHash != "" {
+			rollout.NewTemplateHash = workload.TemplateHash
+		}
+	}
+
+	if workload.Outcome != rollout.Outcome {
+		rollout.Outcome = workload.Outcome
+		rollout.Message = workload.Message
+		rollout.End = 0
+		if workload.Outcome != kubeextractor.RolloutProgressing {
+			rollout.End = timestamp.Unix()
+		}
+	}
+}
+
+// Replica sets of a deployment are named after it and carry the revision they were created or last rolled back for.
+// The latest copy of each within the rollout tells which ones it moved between
+func findRolloutReplicaSets(tables typed.Tables, txn badgerwrap.Txn, rollout *typed.Rollout, metadata *kubeextractor.KubeMetadata, timestamp time.Time) error {
+	if rollout.NewRevision == "" && rollout.OldRevision == "" {
+		return nil
+	}
+	keyPrefix := typed.NewWatchTableKeyComparator(kubeextractor.ReplicaSetKind, metadata.Namespace, metadata.Name+"-", time.Time{})
+	start := time.Unix(rollout.Start, 0).Add(-clockSkew)
+	watchRecords, _, err := tables.WatchTable().RangeRead(txn, keyPrefix, nil, nil, start, timestamp)
+	if err != nil {
+		return errors.Wrap(err, "Could not get replica sets of deployment")
+	}
+
+	latest := map[string]typed.WatchTableKey{}
+	for key := range watchRecords {
+		if prev, ok := latest[key.Name]; !ok || key.Timestamp.After(prev.Timestamp) {
+			latest[key.Name] = key
+		}
+	}
+	for _, key := range latest {
+		replicaSet, err := kubeextractor.ExtractMetadata(watchRecords[key].Payload)
+		if err != nil {
+			return errors.Wrap(err, "Cannot extract replica set metadata")
+		}
+		if !isOwnedBy(&replicaSet, metadata.Uid) {
+			continue
+		}
+		hash := replicaSet.Labels[kubeextractor.PodTemplateHashLabel]
+		if hash == "" {
+			hash = strings.TrimPrefix(replicaSet.Name, metadata.Name+"-")
+		}
+		switch replicaSet.Annotations[kubeextractor.DeploymentRevisionAnnotation] {
+		case "":
+		case rollout.NewRevision:
+			rollout.NewReplicaSet = replicaSet.Name
+			rollout.NewTemplateHash = hash
+		case rollout.OldRevision:
+			if rollout.OldReplicaSet == "" {
+				rollout.OldReplicaSet = replicaSet.Name
+				rollout.OldTemplateHash = hash
+			}
+		}
+	}
+	return nil
+}
+
+func isOwnedBy(metadata *kubeextractor.KubeMetadata, uid string) bool {
+	for _, owner := range metadata.OwnerReferences {
+		if owner.Uid == uid {
+			return true
+		}
+	}
+	return false
+}
+
+// A rollout is copied into the record of the partition it changed in, replacing the copy of the same start there
+func setRollouts(tables typed.Tables, txn badgerwrap.Txn, counts *processingCounts, kind string, metadata *kubeextractor.KubeMetadata, timestamp time.Time, rollouts []*typed.Rollout) error {
+	if len(rollouts) == 0 {
+		return nil
+	}
+	key := typed.NewRolloutKey(untyped.GetPartitionId(timestamp), kind, metadata.Namespace, metadata.Name, metadata.Uid)
+	rolloutRecord, err := tables.RolloutTable().GetOrDefault(txn, key.String())
+	if err != nil {
+		return errors.Wrap(err, "Could not get rollout record")
+	}
+	for _, rollout := range rollouts {
+		found := false
+		for idx, stored := range rolloutRecord.Rollouts {
+			if stored.Start == rollout.Start {
+				rolloutRecord.Rollouts[idx] = rollout
+				found = true
+				break
+			}
+		}
+		if !found {
+			rolloutRecord.Rollouts = append(rolloutRecord.Rollouts, rollout)
+		}
+	}
+
+	err = tables.RolloutTable().Set(txn, key.String(), rolloutRecord)
+	if err != nil {
+		return errors.Wrap(err, "Failed to put rollout record")
+	}
+	counts.success++
+	return nil
+}
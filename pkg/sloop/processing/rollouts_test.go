@@ -0,0 +1,157 @@
+/*
+ * Copyright (c) 2021, salesforce.com, inc.
+ * All rights reserved.
+ * SPDX-License-Identifier: BSD-3-Clause
+ * For full license text, see LICENSE.txt file in the repo root or https://opensource.org/licenses/BSD-3-Clause
+ */
+
+package processing
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/dgraph-io/badger/v2"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/salesforce/sloop/pkg/sloop/kubeextractor"
+	"github.com/salesforce/sloop/pkg/sloop/store/typed"
+	"github.com/salesforce/sloop/pkg/sloop/store/untyped"
+	"github.com/salesforce/sloop/pkg/sloop/store/untyped/badgerwrap"
+	"github.com/stretchr/testify/assert"
+)
+
+const someRolloutDeploymentPayload = `{"metadata": {"name": "web", "namespace": "ns", "uid": "uid-1", "resourceVersion": "%v", "creationTimestamp": "%v",
+  "generation": %v, "annotations": {"deployment.kubernetes.io/revision": "%v"}},
+  "spec": {"replicas": 2, "template": {"spec": {"containers": [{"name": "app", "image": "%v"}]}}},
+  "status": {"observedGeneration": %v, "replicas": 2, "updatedReplicas": %v, "availableReplicas": 2, "conditions": [%v]}}`
+
+const someRolloutReplicaSetPayload = `{"metadata": {"name": "web-%v", "namespace": "ns", "uid": "rs-%v", "resourceVersion": "%v",
+  "labels": {"pod-template-hash": "%v"}, "annotations": {"deployment.kubernetes.io/revision": "%v"},
+  "ownerReferences": [{"kind": "Deployment", "name": "web", "uid": "uid-1"}]}}`
+
+const someProgressDeadlineExceeded = `{"type": "Progressing", "status": "False", "reason": "ProgressDeadlineExceeded", "message": "timed out"}`
+
+var someRolloutStart = time.Date(2019, 3, 4, 3, 0, 0, 0, time.UTC)
+
+type someRolloutRecord struct {
+	kind      string
+	watchType typed.KubeWatchResult_WatchType
+	payload   string
+}
+
+func helper_processWorkloads(t *testing.T, tables typed.Tables, start time.Time, records []someRolloutRecord) {
+	err := tables.Db().Update(func(txn badgerwrap.Txn) error {
+		for idx, record := range records {
+			ts, err := ptypes.TimestampProto(start.Add(time.Duration(idx) * 5 * time.Minute))
+			assert.Nil(t, err)
+			watchRec := &typed.KubeWatchResult{Kind: record.kind, WatchType: record.watchType, Timestamp: ts, Payload: record.payload}
+			metadata, err := kubeextractor.ExtractMetadata(record.payload)
+			assert.Nil(t, err)
+			assert.Nil(t, updateRolloutTable(tables, txn, newProcessingCounts(), watchRec, &metadata, false, 24*time.Hour))
+			assert.Nil(t, updateKubeWatchTable(tables, txn, newProcessingCounts(), watchRec, &metadata, false, false, false))
+		}
+		return nil
+	})
+	assert.Nil(t, err)
+}
+
+func helper_getRollouts(t *testing.T, tables typed.Tables) []*typed.Rollout {
+	var rollouts []*typed.Rollout
+	err := tables.Db().View(func(txn badgerwrap.Txn) error {
+		key := typed.NewRolloutKey(untyped.GetPartitionId(someRolloutStart), kubeextractor.DeploymentKind, "ns", "web", "uid-1")
+		rec, err := tables.RolloutTable().GetOrDefault(txn, key.String())
+		rollouts = rec.Rollouts
+		return err
+	})
+	assert.Nil(t, err)
+	return rollouts
+}
+
+func Test_updateRolloutTable_Deployment(t *testing.T) {
+	untyped.TestHookSetPartitionDuration(time.Hour)
+	db, err := (&badgerwrap.MockFactory{}).Open(badger.DefaultOptions(""))
+	assert.Nil(t, err)
+	tables := typed.NewTableList(db)
+
+	created := someRolloutStart.Format(time.RFC3339)
+	deploymentUpdate := func(version int, generation int, revision int, image string, observedGeneration int, updated int, condition string) someRolloutRecord {
+		return someRolloutRecord{kind: kubeextractor.DeploymentKind, watchType: typed.KubeWatchResult_UPDATE,
+			payload: fmt.Sprintf(someRolloutDeploymentPayload, version, created, generation, revision, image, observedGeneration, updated, condition)}
+	}
+	replicaSetUpdate := func(version int, hash string, revision int) someRolloutRecord {
+		return someRolloutRecord{kind: kubeextractor.ReplicaSetKind, watchType: typed.KubeWatchResult_UPDATE,
+			payload: fmt.Sprintf(someRolloutReplicaSetPayload, hash, hash, version, hash, revision)}
+	}
+	helper_processWorkloads(t, tables, someRolloutStart, []someRolloutRecord{
+		deploymentUpdate(1, 1, 0, "web:1", 0, 0, ""),
+		replicaSetUpdate(2, "aaa", 1),
+		deploymentUpdate(3, 1, 1, "web:1", 1, 2, ""),
+		// A resync is no rollout
+		deploymentUpdate(3, 1, 1, "web:1", 1, 2, ""),
+		// The new template comes before the controller gives it a revision and a replica set
+		deploymentUpdate(4, 2, 1, "web:2", 1, 2, ""),
+		replicaSetUpdate(5, "bbb", 2),
+		deploymentUpdate(6, 2, 2, "web:2", 2, 1, ""),
+		deploymentUpdate(7, 2, 2, "web:2", 2, 1, someProgressDeadlineExceeded),
+	})
+
+	minute := int64(60)
+	start := someRolloutStart.Unix()
+	assert.Equal(t, []*typed.Rollout{
+		{
+			Start:           start,
+			End:             start + 10*minute,
+			LastUpdate:      start + 10*minute,
+			Outcome:         kubeextractor.RolloutComplete,
+			NewGeneration:   1,
+			NewRevision:     "1",
+			NewTemplateHash: "aaa",
+			NewImages:       []string{"app=web:1"},
+			NewReplicaSet:   "web-aaa",
+		},
+		{
+			Start:           start + 20*minute,
+			End:             start + 35*minute,
+			LastUpdate:      start + 35*minute,
+			Outcome:         kubeextractor.RolloutFailed,
+			Message:         "timed out",
+			OldGeneration:   1,
+			NewGeneration:   2,
+			OldRevision:     "1",
+			NewRevision:     "2",
+			OldTemplateHash: "aaa",
+			NewTemplateHash: "bbb",
+			OldImages:       []string{"app=web:1"},
+			NewImages:       []string{"app=web:2"},
+			OldReplicaSet:   "web-aaa",
+			NewReplicaSet:   "web-bbb",
+		},
+	}, helper_getRollouts(t, tables))
+
+	// Deleting the deployment ends the rollout that was still going
+	deleted := deploymentUpdate(8, 2, 2, "web:2", 2, 1, someProgressDeadlineExceeded)
+	deleted.watchType = typed.KubeWatchResult_DELETE
+	helper_processWorkloads(t, tables, someRolloutStart.Add(40*time.Minute), []someRolloutRecord{deleted})
+	rollouts := helper_getRollouts(t, tables)
+	if assert.Len(t, rollouts, 2) {
+		assert.Equal(t, kubeextractor.RolloutDeleted, rollouts[1].Outcome)
+		assert.Equal(t, start+35*minute, rollouts[1].End)
+		assert.Equal(t, start+40*minute, rollouts[1].LastUpdate)
+	}
+}
+
+func Test_updateRolloutTable_NothingBeforeFirstCopy(t *testing.T) {
+	untyped.TestHookSetPartitionDuration(time.Hour)
+	db, err := (&badgerwrap.MockFactory{}).Open(badger.DefaultOptions(""))
+	assert.Nil(t, err)
+	tables := typed.NewTableList(db)
+
+	// Created long before the lookback, so whatever it is rolling out started before we watched
+	old := someRolloutStart.Add(-48 * time.Hour).Format(time.RFC3339)
+	payload := fmt.Sprintf(someRolloutDeploymentPayload, 1, old, 5, 5, "web:5", 4, 1, "")
+	helper_processWorkloads(t, tables, someRolloutStart, []someRolloutRecord{
+		{kind: kubeextractor.DeploymentKind, watchType: typed.KubeWatchResult_UPDATE, payload: payload},
+	})
+	assert.Len(t, helper_getRollouts(t, tables), 0)
+}
@@ -8,12 +8,12 @@
 package processing
 
 import (
-	"github.com/dgraph-io/badger/v2"
 	"github.com/golang/glog"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/pkg/errors"
 	"github.com/salesforce/sloop/pkg/sloop/common"
+	"github.com/salesforce/sloop/pkg/sloop/ingress"
 	"github.com/salesforce/sloop/pkg/sloop/kubeextractor"
 	"github.com/salesforce/sloop/pkg/sloop/queries"
 	"github.com/salesforce/sloop/pkg/sloop/store/typed"
@@ -22,91 +22,98 @@ import (
 	"time"
 )
 
+// Returns the last stored result for the object in the partition of ts, or nil when there is none
 func getLastKubeWatchResult(tables typed.Tables, txn badgerwrap.Txn, ts *timestamp.Timestamp, kind string, namespace string, name string) (*typed.KubeWatchResult, error) {
-	keyPrefixWithoutTs, err := toWatchTableKeyPrefix(ts, kind, namespace, name)
+	timestamp, err := ptypes.Timestamp(ts)
 	if err != nil {
-		return nil, err
+		return nil, errors.Wrapf(err, "Could not convert timestamp %v", ts.String())
 	}
+	return getLastKubeWatchResultSince(tables, txn, timestamp, kind, namespace, name, timestamp)
+}
 
-	prevFound, prevKey, err := getLastWatchKey(txn, keyPrefixWithoutTs)
+// Like getLastKubeWatchResult, but also looks in earlier partitions as far back as the one of since
+func getLastKubeWatchResultSince(tables typed.Tables, txn badgerwrap.Txn, timestamp time.Time, kind string, namespace string, name string, since time.Time) (*typed.KubeWatchResult, error) {
+	keyComparator := typed.NewWatchTableKeyComparator(kind, namespace, name, time.Time{})
+	_, prevWatch, err := tables.WatchTable().GetLastValue(txn, keyComparator, since, timestamp)
 	if err != nil {
-		return nil, errors.Wrapf(err, "Failure getting previous watch result for %v", keyPrefixWithoutTs.String())
-	}
-	if !prevFound {
-		return nil, nil
+		return nil, errors.Wrapf(err, "Failure getting previous watch result for %v", keyComparator.String())
 	}
+	return prevWatch, nil
+}
 
-	prevWatch, err := tables.WatchTable().Get(txn, prevKey)
+// Whether the only changes since the last stored result are in paths the rules ignore, like heartbeats.  Deletes
+// always count
+func isMinorUpdate(tables typed.Tables, txn badgerwrap.Txn, watchRec *typed.KubeWatchResult, metadata *kubeextractor.KubeMetadata, minorUpdates *ingress.MinorUpdates) (bool, error) {
+	if watchRec.WatchType == typed.KubeWatchResult_DELETE || !minorUpdates.MatchesKind(watchRec.Kind) {
+		return false, nil
+	}
+	prevWatch, err := getLastKubeWatchResult(tables, txn, watchRec.Timestamp, watchRec.Kind, metadata.Namespace, metadata.Name)
 	if err != nil {
-		return nil, err
+		return false, errors.Wrap(err, "Could not get previous watch result")
+	}
+	if prevWatch == nil || prevWatch.WatchType == typed.KubeWatchResult_DELETE {
+		return false, nil
 	}
-	return prevWatch, nil
-}
 
-// TODO: This code was labeled 'Previous' but really only returns 'Last', it may be helpful to actually have a 'Previous' implementation
-// TODO: Move this to code-gen per table
-func getLastWatchKey(txn badgerwrap.Txn, keyPrefix *typed.WatchTableKey) (bool, string, error) {
-	// Retrieve the previous copy of this node and see if differences are important
-	// Badger reverse seek is pretty goofy.  We need a prefix with 255 at the end for the seek, but not for prefix
-	keyPrefixStr := keyPrefix.String()
-	keyPrefixEndBytes := []byte(keyPrefixStr + string(rune(255)))
-	keyPrefixBytes := []byte(keyPrefix.String())
-
-	iterOpt := badger.DefaultIteratorOptions
-	iterOpt.Prefix = []byte(keyPrefixBytes)
-	iterOpt.Reverse = true
-	itr := txn.NewIterator(iterOpt)
-	defer itr.Close()
-	itr.Seek([]byte(keyPrefixEndBytes))
-	if itr.ValidForPrefix(keyPrefixBytes) {
-		item := itr.Item()
-		return true, string(item.Key()), nil
-	}
-	return false, "", nil
+	minor, err := minorUpdates.IsMinorUpdate(watchRec.Kind, prevWatch.Payload, watchRec.Payload)
+	if err != nil {
+		keyPrefix, _ := toWatchTableKeyPrefix(watchRec.Timestamp, watchRec.Kind, metadata.Namespace, metadata.Name)
+		return false, errors.Wrapf(err, "Failed to check if there are meaningful differences for %v", keyPrefix.String())
+	}
+	return minor, nil
 }
 
-func doesNodeHaveMajorUpdates(tables typed.Tables, txn badgerwrap.Txn, watchRec *typed.KubeWatchResult, metadata *kubeextractor.KubeMetadata) (bool, error) {
-	prevValue, err := getLastKubeWatchResult(tables, txn, watchRec.Timestamp, watchRec.Kind, metadata.Namespace, metadata.Name)
+// A restart lists every object again, and a resync sends every object as an update.  When we already stored the
+// resource version they carry, they tell us nothing new.  Deletes always count, they carry the last version we saw
+func isReplayOfStoredVersion(tables typed.Tables, txn badgerwrap.Txn, watchRec *typed.KubeWatchResult, metadata *kubeextractor.KubeMetadata) (bool, error) {
+	if watchRec.WatchType == typed.KubeWatchResult_DELETE || metadata.ResourceVersion == "" {
+		return false, nil
+	}
+	prevWatch, err := getLastKubeWatchResult(tables, txn, watchRec.Timestamp, watchRec.Kind, metadata.Namespace, metadata.Name)
 	if err != nil {
-		return false, err
+		return false, errors.Wrap(err, "Could not get previous watch result")
 	}
-	if prevValue == nil {
-		return true, nil
+	if prevWatch == nil || prevWatch.WatchType == typed.KubeWatchResult_DELETE {
+		return false, nil
 	}
-
-	diff, err := kubeextractor.NodeHasMajorUpdate(prevValue.Payload, watchRec.Payload)
+	prevMetadata, err := kubeextractor.ExtractMetadata(prevWatch.Payload)
 	if err != nil {
-		keyPrefix, _ := toWatchTableKeyPrefix(watchRec.Timestamp, watchRec.Kind, metadata.Namespace, metadata.Name)
-		return false, errors.Wrapf(err, "Failed to check if nodes have meaningfully differences for %v", keyPrefix.String())
+		return false, errors.Wrap(err, "Cannot extract resource metadata")
 	}
-	return diff, nil
+	return prevMetadata.Uid == metadata.Uid && prevMetadata.ResourceVersion == metadata.ResourceVersion, nil
 }
 
-func updateKubeWatchTable(tables typed.Tables, txn badgerwrap.Txn, watchRec *typed.KubeWatchResult, metadata *kubeextractor.KubeMetadata, keepMinorNodeUpdates bool) error {
-	metricProcessingWatchtableUpdatecount.Inc()
+func updateKubeWatchTable(tables typed.Tables, txn badgerwrap.Txn, counts *processingCounts, watchRec *typed.KubeWatchResult, metadata *kubeextractor.KubeMetadata, replay bool, minorUpdate bool, payloadPatches bool) error {
+	counts.watchTableUpdates++
 
 	key, err := toWatchTableKey(watchRec.Timestamp, watchRec.Kind, metadata.Namespace, metadata.Name)
 	if err != nil {
 		return err
 	}
 
-	if watchRec.Kind == kubeextractor.NodeKind && !keepMinorNodeUpdates {
-		hasUpdates, err := doesNodeHaveMajorUpdates(tables, txn, watchRec, metadata)
-		if err != nil {
-			return err
-		}
-		if !hasUpdates {
-			glog.V(2).Infof("Not inserting node %v because it has no major updates", key.String())
-			return nil
-		}
+	// The watch activity table still records these as a watch with no change
+	if replay {
+		counts.replays++
+		glog.V(2).Infof("Not inserting %v because resource version %v is already stored", key.String(), metadata.ResourceVersion)
+		return nil
 	}
 
-	err = tables.WatchTable().Set(txn, key.String(), watchRec)
+	if minorUpdate {
+		counts.minorUpdates[watchRec.Kind]++
+		glog.V(2).Infof("Not inserting %v because it has no major updates", key.String())
+		return nil
+	}
+
+	if payloadPatches {
+		err = tables.WatchTable().SetAsPatch(txn, key.String(), watchRec)
+	} else {
+		err = tables.WatchTable().Set(txn, key.String(), watchRec)
+	}
 	if err != nil {
 		return errors.Wrap(err, "Put failed")
 	}
 
-	metricIngestionSuccessCount.Inc()
+	counts.success++
 	return nil
 }
 
@@ -10,6 +10,7 @@ package processing
 import (
 	"github.com/dgraph-io/badger/v2"
 	"github.com/golang/protobuf/ptypes"
+	"github.com/salesforce/sloop/pkg/sloop/ingress"
 	"github.com/salesforce/sloop/pkg/sloop/kubeextractor"
 	"github.com/salesforce/sloop/pkg/sloop/store/typed"
 	"github.com/salesforce/sloop/pkg/sloop/store/untyped"
@@ -70,7 +71,7 @@ const someNodeDiffTsAndRV = `{
 const someNodeDiffStatus = `{
   "metadata": {
     "name": "somehostname",
-    "resourceVersion": "123"
+    "resourceVersion": "789"
   },
   "status": {
     "conditions": [
@@ -95,13 +96,22 @@ func helper_runWatchTableProcessingOnInputs(t *testing.T, inRecs []*typed.KubeWa
 	db, err := (&badgerwrap.MockFactory{}).Open(badger.DefaultOptions(""))
 	assert.Nil(t, err)
 	tables := typed.NewTableList(db)
+	minorUpdates, err := ingress.NewMinorUpdates(ingress.DefaultMinorUpdateRules)
+	assert.Nil(t, err)
+	if keepMinorNodeUpdates {
+		minorUpdates = nil
+	}
 
 	for _, watchRec := range inRecs {
 		err = tables.Db().Update(func(txn badgerwrap.Txn) error {
 			kubeMetadata, err := kubeextractor.ExtractMetadata(watchRec.Payload)
 			assert.Nil(t, err)
 
-			return updateKubeWatchTable(tables, txn, watchRec, &kubeMetadata, keepMinorNodeUpdates)
+			replay, err := isReplayOfStoredVersion(tables, txn, watchRec, &kubeMetadata)
+			assert.Nil(t, err)
+			minorUpdate, err := isMinorUpdate(tables, txn, watchRec, &kubeMetadata, minorUpdates)
+			assert.Nil(t, err)
+			return updateKubeWatchTable(tables, txn, newProcessingCounts(), watchRec, &kubeMetadata, replay, minorUpdate, false)
 		})
 		assert.Nil(t, err)
 	}
@@ -203,6 +213,34 @@ func Test_WatchTable_KeepMinorNodeUpdates_AddNodeTwiceOnlyDiffIsTS_TwoOutputRow(
 	assert.Equal(t, 2, len(results))
 }
 
+func Test_WatchTable_ReplayOfStoredVersion_NotStoredAgain(t *testing.T) {
+	pod := func(resourceVersion string) string {
+		return `{"metadata":{"name":"someName","namespace":"someNamespace","uid":"someUid","resourceVersion":"` + resourceVersion + `"}}`
+	}
+	var recs []*typed.KubeWatchResult
+	for idx, step := range []struct {
+		watchType       typed.KubeWatchResult_WatchType
+		resourceVersion string
+	}{
+		{typed.KubeWatchResult_ADD, "5"},
+		{typed.KubeWatchResult_ADD, "5"},
+		{typed.KubeWatchResult_UPDATE, "5"},
+		{typed.KubeWatchResult_UPDATE, "6"},
+		{typed.KubeWatchResult_DELETE, "6"},
+	} {
+		ts, err := ptypes.TimestampProto(someWatchTime.Add(time.Duration(idx) * time.Second))
+		assert.Nil(t, err)
+		recs = append(recs, &typed.KubeWatchResult{Kind: someKind, WatchType: step.watchType, Timestamp: ts, Payload: pod(step.resourceVersion)})
+	}
+
+	results := helper_runWatchTableProcessingOnInputs(t, recs, true)
+
+	assert.Equal(t, 3, len(results))
+	assert.Equal(t, typed.KubeWatchResult_ADD, results[0].Value.WatchType)
+	assert.Equal(t, typed.KubeWatchResult_UPDATE, results[1].Value.WatchType)
+	assert.Equal(t, typed.KubeWatchResult_DELETE, results[2].Value.WatchType)
+}
+
 func Test_getLastKubeWatchResult(t *testing.T) {
 	untyped.TestHookSetPartitionDuration(time.Hour)
 	db, err := (&badgerwrap.MockFactory{}).Open(badger.DefaultOptions(""))
@@ -215,7 +253,7 @@ func Test_getLastKubeWatchResult(t *testing.T) {
 	watchRec := typed.KubeWatchResult{Kind: kubeextractor.NodeKind, WatchType: typed.KubeWatchResult_UPDATE, Timestamp: ts, Payload: somePodPayload}
 	metadata := &kubeextractor.KubeMetadata{Name: "someName", Namespace: "someNamespace"}
 	err = tables.Db().Update(func(txn badgerwrap.Txn) error {
-		return updateKubeWatchTable(tables, txn, &watchRec, metadata, true)
+		return updateKubeWatchTable(tables, txn, newProcessingCounts(), &watchRec, metadata, false, false, false)
 	})
 	assert.Nil(t, err)
 
@@ -245,7 +283,7 @@ func Test_GetUidForWatchEntry(t *testing.T) {
 	watchRec := typed.KubeWatchResult{Kind: kubeextractor.PodKind, WatchType: typed.KubeWatchResult_UPDATE, Timestamp: ts, Payload: somePodPayload}
 	metadata := &kubeextractor.KubeMetadata{Name: "someName", Namespace: "someNamespace"}
 	err = tables.Db().Update(func(txn badgerwrap.Txn) error {
-		return updateKubeWatchTable(tables, txn, &watchRec, metadata, true)
+		return updateKubeWatchTable(tables, txn, newProcessingCounts(), &watchRec, metadata, false, false, false)
 	})
 	assert.Nil(t, err)
 
@@ -17,7 +17,8 @@ import (
 	"time"
 )
 
-func updateWatchActivityTable(tables typed.Tables, txn badgerwrap.Txn, watchRec *typed.KubeWatchResult, metadata *kubeextractor.KubeMetadata) error {
+// A minor update, like a heartbeat, is recorded as no change even though the resource version moved
+func updateWatchActivityTable(tables typed.Tables, txn badgerwrap.Txn, counts *processingCounts, watchRec *typed.KubeWatchResult, metadata *kubeextractor.KubeMetadata, minorUpdate bool) error {
 
 	if watchRec.Kind == kubeextractor.EventKind {
 		return nil
@@ -38,13 +39,13 @@ func updateWatchActivityTable(tables typed.Tables, txn badgerwrap.Txn, watchRec
 		return err
 	}
 
-	if resourceChanged {
+	if resourceChanged && !minorUpdate {
 		activityRecord.ChangedAt = append(activityRecord.ChangedAt, timestamp.Unix())
 	} else {
 		activityRecord.NoChangeAt = append(activityRecord.NoChangeAt, timestamp.Unix())
 	}
 
-	metricIngestionSuccessCount.Inc()
+	counts.success++
 	return putWatchActivity(tables, txn, activityRecord, key)
 }
 
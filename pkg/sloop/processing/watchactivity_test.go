@@ -72,7 +72,7 @@ func Test_updateWatchActivityTable(t *testing.T) {
 
 	// add a WatchActivity (no matching KubeWatchResult) => no change
 	err = tables.Db().Update(func(txn badgerwrap.Txn) error {
-		err = updateWatchActivityTable(tables, txn, watchRec, &metadata)
+		err = updateWatchActivityTable(tables, txn, newProcessingCounts(), watchRec, &metadata, false)
 		assert.Nil(t, err)
 
 		activityRecord, _, err := getWatchActivity(tables, txn, someWatchTime, watchRec, &metadata)
@@ -88,7 +88,7 @@ func Test_updateWatchActivityTable(t *testing.T) {
 
 	// add a KubeWatchResult
 	err = tables.Db().Update(func(txn badgerwrap.Txn) error {
-		return updateKubeWatchTable(tables, txn, watchRec, &metadata, true)
+		return updateKubeWatchTable(tables, txn, newProcessingCounts(), watchRec, &metadata, false, false, false)
 	})
 	assert.Nil(t, err)
 
@@ -98,7 +98,7 @@ func Test_updateWatchActivityTable(t *testing.T) {
 	assert.Nil(t, err)
 	watchRec.Timestamp = ts2
 	err = tables.Db().Update(func(txn badgerwrap.Txn) error {
-		err = updateWatchActivityTable(tables, txn, watchRec, &metadata)
+		err = updateWatchActivityTable(tables, txn, newProcessingCounts(), watchRec, &metadata, false)
 		assert.Nil(t, err)
 
 		activityRecord, _, err := getWatchActivity(tables, txn, timestamp2, watchRec, &metadata)
@@ -117,7 +117,7 @@ func Test_updateWatchActivityTable(t *testing.T) {
 	metadata, err = kubeextractor.ExtractMetadata(watchRec.Payload)
 	assert.Nil(t, err)
 	err = tables.Db().Update(func(txn badgerwrap.Txn) error {
-		err = updateWatchActivityTable(tables, txn, watchRec, &metadata)
+		err = updateWatchActivityTable(tables, txn, newProcessingCounts(), watchRec, &metadata, false)
 		assert.Nil(t, err)
 
 		activityRecord, _, err := getWatchActivity(tables, txn, timestamp2, watchRec, &metadata)
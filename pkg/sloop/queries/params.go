@@ -21,6 +21,7 @@ const (
 	ClickTimeParam = "click_time"
 	QueryParam     = "query"
 	SortParam      = "sort"
+	DepthParam     = "depth"
 )
 
 const (
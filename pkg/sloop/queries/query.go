@@ -26,6 +26,9 @@ var funcMap = map[string]ganttJsonQuery{
 	"Kinds":             KindQuery,
 	"Queries":           QueryAvailableQueries,
 	"GetResSummaryData": GetResSummaryData,
+	"ResourceGraph":     ResourceGraph,
+	"ContainerRestarts": ContainerRestarts,
+	"Rollouts":          Rollouts,
 }
 
 func Default() string {
@@ -27,6 +27,8 @@ type rawData struct {
 	Events        map[typed.EventCountKey]*typed.ResourceEventCounts
 	Resources     map[typed.ResourceSummaryKey]*typed.ResourceSummary
 	WatchActivity map[typed.WatchActivityKey]*typed.WatchActivity
+	Health        map[typed.HealthKey]*typed.ResourceHealth
+	Restarts      map[typed.RestartKey]*typed.ContainerRestarts
 }
 
 func EventHeatMap3Query(params url.Values, t typed.Tables, queryStartTime time.Time, queryEndTime time.Time, requestId string) ([]byte, error) {
@@ -36,7 +38,7 @@ func EventHeatMap3Query(params url.Values, t typed.Tables, queryStartTime time.T
 		return nil, err
 	}
 
-	glog.Infof("reqId: %v EventHeatMap3Query read %v events, %v resources, and %v watch activity", requestId, len(rawRows.Events), len(rawRows.Resources), len(rawRows.WatchActivity))
+	glog.Infof("reqId: %v EventHeatMap3Query read %v events, %v resources, %v watch activity and %v health", requestId, len(rawRows.Events), len(rawRows.Resources), len(rawRows.WatchActivity), len(rawRows.Health))
 
 	// Remove rows that don't fit in time range, and clip rows that go outside time range
 	err = timeFilterResSumMap(rawRows.Resources, queryStartTime, queryEndTime)
@@ -83,6 +85,20 @@ func EventHeatMap3Query(params url.Values, t typed.Tables, queryStartTime time.T
 		return []byte{}, err
 	}
 
+	// color the row by the health states of the resource
+	mapResSumKeyToHealth, err := healthToMap(rawRows.Health)
+	if err != nil {
+		return []byte{}, err
+	}
+	mergeHeatmapWithHealth(mapResSumKeyToD3Gantt, mapResSumKeyToHealth)
+
+	// mark where containers of pods restarted
+	mapResSumKeyToRestarts, err := restartsToMap(rawRows.Restarts)
+	if err != nil {
+		return []byte{}, err
+	}
+	mergeHeatmapWithRestarts(mapResSumKeyToD3Gantt, mapResSumKeyToRestarts)
+
 	// Because overlays are grouped by minute, that minute might start before the resource was created or end after it finished
 	// This moves the overlay start/end values so they are contained properly in the resource timeline
 	outputRows := convertHeatmapToSlice(mapResSumKeyToD3Gantt)
@@ -111,6 +127,8 @@ func getRawDataFromStore(params url.Values, t typed.Tables, startTime time.Time,
 	ret.Events = map[typed.EventCountKey]*typed.ResourceEventCounts{}
 	ret.Resources = map[typed.ResourceSummaryKey]*typed.ResourceSummary{}
 	ret.WatchActivity = map[typed.WatchActivityKey]*typed.WatchActivity{}
+	ret.Health = map[typed.HealthKey]*typed.ResourceHealth{}
+	ret.Restarts = map[typed.RestartKey]*typed.ContainerRestarts{}
 
 	err := t.Db().View(func(txn badgerwrap.Txn) error {
 		var err2 error
@@ -133,6 +151,18 @@ func getRawDataFromStore(params url.Values, t typed.Tables, startTime time.Time,
 		}
 		stats.Log(requestId)
 
+		ret.Health, stats, err2 = t.HealthTable().RangeRead(txn, nil, paramFilterHealthFn(params), nil, startTime, endTime)
+		if err2 != nil {
+			return err2
+		}
+		stats.Log(requestId)
+
+		ret.Restarts, stats, err2 = t.RestartTable().RangeRead(txn, nil, paramFilterRestartFn(params), nil, startTime, endTime)
+		if err2 != nil {
+			return err2
+		}
+		stats.Log(requestId)
+
 		return nil
 	})
 	if err != nil {
@@ -360,6 +390,99 @@ func mergeHeatmapWithWatchActivity(resKeyToD3Map map[typed.ResourceSummaryKey]*T
 	return nil
 }
 
+// Changes from all partitions of a resource, oldest first
+func healthToMap(health map[typed.HealthKey]*typed.ResourceHealth) (map[typed.ResourceSummaryKey][]*typed.HealthChange, error) {
+	retMap := map[typed.ResourceSummaryKey][]*typed.HealthChange{}
+
+	for key, value := range health {
+		partitionStartTimestamp, _, err := untyped.GetTimeRangeForPartition(key.PartitionId)
+		if err != nil {
+			return nil, err
+		}
+
+		resSumRefKey := *typed.NewResourceSummaryKey(partitionStartTimestamp, key.Kind, key.Namespace, key.Name, key.Uid)
+		resSumRefKey.PartitionId = EmptyPartition // In order for keys to join properly we need an empty partition ID
+		retMap[resSumRefKey] = append(retMap[resSumRefKey], value.Changes...)
+	}
+	for _, changes := range retMap {
+		sort.SliceStable(changes, func(i, j int) bool {
+			return changes[i].Timestamp < changes[j].Timestamp
+		})
+	}
+	return retMap, nil
+}
+
+// Each state lasts until the next change, or the end of the row.  Before the first change we know of, the row has no
+// segment
+func healthChangesToSegments(d3row *TimelineRow, changes []*typed.HealthChange) []Segment {
+	segments := []Segment{}
+	for idx, change := range changes {
+		// Every partition starts with the state the resource is in, which is not a new state
+		if len(segments) > 0 && segments[len(segments)-1].State == change.State && segments[len(segments)-1].Severity == change.Severity {
+			continue
+		}
+		endDate := d3row.EndDate
+		for _, next := range changes[idx+1:] {
+			if next.State != change.State || next.Severity != change.Severity {
+				endDate = next.Timestamp
+				break
+			}
+		}
+		startDate := change.Timestamp
+		if startDate < d3row.StartDate {
+			startDate = d3row.StartDate
+		}
+		if endDate > d3row.EndDate {
+			endDate = d3row.EndDate
+		}
+		if startDate >= endDate {
+			continue
+		}
+		segments = append(segments, Segment{State: change.State, Severity: change.Severity, Message: change.Message, StartDate: startDate, Duration: endDate - startDate, EndDate: endDate})
+	}
+	return segments
+}
+
+func mergeHeatmapWithHealth(resKeyToD3Map map[typed.ResourceSummaryKey]*TimelineRow, health map[typed.ResourceSummaryKey][]*typed.HealthChange) {
+	for resKey, d3row := range resKeyToD3Map {
+		d3row.Segments = healthChangesToSegments(d3row, health[resKey])
+	}
+}
+
+// Restarts from all partitions of a pod, oldest first
+func restartsToMap(restarts map[typed.RestartKey]*typed.ContainerRestarts) (map[typed.ResourceSummaryKey][]Restart, error) {
+	retMap := map[typed.ResourceSummaryKey][]Restart{}
+
+	for key, value := range restarts {
+		partitionStartTimestamp, _, err := untyped.GetTimeRangeForPartition(key.PartitionId)
+		if err != nil {
+			return nil, err
+		}
+
+		resSumRefKey := *typed.NewResourceSummaryKey(partitionStartTimestamp, kubeextractor.PodKind, key.Namespace, key.Name, key.Uid)
+		resSumRefKey.PartitionId = EmptyPartition // In order for keys to join properly we need an empty partition ID
+		for _, restart := range value.Restarts {
+			retMap[resSumRefKey] = append(retMap[resSumRefKey], toRestart(&key, restart))
+		}
+	}
+	for _, rows := range retMap {
+		sortRestarts(rows)
+	}
+	return retMap, nil
+}
+
+// Only restarts within the row are marked, the row is clipped to the query time range already
+func mergeHeatmapWithRestarts(resKeyToD3Map map[typed.ResourceSummaryKey]*TimelineRow, restarts map[typed.ResourceSummaryKey][]Restart) {
+	for resKey, d3row := range resKeyToD3Map {
+		d3row.Restarts = []Restart{}
+		for _, restart := range restarts[resKey] {
+			if restart.Timestamp >= d3row.StartDate && restart.Timestamp <= d3row.EndDate {
+				d3row.Restarts = append(d3row.Restarts, restart)
+			}
+		}
+	}
+}
+
 func convertHeatmapToSlice(resKeyToD3Map map[typed.ResourceSummaryKey]*TimelineRow) []TimelineRow {
 	var ret []TimelineRow
 
@@ -102,6 +102,20 @@ func helper_AddWatchActivity(t *testing.T, tables typed.Tables) {
 	assert.Nil(t, err)
 }
 
+// Pending until the first events, then Running, then CrashLoopBackOff
+func helper_AddHealth(t *testing.T, tables typed.Tables) {
+	someHealthKey := typed.NewHealthKey(untyped.GetPartitionId(someResSumTs), kindPod, someNamespace, someName, someUid)
+	someHealth := &typed.ResourceHealth{Changes: []*typed.HealthChange{
+		{Timestamp: someHeatMapQueryStart.Unix(), State: "Pending", Severity: "progressing"},
+		{Timestamp: events1Ts.Unix(), State: "Running", Severity: "healthy"},
+		{Timestamp: events2Ts.Unix(), State: "CrashLoopBackOff", Severity: "error", Message: "container app: back-off 10s"},
+	}}
+	err := tables.Db().Update(func(txn badgerwrap.Txn) error {
+		return tables.HealthTable().Set(txn, someHealthKey.String(), someHealth)
+	})
+	assert.Nil(t, err)
+}
+
 func helper_UrlValues() url.Values {
 	return map[string][]string{
 		NamespaceParam: []string{AllNamespaces},
@@ -130,6 +144,8 @@ func Test_EventHeatMap3_SimpleTestWithOneDeployment(t *testing.T) {
    "kind": "Pod",
    "namespace": "somens",
    "overlays": [],
+   "restarts": [],
+   "segments": [],
    "changedat": null,
    "nochangeat": null,
    "start_date": 1551398520,
@@ -162,6 +178,7 @@ func Test_EventHeatMap3_OneDeploymentAnd3Events(t *testing.T) {
    "duration": 3480,
    "kind": "Pod",
    "namespace": "somens",
+   "restarts": [],
    "overlays": [
     {
      "text": "ImagePullError:1 LivenessProveFailed:2",
@@ -176,6 +193,7 @@ func Test_EventHeatMap3_OneDeploymentAnd3Events(t *testing.T) {
      "end_date": 1551400140
     }
    ],
+   "segments": [],
    "changedat": [
     1551400080
    ],
@@ -191,6 +209,106 @@ func Test_EventHeatMap3_OneDeploymentAnd3Events(t *testing.T) {
 	assertex.JsonEqual(t, expectedJson, string(resultJsonBytes))
 }
 
+func Test_EventHeatMap3_HealthSegments(t *testing.T) {
+	untyped.TestHookSetPartitionDuration(time.Hour * 24)
+	db, err := (&badgerwrap.MockFactory{}).Open(badger.DefaultOptions(""))
+	assert.Nil(t, err)
+	tables := typed.NewTableList(db)
+
+	helper_AddResSum(t, tables)
+	helper_AddHealth(t, tables)
+
+	resultJsonBytes, err := EventHeatMap3Query(helper_UrlValues(), tables, someHeatMapQueryStart, someHeatMapQueryEnd, someRequestId)
+	assert.Nil(t, err)
+	expectedJson := `{
+ "view_options": {
+  "sort": ""
+ },
+ "rows": [
+  {
+   "text": "somename",
+   "duration": 3480,
+   "kind": "Pod",
+   "namespace": "somens",
+   "overlays": [],
+   "restarts": [],
+   "segments": [
+    {
+     "state": "Pending",
+     "severity": "progressing",
+     "message": "",
+     "start_date": 1551398520,
+     "duration": 300,
+     "end_date": 1551398820
+    },
+    {
+     "state": "Running",
+     "severity": "healthy",
+     "message": "",
+     "start_date": 1551398820,
+     "duration": 1260,
+     "end_date": 1551400080
+    },
+    {
+     "state": "CrashLoopBackOff",
+     "severity": "error",
+     "message": "container app: back-off 10s",
+     "start_date": 1551400080,
+     "duration": 1920,
+     "end_date": 1551402000
+    }
+   ],
+   "changedat": null,
+   "nochangeat": null,
+   "start_date": 1551398520,
+   "end_date": 1551402000
+  }
+ ]
+}`
+	assertex.JsonEqual(t, expectedJson, string(resultJsonBytes))
+}
+
+func Test_healthChangesToSegments_JoinsPartitions(t *testing.T) {
+	d3row := &TimelineRow{StartDate: 100, EndDate: 1000}
+	changes := []*typed.HealthChange{
+		{Timestamp: 50, State: "Running", Severity: "healthy"},
+		{Timestamp: 300, State: "NotReady", Severity: "warning", Message: "containers not ready: app"},
+		// The next partition starts with the state the resource is in
+		{Timestamp: 600, State: "NotReady", Severity: "warning", Message: "containers not ready: app, sidecar"},
+		{Timestamp: 700, State: "Running", Severity: "healthy"},
+		{Timestamp: 1200, State: "Succeeded", Severity: "healthy"},
+	}
+	assert.Equal(t, []Segment{
+		{State: "Running", Severity: "healthy", StartDate: 100, Duration: 200, EndDate: 300},
+		{State: "NotReady", Severity: "warning", Message: "containers not ready: app", StartDate: 300, Duration: 400, EndDate: 700},
+		{State: "Running", Severity: "healthy", StartDate: 700, Duration: 300, EndDate: 1000},
+	}, healthChangesToSegments(d3row, changes))
+	assert.Equal(t, []Segment{}, healthChangesToSegments(d3row, nil))
+}
+
+func Test_mergeHeatmapWithRestarts_MarksRestartsInRow(t *testing.T) {
+	untyped.TestHookSetPartitionDuration(time.Hour)
+	partitionId := untyped.GetPartitionId(someHeatMapQueryStart)
+	restartKey := typed.NewRestartKey(partitionId, someNamespace, someName, someUid, "app")
+	restarts, err := restartsToMap(map[typed.RestartKey]*typed.ContainerRestarts{
+		*restartKey: {Restarts: []*typed.ContainerRestart{
+			{Timestamp: 500, Restarted: true, RestartCount: 2, Reason: "Error", ExitCode: 1},
+			{Timestamp: 200, Restarted: true, RestartCount: 1, Reason: "OOMKilled", ExitCode: 137},
+			{Timestamp: 50, Restarted: true, Reason: "Error"},
+		}},
+	})
+	assert.Nil(t, err)
+
+	resKey := *typed.NewResourceSummaryKey(someHeatMapQueryStart, kindPod, someNamespace, someName, someUid)
+	resKey.PartitionId = EmptyPartition
+	rows := map[typed.ResourceSummaryKey]*TimelineRow{resKey: {StartDate: 100, EndDate: 1000}}
+	mergeHeatmapWithRestarts(rows, restarts)
+	assert.Equal(t, []Restart{
+		{Timestamp: 200, Namespace: someNamespace, Pod: someName, Uid: someUid, Container: "app", Restarted: true, RestartCount: 1, Reason: "OOMKilled", ExitCode: 137},
+		{Timestamp: 500, Namespace: someNamespace, Pod: someName, Uid: someUid, Container: "app", Restarted: true, RestartCount: 2, Reason: "Error", ExitCode: 1},
+	}, rows[resKey].Restarts)
+}
+
 var someAdjQueryEndTime = time.Date(2019, 3, 1, 0, 0, 0, 0, time.UTC)
 var someAdjLastSeenOld = someAdjQueryEndTime.Add(-5 * time.Hour)
 var someAdjLastSeenRecent = someAdjQueryEndTime.Add(-5 * time.Minute)
@@ -73,6 +73,39 @@ func paramFilterWatchActivityFn(params url.Values) func(string) bool {
 	}
 }
 
+func paramFilterHealthFn(params url.Values) func(string) bool {
+	selectedNamespace := params.Get(NamespaceParam)
+	selectedKind := params.Get(KindParam)
+	selectedNameSubstring := params.Get(NameMatchParam)
+	selectedNameExactMatch := params.Get(NameParam)
+	selectedUuid := params.Get(UuidParam)
+	return func(key string) bool {
+		k := &typed.HealthKey{}
+		err := k.Parse(key)
+		if err != nil {
+			return false
+		}
+		return keepRowHelper(k.Name, k.Kind, k.Namespace, selectedKind, selectedNamespace, selectedNameSubstring, selectedNameExactMatch, selectedUuid, k.Uid)
+	}
+}
+
+// Restarts are of pods, so a kind other than Pod filters them all out
+func paramFilterRestartFn(params url.Values) func(string) bool {
+	selectedNamespace := params.Get(NamespaceParam)
+	selectedKind := params.Get(KindParam)
+	selectedNameSubstring := params.Get(NameMatchParam)
+	selectedNameExactMatch := params.Get(NameParam)
+	selectedUuid := params.Get(UuidParam)
+	return func(key string) bool {
+		k := &typed.RestartKey{}
+		err := k.Parse(key)
+		if err != nil {
+			return false
+		}
+		return keepRowHelper(k.Name, kubeextractor.PodKind, k.Namespace, selectedKind, selectedNamespace, selectedNameSubstring, selectedNameExactMatch, selectedUuid, k.Uid)
+	}
+}
+
 // TODO: Try and remove some of this special logic.  Maybe have a generic approach for resources that dont have namespaces
 func keepRowHelper(name string, kind string, namespace string, selectedKind string, selectedNamespace string, selectedNameMatchSubstring string, selectedNameExactMatch string, selectedUuid string, uuid string) bool {
 	// Edge cases:
@@ -0,0 +1,456 @@
+/*
+ * Copyright (c) 2021, salesforce.com, inc.
+ * All rights reserved.
+ * SPDX-License-Identifier: BSD-3-Clause
+ * For full license text, see LICENSE.txt file in the repo root or https://opensource.org/licenses/BSD-3-Clause
+ */
+
+package queries
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/pkg/errors"
+	"github.com/salesforce/sloop/pkg/sloop/kubeextractor"
+	"github.com/salesforce/sloop/pkg/sloop/store/typed"
+	"github.com/salesforce/sloop/pkg/sloop/store/untyped/badgerwrap"
+)
+
+const (
+	defaultGraphDepth = 2
+	maxGraphDepth     = 5
+	// Large enough for a deployment with all its pods, small enough for the browser to lay it out
+	maxGraphNodes = 300
+)
+
+type ResourceGraphOutput struct {
+	Nodes []*GraphNode `json:"nodes"`
+	Edges []*GraphEdge `json:"edges"`
+	// Set when maxGraphNodes was reached before the walk got to the depth limit
+	Truncated bool `json:"truncated"`
+}
+
+// GraphNode is one resource.  Times are unix seconds, merged over all partitions in the time range
+type GraphNode struct {
+	Id           string `json:"id"`
+	Kind         string `json:"kind"`
+	Namespace    string `json:"namespace"`
+	Name         string `json:"name"`
+	Uid          string `json:"uid"`
+	FirstSeen    int64  `json:"firstSeen"`
+	LastSeen     int64  `json:"lastSeen"`
+	DeletedAtEnd bool   `json:"deletedAtEnd"`
+	// False for targets of edges that have no resource summary in the time range, like a missing secret
+	Seen bool `json:"seen"`
+	// Number of edges between this node and the one the walk started from
+	Depth int `json:"depth"`
+}
+
+// GraphEdge points from the resource that names the other one in its payload.  Times are when the source had it
+type GraphEdge struct {
+	From      string `json:"from"`
+	To        string `json:"to"`
+	Type      string `json:"type"`
+	FirstSeen int64  `json:"firstSeen"`
+	LastSeen  int64  `json:"lastSeen"`
+}
+
+type selectorEdge struct {
+	from string
+	// Kind and namespace of the targets
+	target    string
+	relType   string
+	selector  map[string]string
+	firstSeen int64
+	lastSeen  int64
+}
+
+// graphIndex has the edges of every resource summary in the time range, in both directions
+type graphIndex struct {
+	nodes    map[string]*GraphNode
+	byName   map[string][]string
+	byUid    map[string]string
+	out      map[string][]*GraphEdge
+	in       map[string][]*GraphEdge
+	byKindNs map[string][]string
+	// Selectors are matched against pod labels while walking, keyed by target kind and namespace
+	selectors     map[string][]*selectorEdge
+	selectorsFrom map[string][]*selectorEdge
+	labels        map[string]map[string]string
+}
+
+// ResourceGraph walks the relationships of the resource picked by uuid, or by kind, namespace and name, in both
+// directions up to the depth param
+func ResourceGraph(params url.Values, t typed.Tables, startTime time.Time, endTime time.Time, requestId string) ([]byte, error) {
+	depth, err := getGraphDepth(params)
+	if err != nil {
+		return []byte{}, err
+	}
+	uid := params.Get(UuidParam)
+	kind := params.Get(KindParam)
+	namespace := params.Get(NamespaceParam)
+	name := params.Get(NameParam)
+	if uid == "" && (kind == "" || name == "") {
+		return []byte{}, fmt.Errorf("ResourceGraph needs either %v or %v and %v", UuidParam, KindParam, NameParam)
+	}
+
+	var output *ResourceGraphOutput
+	err = t.Db().View(func(txn badgerwrap.Txn) error {
+		resSummaries, stats, err2 := t.ResourceSummaryTable().RangeRead(txn, nil, nil, isResSummaryValInTimeRange(startTime, endTime), startTime, endTime)
+		if err2 != nil {
+			return err2
+		}
+		stats.Log(requestId)
+
+		index, err2 := newGraphIndex(resSummaries)
+		if err2 != nil {
+			return err2
+		}
+		var root string
+		if uid != "" {
+			root = index.byUid[uid]
+		} else if ids := index.byName[nameKey(kind, namespace, name)]; len(ids) > 0 {
+			root = index.newest(ids)
+		}
+		if root == "" {
+			return nil
+		}
+		output, err2 = index.walk(t, txn, root, depth, startTime, endTime)
+		return err2
+	})
+	if err != nil {
+		return []byte{}, err
+	}
+	if output == nil {
+		return []byte{}, nil
+	}
+
+	bytes, err := json.MarshalIndent(output, "", " ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal json %v", err)
+	}
+	return bytes, nil
+}
+
+func getGraphDepth(params url.Values) (int, error) {
+	depthStr := params.Get(DepthParam)
+	if depthStr == "" {
+		return defaultGraphDepth, nil
+	}
+	depth, err := strconv.Atoi(depthStr)
+	if err != nil || depth < 1 {
+		return 0, fmt.Errorf("invalid %v: %v", DepthParam, depthStr)
+	}
+	if depth > maxGraphDepth {
+		depth = maxGraphDepth
+	}
+	return depth, nil
+}
+
+func nodeId(kind string, namespace string, name string, uid string) string {
+	return fmt.Sprintf("%v/%v/%v/%v", kind, namespace, name, uid)
+}
+
+func nameKey(kind string, namespace string, name string) string {
+	return fmt.Sprintf("%v/%v/%v", kind, namespace, name)
+}
+
+func kindNsKey(kind string, namespace string) string {
+	return fmt.Sprintf("%v/%v", kind, namespace)
+}
+
+func newGraphIndex(resSummaries map[typed.ResourceSummaryKey]*typed.ResourceSummary) (*graphIndex, error) {
+	index := &graphIndex{
+		nodes:         map[string]*GraphNode{},
+		byName:        map[string][]string{},
+		byUid:         map[string]string{},
+		out:           map[string][]*GraphEdge{},
+		in:            map[string][]*GraphEdge{},
+		byKindNs:      map[string][]string{},
+		selectors:     map[string][]*selectorEdge{},
+		selectorsFrom: map[string][]*selectorEdge{},
+		labels:        map[string]map[string]string{},
+	}
+
+	// Edges are merged over partitions before they are resolved, as targets only known by name resolve to every
+	// uid with that name
+	type rawEdge struct {
+		from string
+		rel  kubeextractor.Relationship
+	}
+	rawEdges := map[rawEdge]*GraphEdge{}
+	for key, val := range resSummaries {
+		firstSeen, err := ptypes.Timestamp(val.FirstSeen)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not convert first seen for %v", key.String())
+		}
+		lastSeen, err := ptypes.Timestamp(val.LastSeen)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not convert last seen for %v", key.String())
+		}
+		id := index.addNode(key.Kind, key.Namespace, key.Name, key.Uid)
+		node := index.nodes[id]
+		node.Seen = true
+		if node.FirstSeen == 0 || firstSeen.Unix() < node.FirstSeen {
+			node.FirstSeen = firstSeen.Unix()
+		}
+		if lastSeen.Unix() >= node.LastSeen {
+			node.LastSeen = lastSeen.Unix()
+			node.DeletedAtEnd = val.DeletedAtEnd
+		}
+
+		for _, rel := range getSummaryEdges(val) {
+			edgeKey := rawEdge{from: id, rel: kubeextractor.Relationship{Type: rel.Type, Kind: rel.Kind, Namespace: rel.Namespace, Name: rel.Name, Uid: rel.Uid, Selector: rel.Selector}}
+			edge, ok := rawEdges[edgeKey]
+			if !ok {
+				edge = &GraphEdge{From: id, Type: rel.Type, FirstSeen: firstSeen.Unix(), LastSeen: lastSeen.Unix()}
+				rawEdges[edgeKey] = edge
+			}
+			if firstSeen.Unix() < edge.FirstSeen {
+				edge.FirstSeen = firstSeen.Unix()
+			}
+			if lastSeen.Unix() > edge.LastSeen {
+				edge.LastSeen = lastSeen.Unix()
+			}
+		}
+	}
+
+	for edgeKey, edge := range rawEdges {
+		rel := edgeKey.rel
+		if rel.Selector != "" {
+			target := kindNsKey(rel.Kind, rel.Namespace)
+			sel := &selectorEdge{from: edge.From, target: target, relType: rel.Type, selector: kubeextractor.ParseSelector(rel.Selector), firstSeen: edge.FirstSeen, lastSeen: edge.LastSeen}
+			index.selectors[target] = append(index.selectors[target], sel)
+			index.selectorsFrom[edge.From] = append(index.selectorsFrom[edge.From], sel)
+			continue
+		}
+		for _, to := range index.resolve(rel) {
+			if rel.Type == kubeextractor.RelationshipInvolvedObject {
+				index.addEventEdge(to, edge)
+				continue
+			}
+			index.addEdge(&GraphEdge{From: edge.From, To: to, Type: edge.Type, FirstSeen: edge.FirstSeen, LastSeen: edge.LastSeen})
+		}
+	}
+	return index, nil
+}
+
+// Summaries written before edges were extracted only have owner references, as resource summary keys
+func getSummaryEdges(val *typed.ResourceSummary) []*typed.Relationship {
+	if len(val.Edges) > 0 || len(val.Relationships) == 0 {
+		return val.Edges
+	}
+	edges := []*typed.Relationship{}
+	for _, refKey := range val.Relationships {
+		key := &typed.ResourceSummaryKey{}
+		if key.Parse(refKey) != nil {
+			continue
+		}
+		edges = append(edges, &typed.Relationship{Type: kubeextractor.RelationshipOwner, Kind: key.Kind, Namespace: key.Namespace, Name: key.Name, Uid: key.Uid})
+	}
+	return edges
+}
+
+func (index *graphIndex) addNode(kind string, namespace string, name string, uid string) string {
+	id := nodeId(kind, namespace, name, uid)
+	if _, ok := index.nodes[id]; ok {
+		return id
+	}
+	index.nodes[id] = &GraphNode{Id: id, Kind: kind, Namespace: namespace, Name: name, Uid: uid}
+	index.byName[nameKey(kind, namespace, name)] = append(index.byName[nameKey(kind, namespace, name)], id)
+	index.byKindNs[kindNsKey(kind, namespace)] = append(index.byKindNs[kindNsKey(kind, namespace)], id)
+	if uid != "" {
+		index.byUid[uid] = id
+	}
+	return id
+}
+
+func (index *graphIndex) addEdge(edge *GraphEdge) {
+	index.out[edge.From] = append(index.out[edge.From], edge)
+	index.in[edge.To] = append(index.in[edge.To], edge)
+}
+
+// Edges to events are kept on the summary of the object the event is about, so they are turned around to point from
+// the event like the others.  Events have no summary, so the event node is seen whenever the object had it
+func (index *graphIndex) addEventEdge(eventId string, edge *GraphEdge) {
+	node := index.nodes[eventId]
+	node.Seen = true
+	if node.FirstSeen == 0 || edge.FirstSeen < node.FirstSeen {
+		node.FirstSeen = edge.FirstSeen
+	}
+	if edge.LastSeen > node.LastSeen {
+		node.LastSeen = edge.LastSeen
+	}
+	index.addEdge(&GraphEdge{From: eventId, To: edge.From, Type: edge.Type, FirstSeen: edge.FirstSeen, LastSeen: edge.LastSeen})
+}
+
+// Targets are matched by uid when the payload has one, then by name.  A target with no summary in the time range
+// still gets a node, so the graph shows what is missing
+func (index *graphIndex) resolve(rel kubeextractor.Relationship) []string {
+	if rel.Uid != "" {
+		if id, ok := index.byUid[rel.Uid]; ok {
+			return []string{id}
+		}
+	}
+	if ids := index.byName[nameKey(rel.Kind, rel.Namespace, rel.Name)]; len(ids) > 0 {
+		seen := []string{}
+		for _, id := range ids {
+			if index.nodes[id].Seen {
+				seen = append(seen, id)
+			}
+		}
+		if len(seen) > 0 {
+			return seen
+		}
+	}
+	return []string{index.addNode(rel.Kind, rel.Namespace, rel.Name, rel.Uid)}
+}
+
+// A name can have several uids when the resource was re-created.  The one seen last is the one we start from
+func (index *graphIndex) newest(ids []string) string {
+	newest := ids[0]
+	for _, id := range ids[1:] {
+		if index.nodes[id].LastSeen > index.nodes[newest].LastSeen {
+			newest = id
+		}
+	}
+	return newest
+}
+
+// Labels come from the newest payload in the time range, so a pod that was relabeled shows up where it ended
+func (index *graphIndex) getLabels(t typed.Tables, txn badgerwrap.Txn, id string, startTime time.Time, endTime time.Time) (map[string]string, error) {
+	if labels, ok := index.labels[id]; ok {
+		return labels, nil
+	}
+	node := index.nodes[id]
+	keyComparator := typed.NewWatchTableKeyComparator(node.Kind, node.Namespace, node.Name, time.Time{})
+	_, watchRes, err := t.WatchTable().GetLastValue(txn, keyComparator, startTime, endTime)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get labels for %v", id)
+	}
+	labels := map[string]string{}
+	if watchRes != nil {
+		metadata, err := kubeextractor.ExtractMetadata(watchRes.Payload)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not extract labels for %v", id)
+		}
+		if metadata.Labels != nil {
+			labels = metadata.Labels
+		}
+	}
+	index.labels[id] = labels
+	return labels, nil
+}
+
+func selectorMatches(selector map[string]string, labels map[string]string) bool {
+	if len(selector) == 0 {
+		return false
+	}
+	for key, value := range selector {
+		if labelValue, ok := labels[key]; !ok || labelValue != value {
+			return false
+		}
+	}
+	return true
+}
+
+// Edges for the selectors that pick id, and for the selectors id has itself
+func (index *graphIndex) selectorEdges(t typed.Tables, txn badgerwrap.Txn, id string, startTime time.Time, endTime time.Time) ([]*GraphEdge, error) {
+	ret := []*GraphEdge{}
+	node := index.nodes[id]
+	for _, sel := range index.selectors[kindNsKey(node.Kind, node.Namespace)] {
+		labels, err := index.getLabels(t, txn, id, startTime, endTime)
+		if err != nil {
+			return nil, err
+		}
+		if selectorMatches(sel.selector, labels) {
+			ret = append(ret, &GraphEdge{From: sel.from, To: id, Type: sel.relType, FirstSeen: sel.firstSeen, LastSeen: sel.lastSeen})
+		}
+	}
+	for _, sel := range index.selectorsFrom[id] {
+		for _, target := range index.byKindNs[sel.target] {
+			if !index.nodes[target].Seen {
+				continue
+			}
+			labels, err := index.getLabels(t, txn, target, startTime, endTime)
+			if err != nil {
+				return nil, err
+			}
+			if selectorMatches(sel.selector, labels) {
+				ret = append(ret, &GraphEdge{From: id, To: target, Type: sel.relType, FirstSeen: sel.firstSeen, LastSeen: sel.lastSeen})
+			}
+		}
+	}
+	return ret, nil
+}
+
+func (index *graphIndex) walk(t typed.Tables, txn badgerwrap.Txn, root string, maxDepth int, startTime time.Time, endTime time.Time) (*ResourceGraphOutput, error) {
+	output := &ResourceGraphOutput{Nodes: []*GraphNode{}, Edges: []*GraphEdge{}}
+	depths := map[string]int{root: 0}
+	queue := []string{root}
+	edges := map[string]*GraphEdge{}
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		if depths[id] >= maxDepth {
+			continue
+		}
+		neighbours := append(append([]*GraphEdge{}, index.out[id]...), index.in[id]...)
+		selEdges, err := index.selectorEdges(t, txn, id, startTime, endTime)
+		if err != nil {
+			return nil, err
+		}
+		neighbours = append(neighbours, selEdges...)
+		for _, edge := range neighbours {
+			next := edge.To
+			if next == id {
+				next = edge.From
+			}
+			if _, ok := depths[next]; !ok {
+				if len(depths) >= maxGraphNodes {
+					output.Truncated = true
+					continue
+				}
+				depths[next] = depths[id] + 1
+				queue = append(queue, next)
+			}
+			edges[edge.From+"|"+edge.To+"|"+edge.Type] = edge
+		}
+	}
+
+	for id, depth := range depths {
+		node := *index.nodes[id]
+		node.Depth = depth
+		output.Nodes = append(output.Nodes, &node)
+	}
+	sort.Slice(output.Nodes, func(i, j int) bool {
+		if output.Nodes[i].Depth != output.Nodes[j].Depth {
+			return output.Nodes[i].Depth < output.Nodes[j].Depth
+		}
+		return output.Nodes[i].Id < output.Nodes[j].Id
+	})
+	for key, edge := range edges {
+		_, fromOk := depths[edge.From]
+		_, toOk := depths[edge.To]
+		if fromOk && toOk {
+			output.Edges = append(output.Edges, edges[key])
+		}
+	}
+	sort.Slice(output.Edges, func(i, j int) bool {
+		a, b := output.Edges[i], output.Edges[j]
+		if a.From != b.From {
+			return a.From < b.From
+		}
+		if a.To != b.To {
+			return a.To < b.To
+		}
+		return a.Type < b.Type
+	})
+	return output, nil
+}
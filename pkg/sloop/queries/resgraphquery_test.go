@@ -0,0 +1,222 @@
+/*
+ * Copyright (c) 2021, salesforce.com, inc.
+ * All rights reserved.
+ * SPDX-License-Identifier: BSD-3-Clause
+ * For full license text, see LICENSE.txt file in the repo root or https://opensource.org/licenses/BSD-3-Clause
+ */
+
+package queries
+
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/dgraph-io/badger/v2"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/salesforce/sloop/pkg/sloop/kubeextractor"
+	"github.com/salesforce/sloop/pkg/sloop/store/typed"
+	"github.com/salesforce/sloop/pkg/sloop/store/untyped"
+	"github.com/salesforce/sloop/pkg/sloop/store/untyped/badgerwrap"
+	"github.com/stretchr/testify/assert"
+)
+
+var someGraphTs = time.Date(2019, 3, 4, 3, 4, 5, 0, time.UTC)
+
+type graphResource struct {
+	key     *typed.ResourceSummaryKey
+	edges   []*typed.Relationship
+	payload string
+}
+
+func helper_get_graphTables(t *testing.T, resources []graphResource) typed.Tables {
+	ts, err := ptypes.TimestampProto(someGraphTs)
+	assert.Nil(t, err)
+	db, err := (&badgerwrap.MockFactory{}).Open(badger.DefaultOptions(""))
+	assert.Nil(t, err)
+	tables := typed.NewTableList(db)
+	err = db.Update(func(txn badgerwrap.Txn) error {
+		for _, res := range resources {
+			val := &typed.ResourceSummary{FirstSeen: ts, LastSeen: ts, Edges: res.edges}
+			txerr := tables.ResourceSummaryTable().Set(txn, res.key.String(), val)
+			if txerr != nil {
+				return txerr
+			}
+			if res.payload == "" {
+				continue
+			}
+			watchKey := typed.NewWatchTableKey(res.key.PartitionId, res.key.Kind, res.key.Namespace, res.key.Name, someGraphTs)
+			txerr = tables.WatchTable().Set(txn, watchKey.String(), &typed.KubeWatchResult{Timestamp: ts, Kind: res.key.Kind, Payload: res.payload})
+			if txerr != nil {
+				return txerr
+			}
+		}
+		return nil
+	})
+	assert.Nil(t, err)
+	return tables
+}
+
+// Deployment web <- ReplicaSet web-1 <- pods web-1-a and web-1-b, which mount web-config and run on node1.  Service
+// web selects both pods, and pod other also runs on node1
+func helper_get_graphResources() []graphResource {
+	owner := func(kind string, name string, uid string) *typed.Relationship {
+		return &typed.Relationship{Type: kubeextractor.RelationshipOwner, Kind: kind, Namespace: "ns", Name: name, Uid: uid}
+	}
+	node := &typed.Relationship{Type: kubeextractor.RelationshipNodeName, Kind: kubeextractor.NodeKind, Name: "node1"}
+	config := &typed.Relationship{Type: kubeextractor.RelationshipVolume, Kind: kubeextractor.ConfigMapKind, Namespace: "ns", Name: "web-config"}
+	return []graphResource{
+		{key: typed.NewResourceSummaryKey(someGraphTs, "Deployment", "ns", "web", "uid-web")},
+		{key: typed.NewResourceSummaryKey(someGraphTs, "ReplicaSet", "ns", "web-1", "uid-web-1"), edges: []*typed.Relationship{owner("Deployment", "web", "uid-web")}},
+		{key: typed.NewResourceSummaryKey(someGraphTs, kubeextractor.PodKind, "ns", "web-1-a", "uid-web-1-a"), edges: []*typed.Relationship{owner("ReplicaSet", "web-1", "uid-web-1"), node, config},
+			payload: `{"metadata": {"name": "web-1-a", "namespace": "ns", "labels": {"app": "web", "pod-template-hash": "1"}}}`},
+		{key: typed.NewResourceSummaryKey(someGraphTs, kubeextractor.PodKind, "ns", "web-1-b", "uid-web-1-b"), edges: []*typed.Relationship{owner("ReplicaSet", "web-1", "uid-web-1"), node, config},
+			payload: `{"metadata": {"name": "web-1-b", "namespace": "ns", "labels": {"app": "web", "pod-template-hash": "1"}}}`},
+		{key: typed.NewResourceSummaryKey(someGraphTs, kubeextractor.PodKind, "ns", "other", "uid-other"), edges: []*typed.Relationship{node},
+			payload: `{"metadata": {"name": "other", "namespace": "ns", "labels": {"app": "other"}}}`},
+		{key: typed.NewResourceSummaryKey(someGraphTs, kubeextractor.ConfigMapKind, "ns", "web-config", "uid-web-config")},
+		{key: typed.NewResourceSummaryKey(someGraphTs, kubeextractor.ServiceKind, "ns", "web", "uid-web-svc"),
+			edges: []*typed.Relationship{{Type: kubeextractor.RelationshipSelector, Kind: kubeextractor.PodKind, Namespace: "ns", Selector: "app=web"}}},
+	}
+}
+
+func helper_runResourceGraph(t *testing.T, tables typed.Tables, params url.Values) ResourceGraphOutput {
+	res, err := ResourceGraph(params, tables, someGraphTs.Add(-1*time.Hour), someGraphTs.Add(time.Hour), someRequestId)
+	assert.Nil(t, err)
+	output := ResourceGraphOutput{}
+	assert.Nil(t, json.Unmarshal(res, &output))
+	return output
+}
+
+func helper_graphNodeDepths(output ResourceGraphOutput) map[string]int {
+	depths := map[string]int{}
+	for _, node := range output.Nodes {
+		depths[node.Id] = node.Depth
+	}
+	return depths
+}
+
+func helper_graphEdges(output ResourceGraphOutput) []string {
+	edges := []string{}
+	for _, edge := range output.Edges {
+		edges = append(edges, edge.From+" -"+edge.Type+"-> "+edge.To)
+	}
+	return edges
+}
+
+func Test_ResourceGraph_WalksOwnersAndChildren(t *testing.T) {
+	untyped.TestHookSetPartitionDuration(time.Hour)
+	tables := helper_get_graphTables(t, helper_get_graphResources())
+	params := url.Values{KindParam: []string{"ReplicaSet"}, NamespaceParam: []string{"ns"}, NameParam: []string{"web-1"}, DepthParam: []string{"1"}}
+
+	output := helper_runResourceGraph(t, tables, params)
+	assert.Equal(t, map[string]int{
+		"ReplicaSet/ns/web-1/uid-web-1": 0,
+		"Deployment/ns/web/uid-web":     1,
+		"Pod/ns/web-1-a/uid-web-1-a":    1,
+		"Pod/ns/web-1-b/uid-web-1-b":    1,
+	}, helper_graphNodeDepths(output))
+	assert.Equal(t, []string{
+		"Pod/ns/web-1-a/uid-web-1-a -owner-> ReplicaSet/ns/web-1/uid-web-1",
+		"Pod/ns/web-1-b/uid-web-1-b -owner-> ReplicaSet/ns/web-1/uid-web-1",
+		"ReplicaSet/ns/web-1/uid-web-1 -owner-> Deployment/ns/web/uid-web",
+	}, helper_graphEdges(output))
+	assert.False(t, output.Truncated)
+	assert.Equal(t, someGraphTs.Unix(), output.Nodes[0].FirstSeen)
+	assert.Equal(t, someGraphTs.Unix(), output.Edges[0].LastSeen)
+}
+
+func Test_ResourceGraph_ResolvesSelectorsAndMissingTargets(t *testing.T) {
+	untyped.TestHookSetPartitionDuration(time.Hour)
+	tables := helper_get_graphTables(t, helper_get_graphResources())
+	params := url.Values{UuidParam: []string{"uid-web-1-a"}, DepthParam: []string{"1"}}
+
+	output := helper_runResourceGraph(t, tables, params)
+	assert.Equal(t, map[string]int{
+		"Pod/ns/web-1-a/uid-web-1-a":             0,
+		"ReplicaSet/ns/web-1/uid-web-1":          1,
+		"Node//node1/":                           1,
+		"ConfigMap/ns/web-config/uid-web-config": 1,
+		"Service/ns/web/uid-web-svc":             1,
+	}, helper_graphNodeDepths(output))
+	for _, node := range output.Nodes {
+		assert.Equal(t, node.Kind != kubeextractor.NodeKind, node.Seen, node.Id)
+	}
+	assert.Contains(t, helper_graphEdges(output), "Service/ns/web/uid-web-svc -selector-> Pod/ns/web-1-a/uid-web-1-a")
+
+	// From the service the selector picks the pods with matching labels only
+	params = url.Values{KindParam: []string{kubeextractor.ServiceKind}, NamespaceParam: []string{"ns"}, NameParam: []string{"web"}, DepthParam: []string{"1"}}
+	output = helper_runResourceGraph(t, tables, params)
+	assert.Equal(t, []string{
+		"Service/ns/web/uid-web-svc -selector-> Pod/ns/web-1-a/uid-web-1-a",
+		"Service/ns/web/uid-web-svc -selector-> Pod/ns/web-1-b/uid-web-1-b",
+	}, helper_graphEdges(output))
+}
+
+func Test_ResourceGraph_EventsPointAtTheirObject(t *testing.T) {
+	untyped.TestHookSetPartitionDuration(time.Hour)
+	event := &typed.Relationship{Type: kubeextractor.RelationshipInvolvedObject, Kind: kubeextractor.EventKind, Namespace: "ns", Name: "web-0.1", Uid: "uid-event"}
+	tables := helper_get_graphTables(t, []graphResource{
+		{key: typed.NewResourceSummaryKey(someGraphTs, kubeextractor.PodKind, "ns", "web-0", "uid-web-0"), edges: []*typed.Relationship{event}},
+	})
+
+	output := helper_runResourceGraph(t, tables, url.Values{UuidParam: []string{"uid-web-0"}})
+	assert.Equal(t, map[string]int{
+		"Pod/ns/web-0/uid-web-0":     0,
+		"Event/ns/web-0.1/uid-event": 1,
+	}, helper_graphNodeDepths(output))
+	assert.Equal(t, []string{"Event/ns/web-0.1/uid-event -involvedObject-> Pod/ns/web-0/uid-web-0"}, helper_graphEdges(output))
+	for _, node := range output.Nodes {
+		assert.True(t, node.Seen, node.Id)
+		assert.Equal(t, someGraphTs.Unix(), node.LastSeen, node.Id)
+	}
+}
+
+func Test_ResourceGraph_DepthLimit(t *testing.T) {
+	untyped.TestHookSetPartitionDuration(time.Hour)
+	tables := helper_get_graphTables(t, helper_get_graphResources())
+	params := url.Values{UuidParam: []string{"uid-web"}}
+
+	// The default depth stops at the pods
+	output := helper_runResourceGraph(t, tables, params)
+	assert.Len(t, output.Nodes, 4)
+
+	params[DepthParam] = []string{"10"}
+	output = helper_runResourceGraph(t, tables, params)
+	assert.Len(t, output.Nodes, 8)
+	assert.Equal(t, 4, helper_graphNodeDepths(output)["Pod/ns/other/uid-other"])
+
+	params[DepthParam] = []string{"0"}
+	_, err := ResourceGraph(params, tables, someGraphTs.Add(-1*time.Hour), someGraphTs.Add(time.Hour), someRequestId)
+	assert.NotNil(t, err)
+}
+
+func Test_ResourceGraph_LegacyOwnerKeysAndUnknownRoot(t *testing.T) {
+	untyped.TestHookSetPartitionDuration(time.Hour)
+	ts, err := ptypes.TimestampProto(someGraphTs)
+	assert.Nil(t, err)
+	db, err := (&badgerwrap.MockFactory{}).Open(badger.DefaultOptions(""))
+	assert.Nil(t, err)
+	tables := typed.NewTableList(db)
+	deployment := typed.NewResourceSummaryKey(someGraphTs, "Deployment", "ns", "web", "uid-web")
+	replicaSet := typed.NewResourceSummaryKey(someGraphTs, "ReplicaSet", "ns", "web-1", "uid-web-1")
+	err = db.Update(func(txn badgerwrap.Txn) error {
+		txerr := tables.ResourceSummaryTable().Set(txn, deployment.String(), &typed.ResourceSummary{FirstSeen: ts, LastSeen: ts})
+		if txerr != nil {
+			return txerr
+		}
+		return tables.ResourceSummaryTable().Set(txn, replicaSet.String(), &typed.ResourceSummary{FirstSeen: ts, LastSeen: ts, Relationships: []string{deployment.String()}})
+	})
+	assert.Nil(t, err)
+
+	output := helper_runResourceGraph(t, tables, url.Values{UuidParam: []string{"uid-web"}})
+	assert.Equal(t, []string{"ReplicaSet/ns/web-1/uid-web-1 -owner-> Deployment/ns/web/uid-web"}, helper_graphEdges(output))
+
+	res, err := ResourceGraph(url.Values{UuidParam: []string{"uid-gone"}}, tables, someGraphTs.Add(-1*time.Hour), someGraphTs.Add(time.Hour), someRequestId)
+	assert.Nil(t, err)
+	assert.Equal(t, "", string(res))
+
+	_, err = ResourceGraph(url.Values{KindParam: []string{"Deployment"}}, tables, someGraphTs.Add(-1*time.Hour), someGraphTs.Add(time.Hour), someRequestId)
+	assert.NotNil(t, err)
+}
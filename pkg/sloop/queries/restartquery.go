@@ -0,0 +1,163 @@
+/*
+ * Copyright (c) 2021, salesforce.com, inc.
+ * All rights reserved.
+ * SPDX-License-Identifier: BSD-3-Clause
+ * For full license text, see LICENSE.txt file in the repo root or https://opensource.org/licenses/BSD-3-Clause
+ */
+
+package queries
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"time"
+
+	"github.com/salesforce/sloop/pkg/sloop/kubeextractor"
+	"github.com/salesforce/sloop/pkg/sloop/store/typed"
+	"github.com/salesforce/sloop/pkg/sloop/store/untyped/badgerwrap"
+)
+
+type ContainerRestartsData struct {
+	Restarts []Restart `json:"restarts"`
+}
+
+// ContainerRestarts lists the containers that restarted or terminated in the time range, oldest first.  It takes a
+// namespace, or all of them, and optionally a workload by kind and name.  A workload is a pod, or anything that owns
+// pods directly or through other owners, like a deployment through its replica sets
+func ContainerRestarts(params url.Values, t typed.Tables, startTime time.Time, endTime time.Time, requestId string) ([]byte, error) {
+	namespace := params.Get(NamespaceParam)
+	kind := params.Get(KindParam)
+	name := params.Get(NameParam)
+	if namespace == "" {
+		return []byte{}, fmt.Errorf("ContainerRestarts needs %v", NamespaceParam)
+	}
+	if kind == AllKinds {
+		kind = ""
+	}
+	if kind != "" && (name == "" || namespace == AllNamespaces) {
+		return []byte{}, fmt.Errorf("ContainerRestarts needs %v and a single %v with %v", NameParam, NamespaceParam, KindParam)
+	}
+
+	restarts := []Restart{}
+	err := t.Db().View(func(txn badgerwrap.Txn) error {
+		// Nil means every pod in the namespace
+		var pods map[string]bool
+		if kind != "" {
+			var err2 error
+			pods, err2 = getWorkloadPods(t, txn, kind, namespace, name, startTime, endTime, requestId)
+			if err2 != nil {
+				return err2
+			}
+		}
+
+		keyPredFn := func(key string) bool {
+			k := &typed.RestartKey{}
+			if k.Parse(key) != nil {
+				return false
+			}
+			if namespace != AllNamespaces && k.Namespace != namespace {
+				return false
+			}
+			return pods == nil || pods[k.Uid]
+		}
+		restartRecords, stats, err2 := t.RestartTable().RangeRead(txn, nil, keyPredFn, nil, startTime, endTime)
+		if err2 != nil {
+			return err2
+		}
+		stats.Log(requestId)
+
+		for key, val := range restartRecords {
+			for _, restart := range val.Restarts {
+				if restart.Timestamp < startTime.Unix() || restart.Timestamp > endTime.Unix() {
+					continue
+				}
+				restarts = append(restarts, toRestart(&key, restart))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return []byte{}, err
+	}
+	sortRestarts(restarts)
+
+	bytes, err := json.MarshalIndent(ContainerRestartsData{Restarts: restarts}, "", " ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal json %v", err)
+	}
+	return bytes, nil
+}
+
+// Uids of the pods the workload owned at any time in the range, including pods of every uid the workload had
+func getWorkloadPods(t typed.Tables, txn badgerwrap.Txn, kind string, namespace string, name string, startTime time.Time, endTime time.Time, requestId string) (map[string]bool, error) {
+	keyPredFn := func(key string) bool {
+		k := &typed.ResourceSummaryKey{}
+		return k.Parse(key) == nil && k.Namespace == namespace
+	}
+	resSummaries, stats, err := t.ResourceSummaryTable().RangeRead(txn, nil, keyPredFn, isResSummaryValInTimeRange(startTime, endTime), startTime, endTime)
+	if err != nil {
+		return nil, err
+	}
+	stats.Log(requestId)
+	index, err := newGraphIndex(resSummaries)
+	if err != nil {
+		return nil, err
+	}
+
+	pods := map[string]bool{}
+	queue := append([]string{}, index.byName[nameKey(kind, namespace, name)]...)
+	visited := map[string]bool{}
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		if visited[id] {
+			continue
+		}
+		visited[id] = true
+		node := index.nodes[id]
+		if node.Kind == kubeextractor.PodKind {
+			pods[node.Uid] = true
+			continue
+		}
+		for _, edge := range index.in[id] {
+			if edge.Type == kubeextractor.RelationshipOwner {
+				queue = append(queue, edge.From)
+			}
+		}
+	}
+	return pods, nil
+}
+
+func toRestart(key *typed.RestartKey, restart *typed.ContainerRestart) Restart {
+	return Restart{
+		Timestamp:     restart.Timestamp,
+		Namespace:     key.Namespace,
+		Pod:           key.Name,
+		Uid:           key.Uid,
+		Container:     key.Container,
+		InitContainer: restart.InitContainer,
+		Restarted:     restart.Restarted,
+		RestartCount:  restart.RestartCount,
+		Reason:        restart.Reason,
+		ExitCode:      restart.ExitCode,
+		Signal:        restart.Signal,
+		Message:       restart.Message,
+		StartedAt:     restart.StartedAt,
+		FinishedAt:    restart.FinishedAt,
+		ContainerId:   restart.ContainerId,
+	}
+}
+
+func sortRestarts(restarts []Restart) {
+	sort.Slice(restarts, func(i, j int) bool {
+		if restarts[i].Timestamp != restarts[j].Timestamp {
+			return restarts[i].Timestamp < restarts[j].Timestamp
+		}
+		if restarts[i].Pod != restarts[j].Pod {
+			return restarts[i].Pod < restarts[j].Pod
+		}
+		return restarts[i].Container < restarts[j].Container
+	})
+}
@@ -0,0 +1,91 @@
+/*
+ * Copyright (c) 2021, salesforce.com, inc.
+ * All rights reserved.
+ * SPDX-License-Identifier: BSD-3-Clause
+ * For full license text, see LICENSE.txt file in the repo root or https://opensource.org/licenses/BSD-3-Clause
+ */
+
+package queries
+
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/salesforce/sloop/pkg/sloop/store/typed"
+	"github.com/salesforce/sloop/pkg/sloop/store/untyped"
+	"github.com/salesforce/sloop/pkg/sloop/store/untyped/badgerwrap"
+	"github.com/stretchr/testify/assert"
+)
+
+// Restarts of the pods from helper_get_graphResources, one of them long before the time range
+func helper_get_restartTables(t *testing.T) typed.Tables {
+	tables := helper_get_graphTables(t, helper_get_graphResources())
+	partitionId := untyped.GetPartitionId(someGraphTs)
+	restarts := map[*typed.RestartKey][]*typed.ContainerRestart{
+		typed.NewRestartKey(partitionId, "ns", "web-1-a", "uid-web-1-a", "app"): {
+			{Timestamp: someGraphTs.Add(-2 * time.Hour).Unix(), Restarted: true, RestartCount: 1, Reason: "Error", ExitCode: 1},
+			{Timestamp: someGraphTs.Unix(), Restarted: true, RestartCount: 2, Reason: "OOMKilled", ExitCode: 137},
+		},
+		typed.NewRestartKey(partitionId, "ns", "other", "uid-other", "init"): {
+			{Timestamp: someGraphTs.Add(-time.Minute).Unix(), InitContainer: true, Reason: "Error", ExitCode: 2},
+		},
+		typed.NewRestartKey(partitionId, "otherns", "web-1-a", "uid-otherns", "app"): {
+			{Timestamp: someGraphTs.Unix(), Restarted: true, RestartCount: 1, Reason: "Error", ExitCode: 1},
+		},
+	}
+	err := tables.Db().Update(func(txn badgerwrap.Txn) error {
+		for key, val := range restarts {
+			txerr := tables.RestartTable().Set(txn, key.String(), &typed.ContainerRestarts{Restarts: val})
+			if txerr != nil {
+				return txerr
+			}
+		}
+		return nil
+	})
+	assert.Nil(t, err)
+	return tables
+}
+
+func helper_runContainerRestarts(t *testing.T, tables typed.Tables, params url.Values) []Restart {
+	res, err := ContainerRestarts(params, tables, someGraphTs.Add(-1*time.Hour), someGraphTs.Add(time.Hour), someRequestId)
+	assert.Nil(t, err)
+	output := ContainerRestartsData{}
+	assert.Nil(t, json.Unmarshal(res, &output))
+	return output.Restarts
+}
+
+func Test_ContainerRestarts_Namespace(t *testing.T) {
+	untyped.TestHookSetPartitionDuration(time.Hour)
+	tables := helper_get_restartTables(t)
+
+	restarts := helper_runContainerRestarts(t, tables, url.Values{NamespaceParam: []string{"ns"}})
+	assert.Equal(t, []Restart{
+		{Timestamp: someGraphTs.Add(-time.Minute).Unix(), Namespace: "ns", Pod: "other", Uid: "uid-other", Container: "init", InitContainer: true, Reason: "Error", ExitCode: 2},
+		{Timestamp: someGraphTs.Unix(), Namespace: "ns", Pod: "web-1-a", Uid: "uid-web-1-a", Container: "app", Restarted: true, RestartCount: 2, Reason: "OOMKilled", ExitCode: 137},
+	}, restarts)
+
+	assert.Len(t, helper_runContainerRestarts(t, tables, url.Values{NamespaceParam: []string{AllNamespaces}}), 3)
+}
+
+func Test_ContainerRestarts_Workload(t *testing.T) {
+	untyped.TestHookSetPartitionDuration(time.Hour)
+	tables := helper_get_restartTables(t)
+
+	// The deployment owns the pods through its replica set
+	restarts := helper_runContainerRestarts(t, tables, url.Values{NamespaceParam: []string{"ns"}, KindParam: []string{"Deployment"}, NameParam: []string{"web"}})
+	if assert.Len(t, restarts, 1) {
+		assert.Equal(t, "web-1-a", restarts[0].Pod)
+	}
+	restarts = helper_runContainerRestarts(t, tables, url.Values{NamespaceParam: []string{"ns"}, KindParam: []string{"Pod"}, NameParam: []string{"other"}})
+	if assert.Len(t, restarts, 1) {
+		assert.Equal(t, "init", restarts[0].Container)
+	}
+	assert.Len(t, helper_runContainerRestarts(t, tables, url.Values{NamespaceParam: []string{"ns"}, KindParam: []string{"Deployment"}, NameParam: []string{"gone"}}), 0)
+
+	_, err := ContainerRestarts(url.Values{NamespaceParam: []string{"ns"}, KindParam: []string{"Deployment"}}, tables, someGraphTs.Add(-1*time.Hour), someGraphTs.Add(time.Hour), someRequestId)
+	assert.NotNil(t, err)
+	_, err = ContainerRestarts(url.Values{}, tables, someGraphTs.Add(-1*time.Hour), someGraphTs.Add(time.Hour), someRequestId)
+	assert.NotNil(t, err)
+}
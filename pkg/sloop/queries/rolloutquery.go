@@ -0,0 +1,131 @@
+/*
+ * Copyright (c) 2021, salesforce.com, inc.
+ * All rights reserved.
+ * SPDX-License-Identifier: BSD-3-Clause
+ * For full license text, see LICENSE.txt file in the repo root or https://opensource.org/licenses/BSD-3-Clause
+ */
+
+package queries
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"time"
+
+	"github.com/salesforce/sloop/pkg/sloop/store/typed"
+	"github.com/salesforce/sloop/pkg/sloop/store/untyped/badgerwrap"
+)
+
+type RolloutsData struct {
+	Rollouts []Rollout `json:"rollouts"`
+}
+
+type rolloutId struct {
+	uid   string
+	start int64
+}
+
+// Rollouts lists the rollouts of workloads that were going on at any time in the range, oldest first.  It takes a
+// namespace, or all of them, and optionally a kind and a name
+func Rollouts(params url.Values, t typed.Tables, startTime time.Time, endTime time.Time, requestId string) ([]byte, error) {
+	namespace := params.Get(NamespaceParam)
+	kind := params.Get(KindParam)
+	name := params.Get(NameParam)
+	if namespace == "" {
+		return []byte{}, fmt.Errorf("Rollouts needs %v", NamespaceParam)
+	}
+	if kind == AllKinds {
+		kind = ""
+	}
+
+	// A rollout is copied into every partition it changed in, and the copy updated last is the one to go by
+	latest := map[rolloutId]Rollout{}
+	lastUpdate := map[rolloutId]int64{}
+	err := t.Db().View(func(txn badgerwrap.Txn) error {
+		keyPredFn := func(key string) bool {
+			k := &typed.RolloutKey{}
+			if k.Parse(key) != nil {
+				return false
+			}
+			return (namespace == AllNamespaces || k.Namespace == namespace) && (kind == "" || k.Kind == kind) && (name == "" || k.Name == name)
+		}
+		rolloutRecords, stats, err2 := t.RolloutTable().RangeRead(txn, nil, keyPredFn, nil, startTime, endTime)
+		if err2 != nil {
+			return err2
+		}
+		stats.Log(requestId)
+
+		for key, val := range rolloutRecords {
+			for _, rollout := range val.Rollouts {
+				id := rolloutId{uid: key.Uid, start: rollout.Start}
+				if prev, ok := lastUpdate[id]; ok && prev >= rollout.LastUpdate {
+					continue
+				}
+				lastUpdate[id] = rollout.LastUpdate
+				latest[id] = toRollout(&key, rollout)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return []byte{}, err
+	}
+
+	rollouts := []Rollout{}
+	for _, rollout := range latest {
+		if rollout.Start > endTime.Unix() || rollout.Start+rollout.Duration < startTime.Unix() {
+			continue
+		}
+		rollouts = append(rollouts, rollout)
+	}
+	sort.Slice(rollouts, func(i, j int) bool {
+		if rollouts[i].Start != rollouts[j].Start {
+			return rollouts[i].Start < rollouts[j].Start
+		}
+		return rollouts[i].Name < rollouts[j].Name
+	})
+
+	bytes, err := json.MarshalIndent(RolloutsData{Rollouts: rollouts}, "", " ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal json %v", err)
+	}
+	return bytes, nil
+}
+
+func toRollout(key *typed.RolloutKey, rollout *typed.Rollout) Rollout {
+	ended := rollout.End
+	if ended == 0 {
+		ended = rollout.LastUpdate
+	}
+	return Rollout{
+		Kind:            key.Kind,
+		Namespace:       key.Namespace,
+		Name:            key.Name,
+		Uid:             key.Uid,
+		Start:           rollout.Start,
+		End:             rollout.End,
+		Duration:        ended - rollout.Start,
+		Outcome:         rollout.Outcome,
+		Message:         rollout.Message,
+		OldGeneration:   rollout.OldGeneration,
+		NewGeneration:   rollout.NewGeneration,
+		OldRevision:     rollout.OldRevision,
+		NewRevision:     rollout.NewRevision,
+		OldTemplateHash: rollout.OldTemplateHash,
+		NewTemplateHash: rollout.NewTemplateHash,
+		OldImages:       nonNilStrings(rollout.OldImages),
+		NewImages:       nonNilStrings(rollout.NewImages),
+		OldReplicaSet:   rollout.OldReplicaSet,
+		NewReplicaSet:   rollout.NewReplicaSet,
+	}
+}
+
+// So the json has empty lists rather than nulls
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
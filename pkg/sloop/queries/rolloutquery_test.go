@@ -0,0 +1,92 @@
+/*
+ * Copyright (c) 2021, salesforce.com, inc.
+ * All rights reserved.
+ * SPDX-License-Identifier: BSD-3-Clause
+ * For full license text, see LICENSE.txt file in the repo root or https://opensource.org/licenses/BSD-3-Clause
+ */
+
+package queries
+
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/dgraph-io/badger/v2"
+	"github.com/salesforce/sloop/pkg/sloop/store/typed"
+	"github.com/salesforce/sloop/pkg/sloop/store/untyped"
+	"github.com/salesforce/sloop/pkg/sloop/store/untyped/badgerwrap"
+	"github.com/stretchr/testify/assert"
+)
+
+var someRolloutTs = time.Date(2019, 3, 1, 3, 4, 0, 0, time.UTC)
+
+func helper_get_rolloutTables(t *testing.T) typed.Tables {
+	db, err := (&badgerwrap.MockFactory{}).Open(badger.DefaultOptions(""))
+	assert.Nil(t, err)
+	tables := typed.NewTableList(db)
+
+	start := someRolloutTs.Unix()
+	firstPartition := untyped.GetPartitionId(someRolloutTs)
+	secondPartition := untyped.GetPartitionId(someRolloutTs.Add(time.Hour))
+	rollouts := map[*typed.RolloutKey][]*typed.Rollout{
+		// Still progressing at the end of the first partition, and complete in the second
+		typed.NewRolloutKey(firstPartition, "Deployment", "ns", "web", "uid-web"): {
+			{Start: start - 7200, End: start - 7000, LastUpdate: start - 7000, Outcome: "complete", NewRevision: "1"},
+			{Start: start, LastUpdate: start + 60, Outcome: "progressing", OldRevision: "1", NewRevision: "2", NewImages: []string{"app=web:2"}},
+		},
+		typed.NewRolloutKey(secondPartition, "Deployment", "ns", "web", "uid-web"): {
+			{Start: start, End: start + 3600, LastUpdate: start + 3600, Outcome: "complete", OldRevision: "1", NewRevision: "2", NewImages: []string{"app=web:2"}},
+		},
+		typed.NewRolloutKey(firstPartition, "StatefulSet", "ns", "db", "uid-db"): {
+			{Start: start + 30, LastUpdate: start + 90, Outcome: "progressing", NewRevision: "db-7d4b"},
+		},
+		typed.NewRolloutKey(firstPartition, "DaemonSet", "otherns", "agent", "uid-agent"): {
+			{Start: start + 10, End: start + 20, LastUpdate: start + 20, Outcome: "complete"},
+		},
+	}
+	err = tables.Db().Update(func(txn badgerwrap.Txn) error {
+		for key, val := range rollouts {
+			txerr := tables.RolloutTable().Set(txn, key.String(), &typed.WorkloadRollouts{Rollouts: val})
+			if txerr != nil {
+				return txerr
+			}
+		}
+		return nil
+	})
+	assert.Nil(t, err)
+	return tables
+}
+
+func helper_runRollouts(t *testing.T, tables typed.Tables, params url.Values) []Rollout {
+	res, err := Rollouts(params, tables, someRolloutTs.Add(-time.Hour), someRolloutTs.Add(2*time.Hour), someRequestId)
+	assert.Nil(t, err)
+	output := RolloutsData{}
+	assert.Nil(t, json.Unmarshal(res, &output))
+	return output.Rollouts
+}
+
+func Test_Rollouts_Namespace(t *testing.T) {
+	untyped.TestHookSetPartitionDuration(time.Hour)
+	tables := helper_get_rolloutTables(t)
+	start := someRolloutTs.Unix()
+
+	// The rollout that ended before the range is left out, and the one in both partitions is listed once
+	rollouts := helper_runRollouts(t, tables, url.Values{NamespaceParam: []string{"ns"}})
+	assert.Equal(t, []Rollout{
+		{Kind: "Deployment", Namespace: "ns", Name: "web", Uid: "uid-web", Start: start, End: start + 3600, Duration: 3600, Outcome: "complete",
+			OldRevision: "1", NewRevision: "2", OldImages: []string{}, NewImages: []string{"app=web:2"}},
+		{Kind: "StatefulSet", Namespace: "ns", Name: "db", Uid: "uid-db", Start: start + 30, Duration: 60, Outcome: "progressing",
+			NewRevision: "db-7d4b", OldImages: []string{}, NewImages: []string{}},
+	}, rollouts)
+
+	assert.Len(t, helper_runRollouts(t, tables, url.Values{NamespaceParam: []string{AllNamespaces}}), 3)
+	statefulSets := helper_runRollouts(t, tables, url.Values{NamespaceParam: []string{AllNamespaces}, KindParam: []string{"StatefulSet"}})
+	if assert.Len(t, statefulSets, 1) {
+		assert.Equal(t, "db", statefulSets[0].Name)
+	}
+
+	_, err := Rollouts(url.Values{}, tables, someRolloutTs, someRolloutTs, someRequestId)
+	assert.NotNil(t, err)
+}
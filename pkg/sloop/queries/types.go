@@ -18,6 +18,8 @@ type TimelineRow struct {
 	Kind       string    `json:"kind"`
 	Namespace  string    `json:"namespace"`
 	Overlays   []Overlay `json:"overlays"`
+	Segments   []Segment `json:"segments"`
+	Restarts   []Restart `json:"restarts"`
 	ChangedAt  []int64   `json:"changedat"`
 	NoChangeAt []int64   `json:"nochangeat"`
 	StartDate  int64     `json:"start_date"`
@@ -34,3 +36,57 @@ type Overlay struct {
 	Duration  int64  `json:"duration"`
 	EndDate   int64  `json:"end_date"`
 }
+
+// Segment is a span of the row where the resource was in one health state, like Pending or Running for a pod
+type Segment struct {
+	State     string `json:"state"`
+	Severity  string `json:"severity"`
+	Message   string `json:"message"`
+	StartDate int64  `json:"start_date"`
+	Duration  int64  `json:"duration"`
+	EndDate   int64  `json:"end_date"`
+}
+
+// Restart is a container of a pod that restarted, or terminated for good.  Times are unix seconds, and the ones the
+// container started and finished at are 0 when the kubelet did not say
+type Restart struct {
+	Timestamp     int64  `json:"timestamp"`
+	Namespace     string `json:"namespace"`
+	Pod           string `json:"pod"`
+	Uid           string `json:"uid"`
+	Container     string `json:"container"`
+	InitContainer bool   `json:"init_container"`
+	Restarted     bool   `json:"restarted"`
+	RestartCount  int32  `json:"restart_count"`
+	Reason        string `json:"reason"`
+	ExitCode      int32  `json:"exit_code"`
+	Signal        int32  `json:"signal"`
+	Message       string `json:"message"`
+	StartedAt     int64  `json:"started_at"`
+	FinishedAt    int64  `json:"finished_at"`
+	ContainerId   string `json:"container_id"`
+}
+
+// Rollout is a change of the pod template of a deployment, stateful set or daemon set, and how it went.  Times are unix
+// seconds, and a rollout that has not ended lasts up to the last update of its workload
+type Rollout struct {
+	Kind            string   `json:"kind"`
+	Namespace       string   `json:"namespace"`
+	Name            string   `json:"name"`
+	Uid             string   `json:"uid"`
+	Start           int64    `json:"start"`
+	End             int64    `json:"end"`
+	Duration        int64    `json:"duration"`
+	Outcome         string   `json:"outcome"`
+	Message         string   `json:"message"`
+	OldGeneration   int64    `json:"old_generation"`
+	NewGeneration   int64    `json:"new_generation"`
+	OldRevision     string   `json:"old_revision"`
+	NewRevision     string   `json:"new_revision"`
+	OldTemplateHash string   `json:"old_template_hash"`
+	NewTemplateHash string   `json:"new_template_hash"`
+	OldImages       []string `json:"old_images"`
+	NewImages       []string `json:"new_images"`
+	OldReplicaSet   string   `json:"old_replica_set"`
+	NewReplicaSet   string   `json:"new_replica_set"`
+}
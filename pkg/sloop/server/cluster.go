@@ -0,0 +1,405 @@
+/*
+ * Copyright (c) 2021, salesforce.com, inc.
+ * All rights reserved.
+ * SPDX-License-Identifier: BSD-3-Clause
+ * For full license text, see LICENSE.txt file in the repo root or https://opensource.org/licenses/BSD-3-Clause
+ */
+
+package server
+
+import (
+	"io/ioutil"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"github.com/spf13/afero"
+
+	"github.com/salesforce/sloop/pkg/sloop/ingress"
+	"github.com/salesforce/sloop/pkg/sloop/processing"
+	"github.com/salesforce/sloop/pkg/sloop/server/internal/config"
+	"github.com/salesforce/sloop/pkg/sloop/store/typed"
+	"github.com/salesforce/sloop/pkg/sloop/store/untyped"
+	"github.com/salesforce/sloop/pkg/sloop/store/untyped/badgerwrap"
+	"github.com/salesforce/sloop/pkg/sloop/storemanager"
+)
+
+// Everything we run for one kubernetes cluster: ingress, a store, a processor and a store manager
+type cluster struct {
+	kubeContext    string
+	displayContext string
+	db             badgerwrap.DB
+	tables         typed.Tables
+	ingressChan    chan typed.KubeWatchResult
+	processor      *processing.Runner
+	kubeWatcher    ingress.KubeWatcher
+	// Leader election starts and stops the kube watcher while everything else keeps running
+	kubeWatcherLock *sync.Mutex
+	kubeWatcherDone bool
+	playbackWg      *sync.WaitGroup
+	auditSource     *ingress.AuditSource
+	stopPlayback    chan struct{}
+	recorder        *ingress.FileRecorder
+	pushReceiver    *ingress.PushReceiver
+	forwarder       *ingress.Forwarder
+	storemgr        *storemanager.StoreManager
+}
+
+// The kube context is resolved up front so we can check urls and store directories are unique before starting
+// anything.  A single cluster without a clusters section keeps the old layout: its store is named after the kube
+// context and the store manager looks at all of StoreRoot
+func resolveCluster(conf *config.SloopConfig, clusterConf config.ClusterConfig) (*cluster, error) {
+	kubeContext, err := ingress.GetKubernetesContext(clusterConf.ApiServerHost, clusterConf.KubeConfig, clusterConf.Context, conf.PrivilegedAccess)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get kubernetes context")
+	}
+	c := &cluster{kubeContext: kubeContext, displayContext: kubeContext, kubeWatcherLock: &sync.Mutex{}}
+	if clusterConf.DisplayContext != "" {
+		c.displayContext = clusterConf.DisplayContext
+	}
+	return c, nil
+}
+
+func (c *cluster) storeName(conf *config.SloopConfig) string {
+	if len(conf.Clusters) == 0 {
+		return c.kubeContext
+	}
+	return c.displayContext
+}
+
+func (c *cluster) start(conf *config.SloopConfig, clusterConf config.ClusterConfig, diskBudgetMb int, pruner *ingress.Pruner, redactor *ingress.Redactor, minorUpdates *ingress.MinorUpdates, pushSources *ingress.PushSources) (err error) {
+	// Whatever did start has to be stopped again, or the store would stay locked
+	defer func() {
+		if err != nil {
+			c.shutdown()
+		}
+	}()
+
+	factory := &badgerwrap.BadgerFactory{}
+
+	storeRootWithKubeContext := path.Join(conf.StoreRoot, c.storeName(conf))
+	storeConfig := &untyped.Config{
+		RootPath:                 storeRootWithKubeContext,
+		ConfigPartitionDuration:  time.Duration(1) * time.Hour,
+		BadgerMaxTableSize:       conf.BadgerMaxTableSize,
+		BadgerKeepL0InMemory:     conf.BadgerKeepL0InMemory,
+		BadgerVLogFileSize:       conf.BadgerVLogFileSize,
+		BadgerVLogMaxEntries:     conf.BadgerVLogMaxEntries,
+		BadgerUseLSMOnlyOptions:  conf.BadgerUseLSMOnlyOptions,
+		BadgerEnableEventLogging: conf.BadgerEnableEventLogging,
+		BadgerNumOfCompactors:    conf.BadgerNumOfCompactors,
+		BadgerNumL0Tables:        conf.BadgerNumL0Tables,
+		BadgerNumL0TablesStall:   conf.BadgerNumL0TablesStall,
+		BadgerSyncWrites:         conf.BadgerSyncWrites,
+		BadgerLevelOneSize:       conf.BadgerLevelOneSize,
+		BadgerLevSizeMultiplier:  conf.BadgerLevSizeMultiplier,
+		BadgerVLogFileIOMapping:  conf.BadgerVLogFileIOMapping,
+		BadgerVLogTruncate:       conf.BadgerVLogTruncate,
+		BadgerDetailLogEnabled:   conf.BadgerDetailLogEnabled,
+	}
+	c.db, err = untyped.OpenStore(factory, storeConfig)
+	if err != nil {
+		return errors.Wrap(err, "failed to init untyped store")
+	}
+
+	if conf.RestoreDatabaseFile != "" {
+		glog.Infof("Restoring from backup file %q into context %q", conf.RestoreDatabaseFile, c.kubeContext)
+		err := ingress.DatabaseRestore(c.db, conf.RestoreDatabaseFile, redactor)
+		if err != nil {
+			return errors.Wrap(err, "failed to restore database")
+		}
+		glog.Infof("Restored from backup file %q into context %q", conf.RestoreDatabaseFile, c.kubeContext)
+	}
+
+	// Channel used for updates from ingress to store
+	// The channel is owned by this cluster, and no external code should close this!
+	// The exceptions are the ingest queue, payload stage and record stage, which close their output once their input
+	// is closed
+	kubeWatchChan := make(chan typed.KubeWatchResult, 1000)
+	ingressOutChan := kubeWatchChan
+	if conf.DebugRecordFile != "" {
+		recorderConfig := ingress.FileRecorderConfig{
+			Filename:        conf.DebugRecordFile,
+			Format:          conf.DebugRecordFormat,
+			RotateSizeBytes: int64(conf.DebugRecordRotateMb) * 1024 * 1024,
+			RotateInterval:  conf.DebugRecordRotateAge,
+			Gzip:            conf.DebugRecordGzip,
+		}
+		recordChan := make(chan typed.KubeWatchResult, 1000)
+		c.recorder, err = ingress.NewFileRecorder(recorderConfig, recordChan)
+		if err != nil {
+			return errors.Wrap(err, "failed to create file recorder")
+		}
+		c.recorder.Start()
+		// The recorder gets its own copy of everything that goes to processing, after pruning and redaction
+		ingressOutChan = make(chan typed.KubeWatchResult, 1000)
+		go ingress.RunRecordStage(ingressOutChan, kubeWatchChan, recordChan)
+	}
+	err = c.startIngress(conf, ingressOutChan, pruner, redactor)
+	if err != nil {
+		return err
+	}
+
+	c.tables = typed.NewTableList(c.db)
+	processingConfig := processing.Config{
+		MinorUpdates:    minorUpdates,
+		MaxLookback:     conf.MaxLookback,
+		MaxBatchRecords: conf.ProcessingBatchRecords,
+		MaxBatchLatency: conf.ProcessingBatchLatency,
+		Workers:         conf.ProcessingWorkers,
+		PayloadPatches:  conf.PayloadPatches,
+	}
+	c.processor = processing.NewProcessing(processingConfig, kubeWatchChan, c.tables)
+	c.processor.Start()
+
+	// Remote agents pushing to the webserver.  The handler is served once every cluster has started
+	if pushSources != nil {
+		c.pushReceiver = ingress.NewPushReceiver(pushSources, c.displayContext, c.ingressChan)
+	}
+
+	err = c.startSources(conf, clusterConf)
+	if err != nil {
+		return err
+	}
+
+	if !conf.DisableStoreManager {
+		fs := &afero.Afero{Fs: afero.NewOsFs()}
+		// With several clusters each store manager only looks at its own store and keeps it within its share
+		storeManagerRoot := conf.StoreRoot
+		if len(conf.Clusters) > 0 {
+			storeManagerRoot = storeRootWithKubeContext
+		}
+		storeCfg := &storemanager.Config{
+			StoreRoot:          storeManagerRoot,
+			Freq:               conf.CleanupFrequency,
+			TimeLimit:          conf.MaxLookback,
+			SizeLimitBytes:     diskBudgetMb * 1024 * 1024,
+			BadgerDiscardRatio: conf.BadgerDiscardRatio,
+			BadgerVLogGCFreq:   conf.BadgerVLogGCFreq,
+			DeletionBatchSize:  conf.DeletionBatchSize,
+			GCThreshold:        conf.ThresholdForGC,
+			EnableDeleteKeys:   conf.EnableDeleteKeys,
+		}
+		c.storemgr = storemanager.NewStoreManager(c.tables, storeCfg, fs)
+		c.storemgr.Start()
+	}
+	return nil
+}
+
+// An agent watches the cluster and forwards what it sees to a central sloop.  It has no store, and the ingest queue
+// holds on to watch results while the central sloop can not be reached
+func (c *cluster) startAgent(conf *config.SloopConfig, clusterConf config.ClusterConfig, pruner *ingress.Pruner, redactor *ingress.Redactor) (err error) {
+	defer func() {
+		if err != nil {
+			c.shutdown()
+		}
+	}()
+
+	forwarderConfig := ingress.ForwarderConfig{
+		URL:           conf.ForwardUrl,
+		BatchRecords:  conf.ForwardBatchRecords,
+		BatchInterval: conf.ForwardBatchInterval,
+	}
+	if conf.ForwardTokenFile != "" {
+		token, err := ioutil.ReadFile(conf.ForwardTokenFile)
+		if err != nil {
+			return errors.Wrap(err, "failed to read forward token")
+		}
+		forwarderConfig.Token = strings.TrimSpace(string(token))
+	}
+	forwardChan := make(chan typed.KubeWatchResult, 1000)
+	forwarder, err := ingress.NewForwarder(forwarderConfig, forwardChan)
+	if err != nil {
+		return errors.Wrap(err, "failed to create forwarder")
+	}
+	err = c.startIngress(conf, forwardChan, pruner, redactor)
+	if err != nil {
+		return err
+	}
+	c.forwarder = forwarder
+	c.forwarder.Start()
+	return c.startSources(conf, clusterConf)
+}
+
+// Builds the chain from ingressChan, where every source writes, to outChan.  Each stage closes its output when its
+// input is closed, so closing ingressChan flushes everything through to outChan and then closes it
+func (c *cluster) startIngress(conf *config.SloopConfig, outChan chan typed.KubeWatchResult, pruner *ingress.Pruner, redactor *ingress.Redactor) error {
+	// Without a queue, pruning or redaction it is the same channel as outChan
+	c.ingressChan = outChan
+	if pruner != nil || redactor != nil {
+		payloadChan := make(chan typed.KubeWatchResult, 1000)
+		go ingress.RunPayloadStage(pruner, redactor, payloadChan, c.ingressChan)
+		c.ingressChan = payloadChan
+	}
+	// The queue goes in front of everything else so a slow store or payload stage never blocks the kube watchers
+	if conf.IngestQueueRecords > 0 {
+		queueChan := make(chan typed.KubeWatchResult, 1000)
+		queueConfig := ingress.SpillQueueConfig{
+			Cluster:       c.displayContext,
+			Dir:           conf.IngestSpillDir,
+			MemoryRecords: conf.IngestQueueRecords,
+			MaxSpillBytes: int64(conf.IngestSpillMaxMb) * 1024 * 1024,
+		}
+		if conf.IngestDropKinds != "" {
+			queueConfig.DropUpdateKinds = strings.Split(conf.IngestDropKinds, ",")
+		}
+		queue, err := ingress.NewSpillQueue(queueConfig, queueChan, c.ingressChan)
+		if err != nil {
+			close(c.ingressChan)
+			c.ingressChan = nil
+			return errors.Wrap(err, "failed to create ingest queue")
+		}
+		queue.Start()
+		c.ingressChan = queueChan
+	}
+	return nil
+}
+
+// Starts the kube watcher, snapshot import, file playback and audit logs, which write to ingressChan
+func (c *cluster) startSources(conf *config.SloopConfig, clusterConf config.ClusterConfig) error {
+	// Real kubernetes watcher.  With leader election only the leader runs it, and the elector starts it
+	if !conf.DisableKubeWatcher && !conf.LeaderElection {
+		err := c.startKubeWatcher(conf, clusterConf)
+		if err != nil {
+			return err
+		}
+	}
+
+	if conf.AuditLogPath != "" {
+		auditConfig := ingress.AuditSourceConfig{Path: conf.AuditLogPath, Follow: conf.AuditLogFollow}
+		auditSource := ingress.NewAuditSource(auditConfig, c.ingressChan)
+		_, err := auditSource.Init()
+		if err != nil {
+			return errors.Wrap(err, "failed to read audit logs")
+		}
+		c.auditSource = auditSource
+	}
+
+	if conf.ImportSnapshotPath != "" {
+		importConfig := ingress.SnapshotImportConfig{Path: conf.ImportSnapshotPath}
+		if conf.ImportSnapshotTime != "" {
+			// Checked by Validate
+			importConfig.CaptureTime, _ = time.Parse(time.RFC3339, conf.ImportSnapshotTime)
+		}
+		err := ingress.ImportSnapshots(c.ingressChan, importConfig)
+		if err != nil {
+			return errors.Wrap(err, "failed to import snapshots")
+		}
+	}
+
+	// File playback
+	c.playbackWg = &sync.WaitGroup{}
+	c.stopPlayback = make(chan struct{})
+	if conf.DebugPlaybackFile != "" {
+		playbackConfig := ingress.PlaybackConfig{
+			Speed:       conf.DebugPlaybackSpeed,
+			RebaseToNow: conf.DebugPlaybackRebase,
+			Stop:        c.stopPlayback,
+		}
+		if conf.DebugPlaybackSpeed > 0 {
+			// Paced playback can take as long as the recording did, so dont hold up the webserver
+			c.playbackWg.Add(1)
+			go func() {
+				defer c.playbackWg.Done()
+				err := ingress.PlayFile(c.ingressChan, conf.DebugPlaybackFile, playbackConfig)
+				if err != nil {
+					glog.Errorf("Failed to play back file: %v", err)
+				}
+			}()
+		} else {
+			err := ingress.PlayFile(c.ingressChan, conf.DebugPlaybackFile, playbackConfig)
+			if err != nil {
+				return errors.Wrap(err, "failed to play back file")
+			}
+		}
+	}
+	return nil
+}
+
+// Does nothing when the watcher is already running or the cluster is shutting down
+func (c *cluster) startKubeWatcher(conf *config.SloopConfig, clusterConf config.ClusterConfig) error {
+	c.kubeWatcherLock.Lock()
+	defer c.kubeWatcherLock.Unlock()
+	if c.kubeWatcher != nil || c.kubeWatcherDone {
+		return nil
+	}
+	kubeClient, err := ingress.MakeKubernetesClient(clusterConf.ApiServerHost, clusterConf.KubeConfig, c.kubeContext, conf.PrivilegedAccess)
+	if err != nil {
+		return errors.Wrap(err, "failed to create kubernetes client")
+	}
+
+	watcherConfig := ingress.KubeWatcherConfig{
+		Resync:                conf.KubeWatchResyncInterval,
+		IncludeCrds:           conf.WatchCrds,
+		CrdRefreshInterval:    conf.CrdRefreshInterval,
+		MasterURL:             clusterConf.ApiServerHost,
+		KubeConfig:            clusterConf.KubeConfig,
+		KubeContext:           c.kubeContext,
+		EnableGranularMetrics: conf.EnableGranularMetrics,
+		Filter:                conf.WatchFilter,
+		BuiltinInformers:      conf.WatchKinds,
+		WatchAllResources:     conf.WatchAllResources,
+	}
+	c.kubeWatcher, err = ingress.NewKubeWatcherSource(watcherConfig, kubeClient, c.ingressChan)
+	if err != nil {
+		return errors.Wrap(err, "failed to initialize kubeWatcher")
+	}
+	return nil
+}
+
+// Safe to call when the watcher is not running.  Once done is set it will not be started again
+func (c *cluster) stopKubeWatcher(done bool) {
+	c.kubeWatcherLock.Lock()
+	defer c.kubeWatcherLock.Unlock()
+	if c.kubeWatcher != nil {
+		c.kubeWatcher.Stop()
+		c.kubeWatcher = nil
+	}
+	c.kubeWatcherDone = c.kubeWatcherDone || done
+}
+
+// Shutdown happens in the following order:
+// 1. Shut down ingress so that it stops emitting events
+// 2. Close the input channel which signals processing to finish work
+// 3. Wait on processor to tell us all work is complete.  Store will not change after that
+// It copes with a cluster that only partly started
+func (c *cluster) shutdown() {
+	c.stopKubeWatcher(true)
+	if c.stopPlayback != nil {
+		close(c.stopPlayback)
+		c.playbackWg.Wait()
+	}
+	if c.auditSource != nil {
+		c.auditSource.Stop()
+	}
+	if c.pushReceiver != nil {
+		c.pushReceiver.Stop()
+	}
+	if c.forwarder != nil {
+		c.forwarder.Stop()
+	}
+	if c.ingressChan != nil {
+		close(c.ingressChan)
+	}
+	if c.processor != nil {
+		c.processor.Wait()
+	}
+	if c.forwarder != nil {
+		c.forwarder.Wait()
+	}
+
+	if c.recorder != nil {
+		c.recorder.Close()
+	}
+
+	if c.storemgr != nil {
+		c.storemgr.Shutdown()
+	}
+
+	if c.db != nil {
+		untyped.CloseStore(c.db)
+	}
+}
@@ -20,17 +20,36 @@ import (
 	"github.com/golang/glog"
 	"github.com/pkg/errors"
 
+	"github.com/salesforce/sloop/pkg/sloop/ingress"
+	"github.com/salesforce/sloop/pkg/sloop/kubeextractor"
 	"github.com/salesforce/sloop/pkg/sloop/webserver"
 )
 
 const sloopConfigEnvVar = "SLOOP_CONFIG"
 
+// ClusterConfig is one cluster to watch when a single sloop serves several clusters.  Each gets its own store under
+// StoreRoot/<displayContext>, and is served under /<displayContext>
+type ClusterConfig struct {
+	Context        string `json:"context"`        // Empty uses the current context of the kubeconfig
+	KubeConfig     string `json:"kubeConfig"`     // Path to a kubeconfig file.  Empty uses the default loading rules
+	ApiServerHost  string `json:"apiServerHost"`  // Optional override of the server in the kubeconfig
+	DisplayContext string `json:"displayContext"` // Defaults to the context
+	MaxDiskMb      int    `json:"maxDiskMb"`      // Share of the top level MaxDiskMb.  0 = an equal split of what is left
+}
+
 type SloopConfig struct {
 	// These fields can only come from command line
 	ConfigFile string
 	// These fields can only come from file because they use complex types
 	LeftBarLinks  []webserver.LinkTemplate         `json:"leftBarLinks"`
 	ResourceLinks []webserver.ResourceLinkTemplate `json:"resourceLinks"`
+	WatchFilter   ingress.KubeWatchFilterConfig    `json:"watchFilter"`
+	WatchKinds    map[string]bool                  `json:"watchKinds"`   // Turn built-in informers on or off by kind
+	Redactions    []ingress.RedactionRule          `json:"redactions"`   // Set to an empty list to turn off redaction
+	Pruning       []ingress.PruningRule            `json:"pruning"`      // Set to an empty list to store payloads whole
+	MinorUpdates  []ingress.MinorUpdateRule        `json:"minorUpdates"` // Set to an empty list to store every update
+	Clusters      []ClusterConfig                  `json:"clusters"`     // Serve several clusters from one process
+	PushSources   []ingress.PushSource             `json:"pushSources"`  // Agents allowed to POST to /<context>/ingest
 	// Normal fields that can come from file or cmd line
 	DisableKubeWatcher       bool          `json:"disableKubeWatch"`
 	KubeWatchResyncInterval  time.Duration `json:"kubeWatchResyncInterval"`
@@ -41,12 +60,26 @@ type SloopConfig struct {
 	MaxLookback              time.Duration `json:"maxLookBack"`
 	MaxDiskMb                int           `json:"maxDiskMb"`
 	DebugPlaybackFile        string        `json:"debugPlaybackFile"`
+	DebugPlaybackSpeed       float64       `json:"debugPlaybackSpeed"`
+	DebugPlaybackRebase      bool          `json:"debugPlaybackRebase"`
+	ImportSnapshotPath       string        `json:"importSnapshotPath"`
+	ImportSnapshotTime       string        `json:"importSnapshotTime"`
+	AuditLogPath             string        `json:"auditLogPath"`
+	AuditLogFollow           bool          `json:"auditLogFollow"`
 	DebugRecordFile          string        `json:"debugRecordFile"`
+	DebugRecordFormat        string        `json:"debugRecordFormat"`
+	DebugRecordRotateMb      int           `json:"debugRecordRotateMb"`
+	DebugRecordRotateAge     time.Duration `json:"debugRecordRotateAge"`
+	DebugRecordGzip          bool          `json:"debugRecordGzip"`
 	DeletionBatchSize        int           `json:"deletionBatchSize"`
 	UseMockBadger            bool          `json:"mockBadger"`
 	DisableStoreManager      bool          `json:"disableStoreManager"`
 	CleanupFrequency         time.Duration `json:"cleanupFrequency" validate:"min=1h,max=120h"`
 	KeepMinorNodeUpdates     bool          `json:"keepMinorNodeUpdates"`
+	ProcessingBatchRecords   int           `json:"processingBatchRecords"`
+	ProcessingBatchLatency   time.Duration `json:"processingBatchLatency"`
+	ProcessingWorkers        int           `json:"processingWorkers"`
+	PayloadPatches           bool          `json:"payloadPatches"`
 	DefaultNamespace         string        `json:"defaultNamespace"`
 	DefaultKind              string        `json:"defaultKind"`
 	DefaultLookback          string        `json:"defaultLookback"`
@@ -55,6 +88,19 @@ type SloopConfig struct {
 	ApiServerHost            string        `json:"apiServerHost"`
 	WatchCrds                bool          `json:"watchCrds"`
 	CrdRefreshInterval       time.Duration `json:"crdRefreshInterval"`
+	WatchAllResources        bool          `json:"watchAllResources"`
+	IngestQueueRecords       int           `json:"ingestQueueRecords"`
+	IngestSpillDir           string        `json:"ingestSpillDir"`
+	IngestSpillMaxMb         int           `json:"ingestSpillMaxMb"`
+	IngestDropKinds          string        `json:"ingestDropKinds"`
+	ForwardUrl               string        `json:"forwardUrl"`
+	ForwardTokenFile         string        `json:"forwardTokenFile"`
+	ForwardBatchRecords      int           `json:"forwardBatchRecords"`
+	ForwardBatchInterval     time.Duration `json:"forwardBatchInterval"`
+	LeaderElection           bool          `json:"leaderElection"`
+	LeaderElectionNamespace  string        `json:"leaderElectionNamespace"`
+	LeaderElectionName       string        `json:"leaderElectionName"`
+	LeaderElectionId         string        `json:"leaderElectionId"`
 	ThresholdForGC           float64       `json:"threshold for GC"`
 	RestoreDatabaseFile      string        `json:"restoreDatabaseFile"`
 	BadgerDiscardRatio       float64       `json:"badgerDiscardRatio"`
@@ -91,11 +137,25 @@ func registerFlags(fs *flag.FlagSet, config *SloopConfig) {
 	fs.DurationVar(&config.MaxLookback, "max-look-back", config.MaxLookback, "Max history data to keep")
 	fs.IntVar(&config.MaxDiskMb, "max-disk-mb", config.MaxDiskMb, "Max disk storage in MB")
 	fs.StringVar(&config.DebugPlaybackFile, "playback-file", config.DebugPlaybackFile, "Read watch data from a playback file")
+	fs.Float64Var(&config.DebugPlaybackSpeed, "playback-speed", config.DebugPlaybackSpeed, "Replay the recorded gaps between events divided by this factor (1 = real time, 10 = 10x faster).  0 = as fast as possible")
+	fs.BoolVar(&config.DebugPlaybackRebase, "playback-rebase-to-now", config.DebugPlaybackRebase, "Shift playback timestamps so the recording ends at the current time")
+	fs.StringVar(&config.ImportSnapshotPath, "import-snapshot", config.ImportSnapshotPath, "Import objects from kubectl get -o yaml output, or a directory of yaml or json dumps like must-gather.  Files with different times become a timeline")
+	fs.StringVar(&config.ImportSnapshotTime, "import-snapshot-time", config.ImportSnapshotTime, "When the import-snapshot files were captured, in RFC3339.  Empty uses the modification time of each file")
+	fs.StringVar(&config.AuditLogPath, "audit-log-path", config.AuditLogPath, "Read watch data from kubernetes audit logs (json lines) in this file, directory or glob.  Needs the RequestResponse audit level")
+	fs.BoolVar(&config.AuditLogFollow, "audit-log-follow", config.AuditLogFollow, "Keep reading audit-log-path as logs grow and rotate, like tail -F")
 	fs.StringVar(&config.DebugRecordFile, "record-file", config.DebugRecordFile, "Record watch data to a playback file")
+	fs.StringVar(&config.DebugRecordFormat, "record-format", config.DebugRecordFormat, "Format for record-file: ndjson or protobuf (length-delimited)")
+	fs.IntVar(&config.DebugRecordRotateMb, "record-rotate-size-mb", config.DebugRecordRotateMb, "Start a new record file after this many MB.  0 = no size based rotation")
+	fs.DurationVar(&config.DebugRecordRotateAge, "record-rotate-interval", config.DebugRecordRotateAge, "Start a new record file after this much time.  0 = no time based rotation")
+	fs.BoolVar(&config.DebugRecordGzip, "record-gzip", config.DebugRecordGzip, "Gzip record files")
 	fs.BoolVar(&config.UseMockBadger, "use-mock-badger", config.UseMockBadger, "Use a fake in-memory mock of badger")
 	fs.BoolVar(&config.DisableStoreManager, "disable-store-manager", config.DisableStoreManager, "Turn off store manager which is to clean up database")
 	fs.DurationVar(&config.CleanupFrequency, "cleanup-frequency", config.CleanupFrequency, "Frequency between subsequent runs for the database cleanup")
-	fs.BoolVar(&config.KeepMinorNodeUpdates, "keep-minor-node-updates", config.KeepMinorNodeUpdates, "Keep all node updates even if change is only condition timestamps")
+	fs.BoolVar(&config.KeepMinorNodeUpdates, "keep-minor-node-updates", config.KeepMinorNodeUpdates, "Keep all node updates even if change is only condition timestamps.  Turns off the minorUpdates rules for Node")
+	fs.IntVar(&config.ProcessingBatchRecords, "processing-batch-records", config.ProcessingBatchRecords, "Most watch results stored in one database transaction")
+	fs.DurationVar(&config.ProcessingBatchLatency, "processing-batch-latency", config.ProcessingBatchLatency, "How long a watch result waits for others to share its database transaction.  0 = only take the ones already queued")
+	fs.IntVar(&config.ProcessingWorkers, "processing-workers", config.ProcessingWorkers, "Number of goroutines storing watch results.  Results for the same object always go to the same one")
+	fs.BoolVar(&config.PayloadPatches, "payload-patches", config.PayloadPatches, "Store watch results as json patches against a full copy of the object kept once per partition.  Much smaller for big objects like nodes, reads are a little slower")
 	fs.StringVar(&config.DefaultLookback, "default-lookback", config.DefaultLookback, "Default UX filter lookback")
 	fs.StringVar(&config.DefaultKind, "default-kind", config.DefaultKind, "Default UX filter kind")
 	fs.StringVar(&config.DefaultNamespace, "default-namespace", config.DefaultNamespace, "Default UX filter namespace")
@@ -105,6 +165,19 @@ func registerFlags(fs *flag.FlagSet, config *SloopConfig) {
 	fs.StringVar(&config.ApiServerHost, "apiserver-host", config.ApiServerHost, "Kubernetes API server endpoint")
 	fs.BoolVar(&config.WatchCrds, "watch-crds", config.WatchCrds, "Watch for activity for CRDs")
 	fs.DurationVar(&config.CrdRefreshInterval, "crd-refresh-interval", config.CrdRefreshInterval, "Frequency between CRD Informer refresh")
+	fs.BoolVar(&config.WatchAllResources, "watch-all-resources", config.WatchAllResources, "Use discovery to watch every listable resource, including CRDs and aggregated apis, at its preferred version.  Refreshed every crd-refresh-interval")
+	fs.IntVar(&config.IngestQueueRecords, "ingest-queue-records", config.IngestQueueRecords, "Watch results buffered in memory between ingress and processing before spilling to disk.  0 = no queue, ingress blocks when processing falls behind")
+	fs.StringVar(&config.IngestSpillDir, "ingest-spill-dir", config.IngestSpillDir, "Directory for ingest queue spill files.  Empty uses the system temp dir")
+	fs.IntVar(&config.IngestSpillMaxMb, "ingest-spill-max-mb", config.IngestSpillMaxMb, "Max MB of watch results spilled to disk.  Beyond this they are dropped.  0 = drop instead of spilling")
+	fs.StringVar(&config.IngestDropKinds, "ingest-drop-kinds", config.IngestDropKinds, "Comma separated kinds (globs or /regex/) whose updates are dropped first when the ingest queue is full, instead of being spilled")
+	fs.StringVar(&config.ForwardUrl, "forward-url", config.ForwardUrl, "Run as an agent that sends watch results to the ingest url of a central sloop, like https://sloop/mycluster/ingest, instead of storing them.  The ingest queue buffers them while it is down")
+	fs.StringVar(&config.ForwardTokenFile, "forward-token-file", config.ForwardTokenFile, "File with the bearer token for forward-url")
+	fs.IntVar(&config.ForwardBatchRecords, "forward-batch-records", config.ForwardBatchRecords, "Most watch results sent to forward-url in one request")
+	fs.DurationVar(&config.ForwardBatchInterval, "forward-batch-interval", config.ForwardBatchInterval, "How long a watch result waits for others to share its request to forward-url")
+	fs.BoolVar(&config.LeaderElection, "leader-election", config.LeaderElection, "Run several replicas against the same cluster.  Only the one holding a coordination.k8s.io Lease watches kubernetes, the others serve their own store and take over when it goes away")
+	fs.StringVar(&config.LeaderElectionNamespace, "leader-election-namespace", config.LeaderElectionNamespace, "Namespace of the leader election Lease.  Empty uses POD_NAMESPACE, then the namespace of the service account, then default")
+	fs.StringVar(&config.LeaderElectionName, "leader-election-name", config.LeaderElectionName, "Name of the leader election Lease.  Replicas with the same name compete with each other")
+	fs.StringVar(&config.LeaderElectionId, "leader-election-id", config.LeaderElectionId, "Identity of this replica in the Lease.  Empty uses the hostname, which is the pod name in kubernetes")
 	fs.StringVar(&config.RestoreDatabaseFile, "restore-database-file", config.RestoreDatabaseFile, "Restore database from backup file into current context.")
 	fs.Float64Var(&config.BadgerDiscardRatio, "badger-discard-ratio", config.BadgerDiscardRatio, "Badger value log GC uses this value to decide if it wants to compact a vlog file. The lower the value of discardRatio the higher the number of !badger!move keys. And thus more the number of !badger!move keys, the size on disk keeps on increasing over time.")
 	fs.Float64Var(&config.ThresholdForGC, "gc-threshold", config.ThresholdForGC, "Threshold for GC to start garbage collecting")
@@ -132,6 +205,9 @@ func registerFlags(fs *flag.FlagSet, config *SloopConfig) {
 func getDefaultConfig() *SloopConfig {
 	defaultConfig := SloopConfig{
 		ConfigFile:               "",
+		Redactions:               ingress.DefaultRedactionRules,
+		Pruning:                  ingress.DefaultPruningRules,
+		MinorUpdates:             ingress.DefaultMinorUpdateRules,
 		DisableKubeWatcher:       false,
 		KubeWatchResyncInterval:  30 * time.Minute,
 		WebFilesPath:             "./pkg/sloop/webserver/webfiles",
@@ -141,12 +217,25 @@ func getDefaultConfig() *SloopConfig {
 		MaxLookback:              time.Duration(14*24) * time.Hour,
 		MaxDiskMb:                32 * 1024,
 		DebugPlaybackFile:        "",
+		DebugPlaybackSpeed:       0,
+		DebugPlaybackRebase:      false,
+		ImportSnapshotPath:       "",
+		ImportSnapshotTime:       "",
+		AuditLogPath:             "",
+		AuditLogFollow:           false,
 		DebugRecordFile:          "",
+		DebugRecordFormat:        "ndjson",
+		DebugRecordRotateMb:      0,
+		DebugRecordRotateAge:     0,
+		DebugRecordGzip:          false,
 		DeletionBatchSize:        1000,
 		UseMockBadger:            false,
 		DisableStoreManager:      false,
 		CleanupFrequency:         time.Minute * 30,
 		KeepMinorNodeUpdates:     false,
+		ProcessingBatchRecords:   100,
+		ProcessingBatchLatency:   100 * time.Millisecond,
+		ProcessingWorkers:        4,
 		DefaultNamespace:         "default",
 		DefaultKind:              "_all",
 		DefaultLookback:          "1h",
@@ -155,6 +244,19 @@ func getDefaultConfig() *SloopConfig {
 		ApiServerHost:            "",
 		WatchCrds:                true,
 		CrdRefreshInterval:       time.Duration(5 * time.Minute),
+		WatchAllResources:        false,
+		IngestQueueRecords:       10000,
+		IngestSpillDir:           "",
+		IngestSpillMaxMb:         1024,
+		IngestDropKinds:          strings.Join(ingress.DefaultDropUpdateKinds, ","),
+		ForwardUrl:               "",
+		ForwardTokenFile:         "",
+		ForwardBatchRecords:      500,
+		ForwardBatchInterval:     2 * time.Second,
+		LeaderElection:           false,
+		LeaderElectionNamespace:  "",
+		LeaderElectionName:       "sloop",
+		LeaderElectionId:         "",
 		ThresholdForGC:           0.8,
 		RestoreDatabaseFile:      "",
 		BadgerDiscardRatio:       0.99,
@@ -200,8 +302,17 @@ func Init() *SloopConfig {
 	return finalConfig
 }
 
+// ToYaml is logged and shown on the debug pages, so push tokens are masked
 func (c *SloopConfig) ToYaml() string {
-	b, err := yaml.Marshal(c)
+	masked := *c
+	masked.PushSources = nil
+	for _, source := range c.PushSources {
+		if source.Token != "" {
+			source.Token = "<hidden>"
+		}
+		masked.PushSources = append(masked.PushSources, source)
+	}
+	b, err := yaml.Marshal(&masked)
 	if err != nil {
 		panic(err)
 	}
@@ -223,9 +334,156 @@ func (c *SloopConfig) Validate() error {
 		return fmt.Errorf("CleanupFrequency can not be less than 15 minutes.  Badger is lazy about freeing space " +
 			"on disk so we need to give it time to avoid over-correction")
 	}
+	if c.DebugPlaybackSpeed < 0 {
+		return fmt.Errorf("DebugPlaybackSpeed can not be negative, got %v", c.DebugPlaybackSpeed)
+	}
+	if c.IngestQueueRecords < 0 || c.IngestSpillMaxMb < 0 {
+		return fmt.Errorf("IngestQueueRecords and IngestSpillMaxMb can not be negative, got %v and %v", c.IngestQueueRecords, c.IngestSpillMaxMb)
+	}
+	if c.ProcessingBatchRecords <= 0 || c.ProcessingBatchLatency < 0 {
+		return fmt.Errorf("ProcessingBatchRecords must be > 0 and ProcessingBatchLatency can not be negative, got %v and %v", c.ProcessingBatchRecords, c.ProcessingBatchLatency)
+	}
+	if c.ProcessingWorkers <= 0 {
+		return fmt.Errorf("ProcessingWorkers must be > 0, got %v", c.ProcessingWorkers)
+	}
+	if c.DebugRecordFormat != "ndjson" && c.DebugRecordFormat != "protobuf" {
+		return fmt.Errorf("DebugRecordFormat must be ndjson or protobuf, got %q", c.DebugRecordFormat)
+	}
+	if c.ImportSnapshotTime != "" {
+		_, err = time.Parse(time.RFC3339, c.ImportSnapshotTime)
+		if err != nil {
+			return errors.Wrap(err, "invalid ImportSnapshotTime")
+		}
+	}
+	err = c.validateClusters()
+	if err != nil {
+		return err
+	}
+	err = c.validateForward()
+	if err != nil {
+		return err
+	}
+	err = c.validateLeaderElection()
+	if err != nil {
+		return err
+	}
+	_, err = ingress.NewRedactor(c.Redactions)
+	if err != nil {
+		return errors.Wrap(err, "invalid Redactions")
+	}
+	_, err = ingress.NewPruner(c.Pruning)
+	if err != nil {
+		return errors.Wrap(err, "invalid Pruning")
+	}
+	_, err = ingress.NewMinorUpdates(c.MinorUpdates)
+	if err != nil {
+		return errors.Wrap(err, "invalid MinorUpdates")
+	}
+	_, err = ingress.NewPushSources(c.PushSources)
+	if err != nil {
+		return errors.Wrap(err, "invalid PushSources")
+	}
 	return nil
 }
 
+func (c *SloopConfig) validateClusters() error {
+	if len(c.Clusters) == 0 {
+		return nil
+	}
+	if len(c.Clusters) > 1 && (c.DebugPlaybackFile != "" || c.DebugRecordFile != "" || c.RestoreDatabaseFile != "" || c.AuditLogPath != "" || c.ImportSnapshotPath != "") {
+		return fmt.Errorf("playback, record, restore, audit logs and snapshot imports only work with a single cluster")
+	}
+	total := 0
+	for _, budget := range c.DiskBudgetsMb() {
+		if budget <= 0 {
+			return fmt.Errorf("MaxDiskMb of %v is not enough to give every cluster a share, got %v", c.MaxDiskMb, c.DiskBudgetsMb())
+		}
+		total += budget
+	}
+	if total > c.MaxDiskMb {
+		return fmt.Errorf("cluster maxDiskMb values add up to %v which is more than MaxDiskMb of %v", total, c.MaxDiskMb)
+	}
+	return nil
+}
+
+// An agent has no store, so options that need one make no sense with it
+func (c *SloopConfig) validateForward() error {
+	if c.ForwardUrl == "" {
+		return nil
+	}
+	if len(c.Clusters) > 1 {
+		return fmt.Errorf("forwardUrl only works with a single cluster, run an agent for each")
+	}
+	if c.DebugRecordFile != "" || c.RestoreDatabaseFile != "" || c.DisableKubeWatcher && c.DebugPlaybackFile == "" && c.AuditLogPath == "" && c.ImportSnapshotPath == "" {
+		return fmt.Errorf("forwardUrl can not be used with record, restore or without a kube watcher, playback file or audit log")
+	}
+	if c.ForwardBatchRecords <= 0 || c.ForwardBatchInterval <= 0 {
+		return fmt.Errorf("ForwardBatchRecords and ForwardBatchInterval must be > 0, got %v and %v", c.ForwardBatchRecords, c.ForwardBatchInterval)
+	}
+	_, err := ingress.NewForwarder(ingress.ForwarderConfig{URL: c.ForwardUrl}, nil)
+	return err
+}
+
+// Leader election only decides who runs the kube watchers
+func (c *SloopConfig) validateLeaderElection() error {
+	if !c.LeaderElection {
+		return nil
+	}
+	if c.DisableKubeWatcher {
+		return fmt.Errorf("leaderElection needs the kube watcher, but disableKubeWatch is set")
+	}
+	if c.LeaderElectionName == "" {
+		return fmt.Errorf("LeaderElectionName can not be empty")
+	}
+	return nil
+}
+
+// GetMinorUpdateRules returns the MinorUpdates rules, without the ones that apply to nodes when KeepMinorNodeUpdates
+// is set.  That includes rules for every kind or a glob that matches Node
+func (c *SloopConfig) GetMinorUpdateRules() []ingress.MinorUpdateRule {
+	if !c.KeepMinorNodeUpdates {
+		return c.MinorUpdates
+	}
+	var rules []ingress.MinorUpdateRule
+	for _, rule := range c.MinorUpdates {
+		if !rule.MatchesKind(kubeextractor.NodeKind) {
+			rules = append(rules, rule)
+		}
+	}
+	return rules
+}
+
+// GetClusters returns the clusters to watch.  Without a clusters section this is the one cluster described by
+// UseKubeContext, ApiServerHost and DisplayContext
+func (c *SloopConfig) GetClusters() []ClusterConfig {
+	if len(c.Clusters) > 0 {
+		return c.Clusters
+	}
+	return []ClusterConfig{{Context: c.UseKubeContext, ApiServerHost: c.ApiServerHost, DisplayContext: c.DisplayContext, MaxDiskMb: c.MaxDiskMb}}
+}
+
+// DiskBudgetsMb splits MaxDiskMb across GetClusters(), in the same order.  Clusters with their own MaxDiskMb get
+// that, and the rest share whatever is left equally
+func (c *SloopConfig) DiskBudgetsMb() []int {
+	clusters := c.GetClusters()
+	budgets := make([]int, len(clusters))
+	remaining := c.MaxDiskMb
+	unset := 0
+	for idx, cluster := range clusters {
+		budgets[idx] = cluster.MaxDiskMb
+		remaining -= cluster.MaxDiskMb
+		if cluster.MaxDiskMb == 0 {
+			unset++
+		}
+	}
+	for idx := range budgets {
+		if budgets[idx] == 0 {
+			budgets[idx] = remaining / unset
+		}
+	}
+	return budgets
+}
+
 func loadFromFile(filename string, config *SloopConfig) *SloopConfig {
 	configFile, err := ioutil.ReadFile(filename)
 	if err != nil {
@@ -3,6 +3,7 @@ package config
 import (
 	"encoding/json"
 	"github.com/ghodss/yaml"
+	"github.com/salesforce/sloop/pkg/sloop/ingress"
 	"github.com/stretchr/testify/assert"
 	"io/ioutil"
 	"path/filepath"
@@ -44,3 +45,73 @@ func Test_loadFromNoFile_shouldPanic(t *testing.T) {
 	configfilename, _ := filepath.Abs("../testconfig.json")
 	assert.Panics(t, func() { loadFromFile(configfilename, config) }, "The code did not panic")
 }
+
+func Test_DiskBudgetsMb(t *testing.T) {
+	conf := getDefaultConfig()
+	conf.MaxDiskMb = 1000
+	assert.Equal(t, []int{1000}, conf.DiskBudgetsMb())
+	assert.Nil(t, conf.Validate())
+
+	conf.Clusters = []ClusterConfig{{Context: "a", MaxDiskMb: 400}, {Context: "b"}, {Context: "c"}}
+	assert.Equal(t, []int{400, 300, 300}, conf.DiskBudgetsMb())
+	assert.Nil(t, conf.Validate())
+
+	conf.Clusters[0].MaxDiskMb = 1000
+	assert.NotNil(t, conf.Validate())
+	conf.Clusters = []ClusterConfig{{Context: "a", MaxDiskMb: 600}, {Context: "b", MaxDiskMb: 600}}
+	assert.NotNil(t, conf.Validate())
+}
+
+func Test_Validate_SingleClusterOnlyOptions(t *testing.T) {
+	conf := getDefaultConfig()
+	conf.Clusters = []ClusterConfig{{Context: "a"}, {Context: "b"}}
+	assert.Nil(t, conf.Validate())
+	conf.DebugPlaybackFile = "watch.ndjson"
+	assert.NotNil(t, conf.Validate())
+}
+
+func Test_Validate_Forward(t *testing.T) {
+	conf := getDefaultConfig()
+	conf.ForwardUrl = "https://sloop.example.com/edge-1/ingest"
+	assert.Nil(t, conf.Validate())
+	conf.ForwardUrl = "sloop.example.com/edge-1/ingest"
+	assert.NotNil(t, conf.Validate())
+	conf.ForwardUrl = "https://sloop.example.com/edge-1/ingest"
+	conf.RestoreDatabaseFile = "backup.db"
+	assert.NotNil(t, conf.Validate())
+}
+
+func Test_Validate_LeaderElection(t *testing.T) {
+	conf := getDefaultConfig()
+	conf.LeaderElection = true
+	assert.Nil(t, conf.Validate())
+	conf.LeaderElectionName = ""
+	assert.NotNil(t, conf.Validate())
+	conf.LeaderElectionName = "sloop"
+	conf.DisableKubeWatcher = true
+	assert.NotNil(t, conf.Validate())
+}
+
+func Test_GetMinorUpdateRules(t *testing.T) {
+	conf := getDefaultConfig()
+	conf.MinorUpdates = append(conf.MinorUpdates, ingress.MinorUpdateRule{Kind: "Lease", Path: "spec.renewTime"})
+	assert.Len(t, conf.GetMinorUpdateRules(), 2)
+	conf.KeepMinorNodeUpdates = true
+	assert.Equal(t, []ingress.MinorUpdateRule{{Kind: "Lease", Path: "spec.renewTime"}}, conf.GetMinorUpdateRules())
+
+	// Rules that also apply to nodes go too
+	conf.MinorUpdates = append(conf.MinorUpdates, ingress.MinorUpdateRule{Path: "metadata.annotations.foo"},
+		ingress.MinorUpdateRule{Kind: "*", Path: "metadata.annotations.bar"}, ingress.MinorUpdateRule{Kind: "N*", Path: "metadata.annotations.baz"})
+	assert.Equal(t, []ingress.MinorUpdateRule{{Kind: "Lease", Path: "spec.renewTime"}}, conf.GetMinorUpdateRules())
+
+	conf.MinorUpdates = []ingress.MinorUpdateRule{{Kind: "Lease"}}
+	assert.NotNil(t, conf.Validate())
+}
+
+func Test_ToYaml_HidesPushTokens(t *testing.T) {
+	conf := SloopConfig{PushSources: []ingress.PushSource{{Name: "agent", Token: "secret"}}}
+	out := conf.ToYaml()
+	assert.NotContains(t, out, "secret")
+	assert.Contains(t, out, "agent")
+	assert.Equal(t, "secret", conf.PushSources[0].Token)
+}
@@ -0,0 +1,205 @@
+/*
+ * Copyright (c) 2021, salesforce.com, inc.
+ * All rights reserved.
+ * SPDX-License-Identifier: BSD-3-Clause
+ * For full license text, see LICENSE.txt file in the repo root or https://opensource.org/licenses/BSD-3-Clause
+ */
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/salesforce/sloop/pkg/sloop/server/internal/config"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+)
+
+const serviceAccountNamespaceFile = "/var/run/secrets/kubernetes.io/serviceaccount/namespace"
+
+var (
+	metricLeaderElectionIsLeader    = promauto.NewGauge(prometheus.GaugeOpts{Name: "sloop_leader_election_is_leader"})
+	metricLeaderElectionTransitions = promauto.NewCounterVec(prometheus.CounterOpts{Name: "sloop_leader_election_transitions"}, []string{"to"})
+)
+
+// Same as the defaults of kube-controller-manager
+type leaderElectionTimings struct {
+	leaseDuration time.Duration
+	renewDeadline time.Duration
+	retryPeriod   time.Duration
+}
+
+var defaultLeaderElectionTimings = leaderElectionTimings{leaseDuration: 15 * time.Second, renewDeadline: 10 * time.Second, retryPeriod: 2 * time.Second}
+
+// leaderElection lets several sloop replicas watch the same clusters without all of them loading the apiserver.
+// Whoever holds the Lease runs the kube watchers.  The others keep their store, processing and webserver running,
+// so they only need to start watching to take over.  A watch starts with a full list, so the new leader catches up
+// on whatever changed while it was following.
+type leaderElection struct {
+	identity string
+	lock     resourcelock.Interface
+	timings  leaderElectionTimings
+	// Called when we become the leader and when we stop being it.  Both have to cope with being called again
+	startLeading func() error
+	stopLeading  func()
+
+	protection *sync.Mutex
+	// Tracked from the callbacks, GetLeader of the elector is not safe to call while it runs
+	leader  string
+	leading bool
+	cancel  context.CancelFunc
+	done    chan struct{}
+}
+
+func newLeaderElection(conf *config.SloopConfig, kubeClient kubernetes.Interface, startLeading func() error, stopLeading func()) (*leaderElection, error) {
+	identity := conf.LeaderElectionId
+	if identity == "" {
+		hostname, err := os.Hostname()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get hostname for the leader election identity")
+		}
+		identity = hostname
+	}
+	namespace := conf.LeaderElectionNamespace
+	if namespace == "" {
+		namespace = defaultLeaderElectionNamespace()
+	}
+	lock := &resourcelock.LeaseLock{
+		LeaseMeta:  metav1.ObjectMeta{Name: conf.LeaderElectionName, Namespace: namespace},
+		Client:     kubeClient.CoordinationV1(),
+		LockConfig: resourcelock.ResourceLockConfig{Identity: identity},
+	}
+	glog.Infof("Leader election for lease %v/%v as %q", namespace, conf.LeaderElectionName, identity)
+	return &leaderElection{
+		identity:     identity,
+		lock:         lock,
+		timings:      defaultLeaderElectionTimings,
+		startLeading: startLeading,
+		stopLeading:  stopLeading,
+		protection:   &sync.Mutex{},
+	}, nil
+}
+
+// The namespace we run in, so the lease lives next to the pods competing for it
+func defaultLeaderElectionNamespace() string {
+	if namespace := os.Getenv("POD_NAMESPACE"); namespace != "" {
+		return namespace
+	}
+	if data, err := ioutil.ReadFile(serviceAccountNamespaceFile); err == nil && strings.TrimSpace(string(data)) != "" {
+		return strings.TrimSpace(string(data))
+	}
+	return metav1.NamespaceDefault
+}
+
+func (l *leaderElection) start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	l.cancel = cancel
+	l.done = make(chan struct{})
+	go l.run(ctx)
+}
+
+// Campaigns until stopped.  Losing the lease ends a round, and the next round campaigns for it again
+func (l *leaderElection) run(ctx context.Context) {
+	defer close(l.done)
+	for ctx.Err() == nil {
+		roundCtx, cancelRound := context.WithCancel(ctx)
+		elector, err := leaderelection.NewLeaderElector(leaderelection.LeaderElectionConfig{
+			Lock:            l.lock,
+			LeaseDuration:   l.timings.leaseDuration,
+			RenewDeadline:   l.timings.renewDeadline,
+			RetryPeriod:     l.timings.retryPeriod,
+			ReleaseOnCancel: true,
+			Name:            "sloop",
+			Callbacks: leaderelection.LeaderCallbacks{
+				OnStartedLeading: func(context.Context) {
+					l.setLeading(true)
+					err := l.startLeading()
+					if err != nil {
+						// Give the lease up so a replica that can watch gets it
+						glog.Errorf("Failed to start watching after becoming the leader: %v", err)
+						cancelRound()
+					}
+				},
+				OnStoppedLeading: func() {
+					l.stopLeading()
+					l.setLeading(false)
+				},
+				OnNewLeader: func(identity string) {
+					glog.Infof("Leader is now %q", identity)
+					l.protection.Lock()
+					l.leader = identity
+					l.protection.Unlock()
+				},
+			},
+		})
+		if err != nil {
+			// Only happens for bad timings, which are ours
+			glog.Errorf("Failed to create leader elector: %v", err)
+			cancelRound()
+			return
+		}
+		elector.Run(roundCtx)
+		cancelRound()
+
+		select {
+		case <-ctx.Done():
+		case <-time.After(l.timings.retryPeriod):
+		}
+	}
+}
+
+func (l *leaderElection) setLeading(leading bool) {
+	l.protection.Lock()
+	defer l.protection.Unlock()
+	if l.leading == leading {
+		return
+	}
+	l.leading = leading
+	if leading {
+		glog.Infof("Became the leader as %q, starting kube watchers", l.identity)
+		metricLeaderElectionIsLeader.Set(1)
+		metricLeaderElectionTransitions.WithLabelValues("leader").Inc()
+	} else {
+		glog.Infof("No longer the leader, stopped kube watchers")
+		metricLeaderElectionIsLeader.Set(0)
+		metricLeaderElectionTransitions.WithLabelValues("follower").Inc()
+	}
+}
+
+// Stops watching and gives up the lease, so another replica can take over without waiting for it to expire
+func (l *leaderElection) stop() {
+	if l.cancel == nil {
+		return
+	}
+	l.cancel()
+	<-l.done
+	// Run does not wait for OnStartedLeading, so a watcher it started late is stopped here
+	l.stopLeading()
+	l.setLeading(false)
+}
+
+// For /healthz.  Followers are healthy too, they are ready to take over
+func (l *leaderElection) status() string {
+	l.protection.Lock()
+	defer l.protection.Unlock()
+	if l.leading {
+		return fmt.Sprintf("leader election: leading as %q", l.identity)
+	}
+	leader := l.leader
+	if leader == "" || leader == l.identity {
+		return fmt.Sprintf("leader election: following as %q, no leader yet", l.identity)
+	}
+	return fmt.Sprintf("leader election: following %q as %q", leader, l.identity)
+}
@@ -0,0 +1,68 @@
+/*
+ * Copyright (c) 2021, salesforce.com, inc.
+ * All rights reserved.
+ * SPDX-License-Identifier: BSD-3-Clause
+ * For full license text, see LICENSE.txt file in the repo root or https://opensource.org/licenses/BSD-3-Clause
+ */
+
+package server
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/salesforce/sloop/pkg/sloop/server/internal/config"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+// Returns the election along with how often it was told to start and stop watching
+func helper_newLeaderElection(t *testing.T, kubeClient kubernetes.Interface, identity string) (*leaderElection, *int32, *int32) {
+	conf := &config.SloopConfig{LeaderElection: true, LeaderElectionNamespace: "sloop", LeaderElectionName: "sloop", LeaderElectionId: identity}
+	var starts, stops int32
+	election, err := newLeaderElection(conf, kubeClient, func() error {
+		atomic.AddInt32(&starts, 1)
+		return nil
+	}, func() {
+		atomic.AddInt32(&stops, 1)
+	})
+	assert.Nil(t, err)
+	election.timings = leaderElectionTimings{leaseDuration: 2 * time.Second, renewDeadline: time.Second, retryPeriod: 50 * time.Millisecond}
+	return election, &starts, &stops
+}
+
+func helper_waitFor(condition func() bool) bool {
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(10 * time.Millisecond) {
+		if condition() {
+			return true
+		}
+	}
+	return false
+}
+
+func Test_LeaderElection_OneLeaderAndReleaseOnStop(t *testing.T) {
+	kubeClient := fake.NewSimpleClientset()
+	first, firstStarts, firstStops := helper_newLeaderElection(t, kubeClient, "first")
+	first.start()
+	assert.True(t, helper_waitFor(func() bool { return atomic.LoadInt32(firstStarts) == 1 }))
+	assert.Equal(t, `leader election: leading as "first"`, first.status())
+
+	second, secondStarts, _ := helper_newLeaderElection(t, kubeClient, "second")
+	second.start()
+	assert.True(t, helper_waitFor(func() bool { return second.status() == `leader election: following "first" as "second"` }))
+	assert.Equal(t, int32(0), atomic.LoadInt32(secondStarts))
+
+	first.stop()
+	assert.True(t, atomic.LoadInt32(firstStops) >= 1)
+	lease, err := kubeClient.CoordinationV1().Leases("sloop").Get("sloop", metav1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, "", *lease.Spec.HolderIdentity)
+
+	// The released lease is short, so the follower takes over soon after
+	assert.True(t, helper_waitFor(func() bool { return atomic.LoadInt32(secondStarts) == 1 }))
+	assert.Equal(t, `leader election: leading as "second"`, second.status())
+	second.stop()
+}
@@ -9,25 +9,18 @@ package server
 
 import (
 	"flag"
+	"fmt"
+	"net/http"
 	"os"
-	"path"
 	"strings"
-	"time"
 
 	"github.com/pkg/errors"
 
 	"github.com/salesforce/sloop/pkg/sloop/ingress"
 	"github.com/salesforce/sloop/pkg/sloop/server/internal/config"
-	"github.com/salesforce/sloop/pkg/sloop/store/typed"
-	"github.com/salesforce/sloop/pkg/sloop/store/untyped"
 
 	"github.com/golang/glog"
 
-	"github.com/spf13/afero"
-
-	"github.com/salesforce/sloop/pkg/sloop/processing"
-	"github.com/salesforce/sloop/pkg/sloop/store/untyped/badgerwrap"
-	"github.com/salesforce/sloop/pkg/sloop/storemanager"
 	"github.com/salesforce/sloop/pkg/sloop/webserver"
 )
 
@@ -45,105 +38,72 @@ func RealMain() error {
 		return errors.Wrap(err, "config validation failed")
 	}
 
-	kubeContext, err := ingress.GetKubernetesContext(conf.ApiServerHost, conf.UseKubeContext, conf.PrivilegedAccess)
+	redactor, err := ingress.NewRedactor(conf.Redactions)
 	if err != nil {
-		return errors.Wrap(err, "failed to get kubernetes context")
-	}
-
-	// Channel used for updates from ingress to store
-	// The channel is owned by this function, and no external code should close this!
-	kubeWatchChan := make(chan typed.KubeWatchResult, 1000)
-
-	factory := &badgerwrap.BadgerFactory{}
-
-	storeRootWithKubeContext := path.Join(conf.StoreRoot, kubeContext)
-	storeConfig := &untyped.Config{
-		RootPath:                 storeRootWithKubeContext,
-		ConfigPartitionDuration:  time.Duration(1) * time.Hour,
-		BadgerMaxTableSize:       conf.BadgerMaxTableSize,
-		BadgerKeepL0InMemory:     conf.BadgerKeepL0InMemory,
-		BadgerVLogFileSize:       conf.BadgerVLogFileSize,
-		BadgerVLogMaxEntries:     conf.BadgerVLogMaxEntries,
-		BadgerUseLSMOnlyOptions:  conf.BadgerUseLSMOnlyOptions,
-		BadgerEnableEventLogging: conf.BadgerEnableEventLogging,
-		BadgerNumOfCompactors:    conf.BadgerNumOfCompactors,
-		BadgerNumL0Tables:        conf.BadgerNumL0Tables,
-		BadgerNumL0TablesStall:   conf.BadgerNumL0TablesStall,
-		BadgerSyncWrites:         conf.BadgerSyncWrites,
-		BadgerLevelOneSize:       conf.BadgerLevelOneSize,
-		BadgerLevSizeMultiplier:  conf.BadgerLevSizeMultiplier,
-		BadgerVLogFileIOMapping:  conf.BadgerVLogFileIOMapping,
-		BadgerVLogTruncate:       conf.BadgerVLogTruncate,
-		BadgerDetailLogEnabled:   conf.BadgerDetailLogEnabled,
-	}
-	db, err := untyped.OpenStore(factory, storeConfig)
+		return errors.Wrap(err, "failed to create redactor")
+	}
+	pruner, err := ingress.NewPruner(conf.Pruning)
 	if err != nil {
-		return errors.Wrap(err, "failed to init untyped store")
+		return errors.Wrap(err, "failed to create pruner")
 	}
-	defer untyped.CloseStore(db)
-
-	if conf.RestoreDatabaseFile != "" {
-		glog.Infof("Restoring from backup file %q into context %q", conf.RestoreDatabaseFile, kubeContext)
-		err := ingress.DatabaseRestore(db, conf.RestoreDatabaseFile)
-		if err != nil {
-			return errors.Wrap(err, "failed to restore database")
-		}
-		glog.Infof("Restored from backup file %q into context %q", conf.RestoreDatabaseFile, kubeContext)
+	minorUpdates, err := ingress.NewMinorUpdates(conf.GetMinorUpdateRules())
+	if err != nil {
+		return errors.Wrap(err, "failed to load minor update rules")
+	}
+	pushSources, err := ingress.NewPushSources(conf.PushSources)
+	if err != nil {
+		return errors.Wrap(err, "failed to load push sources")
 	}
 
-	tables := typed.NewTableList(db)
-	processor := processing.NewProcessing(kubeWatchChan, tables, conf.KeepMinorNodeUpdates, conf.MaxLookback)
-	processor.Start()
+	if conf.ForwardUrl != "" {
+		return runAgent(conf, pruner, redactor)
+	}
 
-	// Real kubernetes watcher
-	var kubeWatcherSource ingress.KubeWatcher
-	if !conf.DisableKubeWatcher {
-		kubeClient, err := ingress.MakeKubernetesClient(conf.ApiServerHost, kubeContext, conf.PrivilegedAccess)
+	clusterConfs := conf.GetClusters()
+	var clusters []*cluster
+	seenContexts := map[string]bool{}
+	for _, clusterConf := range clusterConfs {
+		c, err := resolveCluster(conf, clusterConf)
 		if err != nil {
-			return errors.Wrap(err, "failed to create kubernetes client")
+			return err
 		}
-
-		kubeWatcherSource, err = ingress.NewKubeWatcherSource(kubeClient, kubeWatchChan, conf.KubeWatchResyncInterval, conf.WatchCrds, conf.CrdRefreshInterval, conf.ApiServerHost, kubeContext, conf.EnableGranularMetrics)
-		if err != nil {
-			return errors.Wrap(err, "failed to initialize kubeWatcher")
+		// The display context is the first part of every url for the cluster, and names its store
+		if len(clusterConfs) > 1 && (c.displayContext == "" || strings.ContainsAny(c.displayContext, "/{}")) {
+			return fmt.Errorf("context %q can not be used in urls, set a displayContext for it", c.displayContext)
 		}
+		if seenContexts[c.displayContext] {
+			return fmt.Errorf("more than one cluster uses context %q, set a displayContext for each", c.displayContext)
+		}
+		seenContexts[c.displayContext] = true
+		clusters = append(clusters, c)
 	}
 
-	// File playback
-	if conf.DebugPlaybackFile != "" {
-		err = ingress.PlayFile(kubeWatchChan, conf.DebugPlaybackFile)
+	diskBudgetsMb := conf.DiskBudgetsMb()
+	var webClusters []webserver.ClusterTables
+	for idx, c := range clusters {
+		glog.Infof("Starting cluster %q with a disk budget of %vMB", c.displayContext, diskBudgetsMb[idx])
+		err = c.start(conf, clusterConfs[idx], diskBudgetsMb[idx], pruner, redactor, minorUpdates, pushSources)
 		if err != nil {
-			return errors.Wrap(err, "failed to play back file")
+			// start cleans up after itself, but the clusters before it are running
+			for _, started := range clusters[:idx] {
+				started.shutdown()
+			}
+			return errors.Wrapf(err, "failed to start cluster %q", c.displayContext)
 		}
-	}
-
-	var recorder *ingress.FileRecorder
-	if conf.DebugRecordFile != "" {
-		recorder = ingress.NewFileRecorder(conf.DebugRecordFile, kubeWatchChan)
-		recorder.Start()
-	}
-
-	var storemgr *storemanager.StoreManager
-	if !conf.DisableStoreManager {
-		fs := &afero.Afero{Fs: afero.NewOsFs()}
-		storeCfg := &storemanager.Config{
-			StoreRoot:          conf.StoreRoot,
-			Freq:               conf.CleanupFrequency,
-			TimeLimit:          conf.MaxLookback,
-			SizeLimitBytes:     conf.MaxDiskMb * 1024 * 1024,
-			BadgerDiscardRatio: conf.BadgerDiscardRatio,
-			BadgerVLogGCFreq:   conf.BadgerVLogGCFreq,
-			DeletionBatchSize:  conf.DeletionBatchSize,
-			GCThreshold:        conf.ThresholdForGC,
-			EnableDeleteKeys:   conf.EnableDeleteKeys,
+		webCluster := webserver.ClusterTables{Context: c.displayContext, Tables: c.tables}
+		if c.pushReceiver != nil {
+			webCluster.Ingest = c.pushReceiver
+			webCluster.Audit = http.HandlerFunc(c.pushReceiver.ServeAudit)
 		}
-		storemgr = storemanager.NewStoreManager(tables, storeCfg, fs)
-		storemgr.Start()
+		webClusters = append(webClusters, webCluster)
 	}
 
-	displayContext := kubeContext
-	if conf.DisplayContext != "" {
-		displayContext = conf.DisplayContext
+	election, err := startLeaderElection(conf, clusters, clusterConfs)
+	if err != nil {
+		for _, c := range clusters {
+			c.shutdown()
+		}
+		return err
 	}
 
 	webConfig := webserver.WebConfig{
@@ -157,35 +117,90 @@ func RealMain() error {
 		DefaultResources: conf.DefaultKind,
 		ResourceLinks:    conf.ResourceLinks,
 		LeftBarLinks:     conf.LeftBarLinks,
-		CurrentContext:   displayContext,
 	}
-	err = webserver.Run(webConfig, tables)
+	if election != nil {
+		webConfig.HealthStatus = election.status
+	}
+	err = webserver.Run(webConfig, webClusters)
+	if election != nil {
+		election.stop()
+	}
+	for _, c := range clusters {
+		c.shutdown()
+	}
 	if err != nil {
 		return errors.Wrap(err, "failed to run webserver")
 	}
 
-	// Initiate shutdown with the following order:
-	// 1. Shut down ingress so that it stops emitting events
-	// 2. Close the input channel which signals processing to finish work
-	// 3. Wait on processor to tell us all work is complete.  Store will not change after that
-	if kubeWatcherSource != nil {
-		kubeWatcherSource.Stop()
-	}
-	close(kubeWatchChan)
-	processor.Wait()
+	glog.Infof("RunWithConfig finished")
+	return nil
+}
 
-	if recorder != nil {
-		recorder.Close()
+// Agents only need health and metrics from the webserver, everything they watch goes to ForwardUrl
+func runAgent(conf *config.SloopConfig, pruner *ingress.Pruner, redactor *ingress.Redactor) error {
+	clusterConf := conf.GetClusters()[0]
+	c, err := resolveCluster(conf, clusterConf)
+	if err != nil {
+		return err
 	}
-
-	if storemgr != nil {
-		storemgr.Shutdown()
+	glog.Infof("Forwarding context %q to %v", c.kubeContext, conf.ForwardUrl)
+	err = c.startAgent(conf, clusterConf, pruner, redactor)
+	if err != nil {
+		return errors.Wrap(err, "failed to start agent")
 	}
 
-	glog.Infof("RunWithConfig finished")
+	election, err := startLeaderElection(conf, []*cluster{c}, []config.ClusterConfig{clusterConf})
+	if err != nil {
+		c.shutdown()
+		return err
+	}
+	webConfig := webserver.WebConfig{BindAddress: conf.BindAddress, Port: conf.Port}
+	if election != nil {
+		webConfig.HealthStatus = election.status
+	}
+	err = webserver.RunAgent(webConfig)
+	if election != nil {
+		election.stop()
+	}
+	c.shutdown()
+	if err != nil {
+		return errors.Wrap(err, "failed to run webserver")
+	}
+	glog.Infof("Agent finished")
 	return nil
 }
 
+// Returns nil without LeaderElection.  The lease lives in the first cluster, and whoever holds it watches all of them
+func startLeaderElection(conf *config.SloopConfig, clusters []*cluster, clusterConfs []config.ClusterConfig) (*leaderElection, error) {
+	if !conf.LeaderElection {
+		return nil, nil
+	}
+	kubeClient, err := ingress.MakeKubernetesClient(clusterConfs[0].ApiServerHost, clusterConfs[0].KubeConfig, clusters[0].kubeContext, conf.PrivilegedAccess)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create kubernetes client for leader election")
+	}
+	startLeading := func() error {
+		for idx, c := range clusters {
+			err := c.startKubeWatcher(conf, clusterConfs[idx])
+			if err != nil {
+				return errors.Wrapf(err, "failed to start watching cluster %q", c.displayContext)
+			}
+		}
+		return nil
+	}
+	stopLeading := func() {
+		for _, c := range clusters {
+			c.stopKubeWatcher(false)
+		}
+	}
+	election, err := newLeaderElection(conf, kubeClient, startLeading, stopLeading)
+	if err != nil {
+		return nil, err
+	}
+	election.start()
+	return election, nil
+}
+
 // By default glog will not print anything to console, which can confuse users
 // This will turn it on unless user sets it explicitly (with --alsologtostderr=false)
 func setupStdErrLogging() {
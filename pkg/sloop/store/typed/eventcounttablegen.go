@@ -76,6 +76,13 @@ func (t *ResourceEventCountsTable) Get(txn badgerwrap.Txn, key string) (*Resourc
 	if err != nil {
 		return nil, errors.Wrapf(err, "protobuf unmarshal failed for table %v on value length %v", t.tableName, len(valueBytes))
 	}
+	err = expandStoredValue(txn, key, retValue)
+	if errors.Cause(err) == errMissingKeyframe {
+		// Only while the partition is being dropped, so the value is as good as gone too
+		return nil, badger.ErrKeyNotFound
+	} else if err != nil {
+		return nil, errors.Wrapf(err, "failed to expand value for table %v key %v", t.tableName, key)
+	}
 	return retValue, nil
 }
 
@@ -223,6 +230,61 @@ func (t *ResourceEventCountsTable) getLastMatchingKeyInPartition(txn badgerwrap.
 	return false, &EventCountKey{}, nil
 }
 
+// GetLastValue returns the newest value matching keyComparator.  It looks in the partition of endTime first, and then
+// in earlier partitions down to the one of startTime, so a value written just before a partition boundary is still
+// found.  Returns nils when there is none
+func (t *ResourceEventCountsTable) GetLastValue(txn badgerwrap.Txn, keyComparator *EventCountKey, startTime time.Time, endTime time.Time) (*EventCountKey, *ResourceEventCounts, error) {
+	// A copy, so the caller's key keeps the partition it had
+	comparator := *keyComparator
+	ok, minPartition, maxPartition := t.GetMinMaxPartitions(txn)
+	if !ok {
+		return nil, nil, nil
+	}
+	startPartition := untyped.GetPartitionId(startTime)
+	if startPartition < minPartition {
+		startPartition = minPartition
+	}
+	// No need to walk through partitions that are not there yet
+	if untyped.GetPartitionId(endTime) > maxPartition {
+		maxPartitionStartTime, _, err := untyped.GetTimeRangeForPartition(maxPartition)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to get time range for partition:%v", maxPartition)
+		}
+		endTime = maxPartitionStartTime
+	}
+
+	for curTime := endTime; untyped.GetPartitionId(curTime) >= startPartition; curTime = curTime.Add(-untyped.GetPartitionDuration()) {
+		curPartition := untyped.GetPartitionId(curTime)
+		comparator.SetPartitionId(curPartition)
+		keyPrefix := comparator.String()
+
+		iterOpt := badger.DefaultIteratorOptions
+		iterOpt.Prefix = []byte(keyPrefix)
+		iterOpt.Reverse = true
+		itr := txn.NewIterator(iterOpt)
+		// Badger reverse seek needs 255 at the end of the prefix to start from the last key
+		itr.Seek([]byte(keyPrefix + string(rune(255))))
+		if !itr.ValidForPrefix([]byte(keyPrefix)) {
+			itr.Close()
+			continue
+		}
+		keyStr := string(itr.Item().Key())
+		itr.Close()
+
+		key := &EventCountKey{}
+		err := key.Parse(keyStr)
+		if err != nil {
+			return nil, nil, err
+		}
+		value, err := t.Get(txn, keyStr)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to get last value for %v in table:%v", keyStr, t.tableName)
+		}
+		return key, value, nil
+	}
+	return nil, nil, nil
+}
+
 func (t *ResourceEventCountsTable) RangeRead(txn badgerwrap.Txn, keyPrefix *EventCountKey,
 	keyPredicateFn func(string) bool, valPredicateFn func(*ResourceEventCounts) bool, startTime time.Time, endTime time.Time) (map[EventCountKey]*ResourceEventCounts, RangeReadStats, error) {
 	resources := map[EventCountKey]*ResourceEventCounts{}
@@ -277,6 +339,15 @@ func (t *ResourceEventCountsTable) RangeRead(txn badgerwrap.Txn, keyPrefix *Even
 			if err != nil {
 				return nil, stats, err
 			}
+			err = expandStoredValue(txn, string(itr.Item().Key()), retValue)
+			if errors.Cause(err) == errMissingKeyframe {
+				// Only while the partition is being dropped
+				stats.RowsMissingKeyframeCount += 1
+				continue
+			}
+			if err != nil {
+				return nil, stats, err
+			}
 			if valPredicateFn != nil && !valPredicateFn(retValue) {
 				continue
 			}
@@ -0,0 +1,106 @@
+/*
+ * Copyright (c) 2021, salesforce.com, inc.
+ * All rights reserved.
+ * SPDX-License-Identifier: BSD-3-Clause
+ * For full license text, see LICENSE.txt file in the repo root or https://opensource.org/licenses/BSD-3-Clause
+ */
+
+package typed
+
+import (
+	"github.com/salesforce/sloop/pkg/sloop/store/untyped"
+	"github.com/salesforce/sloop/pkg/sloop/store/untyped/badgerwrap"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+const (
+	someHealthKey = "/health/001546398000/somekind/somenamespace/somename/68510937-4ffc-11e9-8e26-1418775557c8"
+)
+
+func Test_HealthKey_OutputCorrect(t *testing.T) {
+	untyped.TestHookSetPartitionDuration(time.Hour)
+	partitionId := untyped.GetPartitionId(someTs)
+	k := NewHealthKey(partitionId, someKind, someNamespace, someName, someUid)
+	assert.Equal(t, someHealthKey, k.String())
+}
+
+func Test_HealthKey_ParseCorrect(t *testing.T) {
+	untyped.TestHookSetPartitionDuration(time.Hour)
+	k := &HealthKey{}
+	err := k.Parse(someHealthKey)
+	assert.Nil(t, err)
+	assert.Equal(t, someMinPartition, k.PartitionId)
+	assert.Equal(t, someKind, k.Kind)
+	assert.Equal(t, someNamespace, k.Namespace)
+	assert.Equal(t, someName, k.Name)
+	assert.Equal(t, someUid, k.Uid)
+}
+
+func Test_HealthKey_ValidateWorks(t *testing.T) {
+	assert.Nil(t, (&HealthKey{}).ValidateKey(someHealthKey))
+	assert.NotNil(t, (&HealthKey{}).ValidateKey(someWatchActivityKey))
+}
+
+func Test_ResourceHealth_PutThenGet_SameData(t *testing.T) {
+	db, ht := helper_update_ResourceHealthTable(t, (&HealthKey{}).SetTestKeys(), (&HealthKey{}).SetTestValue())
+	var retval *ResourceHealth
+	var missing *ResourceHealth
+	err := db.View(func(txn badgerwrap.Txn) error {
+		var txerr error
+		retval, txerr = ht.GetOrDefault(txn, someHealthKey)
+		if txerr != nil {
+			return txerr
+		}
+		missing, txerr = ht.GetOrDefault(txn, NewHealthKey(someMinPartition, someKind, someNamespace, "othername", someUid).String())
+		return txerr
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, (&HealthKey{}).SetTestValue().Changes, retval.Changes)
+	assert.Len(t, missing.Changes, 0)
+}
+
+func Test_ResourceHealth_TestGetMinMaxPartitions(t *testing.T) {
+	db, ht := helper_update_ResourceHealthTable(t, (&HealthKey{}).SetTestKeys(), (&HealthKey{}).SetTestValue())
+	var minPartition string
+	var maxPartition string
+	var found bool
+	err := db.View(func(txn badgerwrap.Txn) error {
+		found, minPartition, maxPartition = ht.GetMinMaxPartitions(txn)
+		return nil
+	})
+
+	assert.Nil(t, err)
+	assert.True(t, found)
+	assert.Equal(t, someMinPartition, minPartition)
+	assert.Equal(t, someMaxPartition, maxPartition)
+}
+
+func (*HealthKey) GetTestKey() string {
+	k := NewHealthKey(someMinPartition, someKind, someNamespace, someName, someUid)
+	return k.String()
+}
+
+func (*HealthKey) GetTestValue() *ResourceHealth {
+	return &ResourceHealth{}
+}
+
+func (*HealthKey) SetTestKeys() []string {
+	untyped.TestHookSetPartitionDuration(time.Hour)
+	var keys []string
+	var partitionId string
+	gap := 0
+	for i := 'a'; i < 'd'; i++ {
+		// add keys in ascending order
+		partitionId = untyped.GetPartitionId(someTs.Add(time.Hour * time.Duration(gap)))
+		keys = append(keys, NewHealthKey(partitionId, someKind, someNamespace, someName, someUid).String())
+		keys = append(keys, NewHealthKey(partitionId, someKind, someNamespace, someName, someUid+string(i)).String())
+		gap++
+	}
+	return keys
+}
+
+func (*HealthKey) SetTestValue() *ResourceHealth {
+	return &ResourceHealth{Changes: []*HealthChange{{Timestamp: someTs.Unix(), State: "Running", Severity: "healthy"}}}
+}
@@ -0,0 +1,83 @@
+/*
+ * Copyright (c) 2021, salesforce.com, inc.
+ * All rights reserved.
+ * SPDX-License-Identifier: BSD-3-Clause
+ * For full license text, see LICENSE.txt file in the repo root or https://opensource.org/licenses/BSD-3-Clause
+ */
+
+package typed
+
+import (
+	"fmt"
+	"github.com/dgraph-io/badger/v2"
+	"github.com/salesforce/sloop/pkg/sloop/common"
+	"github.com/salesforce/sloop/pkg/sloop/store/untyped/badgerwrap"
+)
+
+// Key is /<partition>/<namespace>/<name>/<uid>/<container>
+//
+// Partition is UnixSeconds rounded down to partition duration
+// Namespace is kubernetes namespace of the pod, all lower
+// Name is kubernetes name of the pod, all lower
+// Uid is kubernetes $.metadata.uid of the pod
+// Container is the name of the container, which is unique over the containers and init containers of the pod
+//
+// Only pods have containers, so the kind is not part of the key
+
+type RestartKey struct {
+	PartitionId string
+	Namespace   string
+	Name        string
+	Uid         string
+	Container   string
+}
+
+func NewRestartKey(partitionId string, namespace string, name string, uid string, container string) *RestartKey {
+	return &RestartKey{PartitionId: partitionId, Namespace: namespace, Name: name, Uid: uid, Container: container}
+}
+
+func (*RestartKey) TableName() string {
+	return "restart"
+}
+
+func (k *RestartKey) Parse(key string) error {
+	err, parts := common.ParseKey(key)
+	if err != nil {
+		return err
+	}
+
+	if parts[1] != k.TableName() {
+		return fmt.Errorf("Second part of key (%v) should be %v", key, k.TableName())
+	}
+	k.PartitionId = parts[2]
+	k.Namespace = parts[3]
+	k.Name = parts[4]
+	k.Uid = parts[5]
+	k.Container = parts[6]
+	return nil
+}
+
+func (k *RestartKey) String() string {
+	return fmt.Sprintf("/%v/%v/%v/%v/%v/%v", k.TableName(), k.PartitionId, k.Namespace, k.Name, k.Uid, k.Container)
+}
+
+func (*RestartKey) ValidateKey(key string) error {
+	newKey := RestartKey{}
+	return newKey.Parse(key)
+}
+
+func (k *RestartKey) SetPartitionId(newPartitionId string) {
+	k.PartitionId = newPartitionId
+}
+
+func (t *ContainerRestartsTable) GetOrDefault(txn badgerwrap.Txn, key string) (*ContainerRestarts, error) {
+	rec, err := t.Get(txn, key)
+	if err != nil {
+		if err != badger.ErrKeyNotFound {
+			return nil, err
+		} else {
+			return &ContainerRestarts{}, nil
+		}
+	}
+	return rec, nil
+}
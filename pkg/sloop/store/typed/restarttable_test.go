@@ -0,0 +1,91 @@
+/*
+ * Copyright (c) 2021, salesforce.com, inc.
+ * All rights reserved.
+ * SPDX-License-Identifier: BSD-3-Clause
+ * For full license text, see LICENSE.txt file in the repo root or https://opensource.org/licenses/BSD-3-Clause
+ */
+
+package typed
+
+import (
+	"github.com/salesforce/sloop/pkg/sloop/store/untyped"
+	"github.com/salesforce/sloop/pkg/sloop/store/untyped/badgerwrap"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+const (
+	someContainer  = "somecontainer"
+	someRestartKey = "/restart/001546398000/somenamespace/somename/68510937-4ffc-11e9-8e26-1418775557c8/somecontainer"
+)
+
+func Test_RestartKey_OutputCorrect(t *testing.T) {
+	untyped.TestHookSetPartitionDuration(time.Hour)
+	partitionId := untyped.GetPartitionId(someTs)
+	k := NewRestartKey(partitionId, someNamespace, someName, someUid, someContainer)
+	assert.Equal(t, someRestartKey, k.String())
+}
+
+func Test_RestartKey_ParseCorrect(t *testing.T) {
+	untyped.TestHookSetPartitionDuration(time.Hour)
+	k := &RestartKey{}
+	err := k.Parse(someRestartKey)
+	assert.Nil(t, err)
+	assert.Equal(t, someMinPartition, k.PartitionId)
+	assert.Equal(t, someNamespace, k.Namespace)
+	assert.Equal(t, someName, k.Name)
+	assert.Equal(t, someUid, k.Uid)
+	assert.Equal(t, someContainer, k.Container)
+}
+
+func Test_RestartKey_ValidateWorks(t *testing.T) {
+	assert.Nil(t, (&RestartKey{}).ValidateKey(someRestartKey))
+	assert.NotNil(t, (&RestartKey{}).ValidateKey(someHealthKey))
+}
+
+func Test_ContainerRestarts_PutThenGet_SameData(t *testing.T) {
+	db, rt := helper_update_ContainerRestartsTable(t, (&RestartKey{}).SetTestKeys(), (&RestartKey{}).SetTestValue())
+	var retval *ContainerRestarts
+	var missing *ContainerRestarts
+	err := db.View(func(txn badgerwrap.Txn) error {
+		var txerr error
+		retval, txerr = rt.GetOrDefault(txn, someRestartKey)
+		if txerr != nil {
+			return txerr
+		}
+		missing, txerr = rt.GetOrDefault(txn, NewRestartKey(someMinPartition, someNamespace, someName, someUid, "othercontainer").String())
+		return txerr
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, (&RestartKey{}).SetTestValue().Restarts, retval.Restarts)
+	assert.Len(t, missing.Restarts, 0)
+}
+
+func (*RestartKey) GetTestKey() string {
+	k := NewRestartKey(someMinPartition, someNamespace, someName, someUid, someContainer)
+	return k.String()
+}
+
+func (*RestartKey) GetTestValue() *ContainerRestarts {
+	return &ContainerRestarts{}
+}
+
+func (*RestartKey) SetTestKeys() []string {
+	untyped.TestHookSetPartitionDuration(time.Hour)
+	var keys []string
+	var partitionId string
+	gap := 0
+	for i := 'a'; i < 'd'; i++ {
+		// add keys in ascending order
+		partitionId = untyped.GetPartitionId(someTs.Add(time.Hour * time.Duration(gap)))
+		keys = append(keys, NewRestartKey(partitionId, someNamespace, someName, someUid, someContainer).String())
+		keys = append(keys, NewRestartKey(partitionId, someNamespace, someName, someUid, someContainer+string(i)).String())
+		gap++
+	}
+	return keys
+}
+
+func (*RestartKey) SetTestValue() *ContainerRestarts {
+	return &ContainerRestarts{Restarts: []*ContainerRestart{{Timestamp: someTs.Unix(), Restarted: true, RestartCount: 1, Reason: "OOMKilled", ExitCode: 137}}}
+}
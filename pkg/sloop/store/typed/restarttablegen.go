@@ -0,0 +1,436 @@
+// This file was automatically generated by genny.
+// Any changes will be lost if this file is regenerated.
+// see https://github.com/cheekybits/genny
+
+/*
+ * Copyright (c) 2019, salesforce.com, inc.
+ * All rights reserved.
+ * SPDX-License-Identifier: BSD-3-Clause
+ * For full license text, see LICENSE.txt file in the repo root or https://opensource.org/licenses/BSD-3-Clause
+ */
+
+package typed
+
+import (
+	"fmt"
+	"github.com/salesforce/sloop/pkg/sloop/common"
+	"strconv"
+	"strings"
+	"time"
+
+	badger "github.com/dgraph-io/badger/v2"
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	"github.com/salesforce/sloop/pkg/sloop/store/untyped"
+	"github.com/salesforce/sloop/pkg/sloop/store/untyped/badgerwrap"
+)
+
+type ContainerRestartsTable struct {
+	tableName string
+}
+
+func OpenContainerRestartsTable() *ContainerRestartsTable {
+	keyInst := &RestartKey{}
+	return &ContainerRestartsTable{tableName: keyInst.TableName()}
+}
+
+func (t *ContainerRestartsTable) Set(txn badgerwrap.Txn, key string, value *ContainerRestarts) error {
+	err := (&RestartKey{}).ValidateKey(key)
+	if err != nil {
+		return errors.Wrapf(err, "invalid key for table %v: %v", t.tableName, key)
+	}
+
+	outb, err := proto.Marshal(value)
+	if err != nil {
+		return errors.Wrapf(err, "protobuf marshal for table %v failed", t.tableName)
+	}
+
+	err = txn.Set([]byte(key), outb)
+	if err != nil {
+		return errors.Wrapf(err, "set for table %v failed", t.tableName)
+	}
+	return nil
+}
+
+func (t *ContainerRestartsTable) Get(txn badgerwrap.Txn, key string) (*ContainerRestarts, error) {
+	err := (&RestartKey{}).ValidateKey(key)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid key for table %v: %v", t.tableName, key)
+	}
+
+	item, err := txn.Get([]byte(key))
+	if err == badger.ErrKeyNotFound {
+		// Dont wrap. Need to preserve error type
+		return nil, err
+	} else if err != nil {
+		return nil, errors.Wrapf(err, "get failed for table %v", t.tableName)
+	}
+
+	valueBytes, err := item.ValueCopy([]byte{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "value copy failed for table %v", t.tableName)
+	}
+
+	retValue := &ContainerRestarts{}
+	err = proto.Unmarshal(valueBytes, retValue)
+	if err != nil {
+		return nil, errors.Wrapf(err, "protobuf unmarshal failed for table %v on value length %v", t.tableName, len(valueBytes))
+	}
+	err = expandStoredValue(txn, key, retValue)
+	if errors.Cause(err) == errMissingKeyframe {
+		// Only while the partition is being dropped, so the value is as good as gone too
+		return nil, badger.ErrKeyNotFound
+	} else if err != nil {
+		return nil, errors.Wrapf(err, "failed to expand value for table %v key %v", t.tableName, key)
+	}
+	return retValue, nil
+}
+
+func (t *ContainerRestartsTable) GetMinKey(txn badgerwrap.Txn) (bool, string) {
+	keyPrefix := "/" + t.tableName + "/"
+	iterOpt := badger.DefaultIteratorOptions
+	iterOpt.Prefix = []byte(keyPrefix)
+	iterator := txn.NewIterator(iterOpt)
+	defer iterator.Close()
+	iterator.Seek([]byte(keyPrefix))
+	if !iterator.ValidForPrefix([]byte(keyPrefix)) {
+		return false, ""
+	}
+	return true, string(iterator.Item().Key())
+}
+
+func (t *ContainerRestartsTable) GetMaxKey(txn badgerwrap.Txn) (bool, string) {
+	keyPrefix := "/" + t.tableName + "/"
+	iterOpt := badger.DefaultIteratorOptions
+	iterOpt.Prefix = []byte(keyPrefix)
+	iterOpt.Reverse = true
+	iterator := txn.NewIterator(iterOpt)
+	defer iterator.Close()
+	// We need to seek to the end of the range so we add a 255 character at the end
+	iterator.Seek([]byte(keyPrefix + string(rune(255))))
+	if !iterator.Valid() {
+		return false, ""
+	}
+	return true, string(iterator.Item().Key())
+}
+
+func (t *ContainerRestartsTable) GetMinMaxPartitions(txn badgerwrap.Txn) (bool, string, string) {
+	minPartitionOk, minPar := t.GetMinPartition(txn)
+
+	if !minPartitionOk {
+		return false, "", ""
+	}
+
+	maxPartitionOk, maxPar := t.GetMaxPartition(txn)
+	return maxPartitionOk, minPar, maxPar
+}
+
+func (t *ContainerRestartsTable) GetMaxPartition(txn badgerwrap.Txn) (bool, string) {
+	ok, maxKeyStr := t.GetMaxKey(txn)
+	if !ok {
+		return false, ""
+	}
+
+	maxKey := &RestartKey{}
+
+	err := maxKey.Parse(maxKeyStr)
+	if err != nil {
+		panic(fmt.Sprintf("invalid key in table: %v key: %q error: %v", t.tableName, maxKeyStr, err))
+	}
+
+	return true, maxKey.PartitionId
+}
+
+func (t *ContainerRestartsTable) GetMinPartition(txn badgerwrap.Txn) (bool, string) {
+	ok, minKeyStr := t.GetMinKey(txn)
+	if !ok {
+		return false, ""
+	}
+
+	minKey := &RestartKey{}
+
+	err := minKey.Parse(minKeyStr)
+	if err != nil {
+		panic(fmt.Sprintf("invalid key in table: %v key: %q error: %v", t.tableName, minKeyStr, err))
+	}
+
+	return true, minKey.PartitionId
+}
+
+func (t *ContainerRestartsTable) GetUniquePartitionList(txn badgerwrap.Txn) ([]string, error) {
+	resources := []string{}
+	ok, minPar, maxPar := t.GetMinMaxPartitions(txn)
+	if ok {
+		parDuration := untyped.GetPartitionDuration()
+		for curPar := minPar; curPar <= maxPar; {
+			resources = append(resources, curPar)
+			// update curPar
+			partInt, err := strconv.ParseInt(curPar, 10, 64)
+			if err != nil {
+				return resources, errors.Wrapf(err, "failed to get partition:%v", curPar)
+			}
+			parTime := time.Unix(partInt, 0).UTC().Add(parDuration)
+			curPar = untyped.GetPartitionId(parTime)
+		}
+	}
+	return resources, nil
+}
+
+func (t *ContainerRestartsTable) GetPreviousKey(txn badgerwrap.Txn, key *RestartKey, keyComparator *RestartKey) (*RestartKey, error) {
+	partitionList, err := t.GetUniquePartitionList(txn)
+	if err != nil {
+		return &RestartKey{}, errors.Wrapf(err, "failed to get partition list from table:%v", t.tableName)
+	}
+	currentPartition := key.PartitionId
+	for i := len(partitionList) - 1; i >= 0; i-- {
+		prePart := partitionList[i]
+		if prePart > currentPartition {
+			continue
+		} else {
+			prevFound, prevKey, err := t.getLastMatchingKeyInPartition(txn, prePart, key, keyComparator)
+			if err != nil {
+				return &RestartKey{}, errors.Wrapf(err, "Failure getting previous key for %v, for partition id:%v", key.String(), prePart)
+			}
+			if prevFound && err == nil {
+				return prevKey, nil
+			}
+		}
+	}
+	return &RestartKey{}, fmt.Errorf("failed to get any previous key in table:%v, for key:%v, keyComparator:%v", t.tableName, key.String(), keyComparator)
+}
+
+func (t *ContainerRestartsTable) getLastMatchingKeyInPartition(txn badgerwrap.Txn, curPartition string, curKey *RestartKey, keyComparator *RestartKey) (bool, *RestartKey, error) {
+	iterOpt := badger.DefaultIteratorOptions
+	iterOpt.Reverse = true
+	itr := txn.NewIterator(iterOpt)
+	defer itr.Close()
+
+	oldKey := curKey.String()
+
+	// update partition with current value
+	curKey.SetPartitionId(curPartition)
+	keyComparator.SetPartitionId(curPartition)
+
+	keySeekStr := curKey.String() + string(rune(255))
+	itr.Seek([]byte(keySeekStr))
+
+	// if the result is same as key, we want to check its previous one
+	if itr.Valid() && oldKey == string(itr.Item().Key()) {
+		itr.Next()
+	}
+
+	if itr.ValidForPrefix([]byte(keyComparator.String())) {
+		key := &RestartKey{}
+		err := key.Parse(string(itr.Item().Key()))
+		if err != nil {
+			return true, &RestartKey{}, err
+		}
+		return true, key, nil
+	}
+	return false, &RestartKey{}, nil
+}
+
+// GetLastValue returns the newest value matching keyComparator.  It looks in the partition of endTime first, and then
+// in earlier partitions down to the one of startTime, so a value written just before a partition boundary is still
+// found.  Returns nils when there is none
+func (t *ContainerRestartsTable) GetLastValue(txn badgerwrap.Txn, keyComparator *RestartKey, startTime time.Time, endTime time.Time) (*RestartKey, *ContainerRestarts, error) {
+	// A copy, so the caller's key keeps the partition it had
+	comparator := *keyComparator
+	ok, minPartition, maxPartition := t.GetMinMaxPartitions(txn)
+	if !ok {
+		return nil, nil, nil
+	}
+	startPartition := untyped.GetPartitionId(startTime)
+	if startPartition < minPartition {
+		startPartition = minPartition
+	}
+	// No need to walk through partitions that are not there yet
+	if untyped.GetPartitionId(endTime) > maxPartition {
+		maxPartitionStartTime, _, err := untyped.GetTimeRangeForPartition(maxPartition)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to get time range for partition:%v", maxPartition)
+		}
+		endTime = maxPartitionStartTime
+	}
+
+	for curTime := endTime; untyped.GetPartitionId(curTime) >= startPartition; curTime = curTime.Add(-untyped.GetPartitionDuration()) {
+		curPartition := untyped.GetPartitionId(curTime)
+		comparator.SetPartitionId(curPartition)
+		keyPrefix := comparator.String()
+
+		iterOpt := badger.DefaultIteratorOptions
+		iterOpt.Prefix = []byte(keyPrefix)
+		iterOpt.Reverse = true
+		itr := txn.NewIterator(iterOpt)
+		// Badger reverse seek needs 255 at the end of the prefix to start from the last key
+		itr.Seek([]byte(keyPrefix + string(rune(255))))
+		if !itr.ValidForPrefix([]byte(keyPrefix)) {
+			itr.Close()
+			continue
+		}
+		keyStr := string(itr.Item().Key())
+		itr.Close()
+
+		key := &RestartKey{}
+		err := key.Parse(keyStr)
+		if err != nil {
+			return nil, nil, err
+		}
+		value, err := t.Get(txn, keyStr)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to get last value for %v in table:%v", keyStr, t.tableName)
+		}
+		return key, value, nil
+	}
+	return nil, nil, nil
+}
+
+func (t *ContainerRestartsTable) RangeRead(txn badgerwrap.Txn, keyPrefix *RestartKey,
+	keyPredicateFn func(string) bool, valPredicateFn func(*ContainerRestarts) bool, startTime time.Time, endTime time.Time) (map[RestartKey]*ContainerRestarts, RangeReadStats, error) {
+	resources := map[RestartKey]*ContainerRestarts{}
+
+	stats := RangeReadStats{}
+	before := time.Now()
+
+	partitionList, err := t.GetPartitionsFromTimeRange(txn, startTime, endTime)
+	stats.PartitionCount = len(partitionList)
+	if err != nil {
+		return resources, stats, errors.Wrapf(err, "failed to get partitions from table:%v, from startTime:%v, to endTime:%v", t.tableName, startTime, endTime)
+	}
+
+	for _, currentPartition := range partitionList {
+		var seekStr string
+
+		// when keyPrefix does not have such info as kind,namespace,and etc, we seek from /tableName/currentPartition/
+		if keyPrefix == nil {
+			seekStr = "/" + t.tableName + "/" + currentPartition + "/"
+		} else {
+			// update keyPrefix with current partition
+			keyPrefix.SetPartitionId(currentPartition)
+			seekStr = keyPrefix.String()
+		}
+
+		itr := txn.NewIterator(badger.IteratorOptions{Prefix: []byte(seekStr)})
+		defer itr.Close()
+
+		//in worst case, when seekStr = /table/partition, we need to iterate a key list and return all of them
+		//in most cases, we should only hit one result per partition
+		for itr.Seek([]byte(seekStr)); itr.ValidForPrefix([]byte(seekStr)); itr.Next() {
+			stats.RowsVisitedCount += 1
+			if keyPredicateFn != nil {
+				if !keyPredicateFn(string(itr.Item().Key())) {
+					continue
+				}
+			}
+			key := RestartKey{}
+			err := key.Parse(string(itr.Item().Key()))
+			if err != nil {
+				return nil, stats, err
+			}
+
+			stats.RowsPassedKeyPredicateCount += 1
+
+			valueBytes, err := itr.Item().ValueCopy([]byte{})
+			if err != nil {
+				return nil, stats, err
+			}
+			retValue := &ContainerRestarts{}
+			err = proto.Unmarshal(valueBytes, retValue)
+			if err != nil {
+				return nil, stats, err
+			}
+			err = expandStoredValue(txn, string(itr.Item().Key()), retValue)
+			if errors.Cause(err) == errMissingKeyframe {
+				// Only while the partition is being dropped
+				stats.RowsMissingKeyframeCount += 1
+				continue
+			}
+			if err != nil {
+				return nil, stats, err
+			}
+			if valPredicateFn != nil && !valPredicateFn(retValue) {
+				continue
+			}
+			stats.RowsPassedValuePredicateCount += 1
+			resources[key] = retValue
+		}
+
+		//Close() is safe to call more than once, close at the end of each partition to avoid having old iterators open
+		itr.Close()
+	}
+
+	stats.Elapsed = time.Since(before)
+	stats.TableName = (&RestartKey{}).TableName()
+	return resources, stats, nil
+}
+
+//todo: need to add unit test
+func (t *ContainerRestartsTable) GetPartitionsFromTimeRange(txn badgerwrap.Txn, startTime time.Time, endTime time.Time) ([]string, error) {
+	resources := []string{}
+	startPartition := untyped.GetPartitionId(startTime)
+	endPartition := untyped.GetPartitionId(endTime)
+	parDuration := untyped.GetPartitionDuration()
+	for curPar := startPartition; curPar <= endPartition; {
+		resources = append(resources, curPar)
+		// update curPar
+		partInt, err := strconv.ParseInt(curPar, 10, 64)
+		if err != nil {
+			return resources, errors.Wrapf(err, "failed to get partition:%v", curPar)
+		}
+		parTime := time.Unix(partInt, 0).UTC().Add(parDuration)
+		curPar = untyped.GetPartitionId(parTime)
+	}
+	return resources, nil
+}
+
+func ContainerRestarts_ValPredicateFns(valFn ...func(*ContainerRestarts) bool) func(*ContainerRestarts) bool {
+	return func(result *ContainerRestarts) bool {
+		for _, thisFn := range valFn {
+			if !thisFn(result) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+func ContainerRestarts_KeyPredicateFns(keyFn ...func(string) bool) func(string) bool {
+	return func(result string) bool {
+		for _, thisFn := range keyFn {
+			if !thisFn(result) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// Return all keys in all partitions in the given a lookback period
+func (t *ContainerRestartsTable) GetAllKeysForGivenPartitions(db badgerwrap.DB, key *RestartKey, maxNumberOfKeys int, lookBack int, keyPrefix string) []string {
+	var keys []string
+	var partitionList []string
+	_ = db.View(func(txn badgerwrap.Txn) error {
+		partitionList, _ = t.GetUniquePartitionList(txn)
+		return nil
+	})
+
+	count := 0
+	lookBackVal := lookBack
+
+	if len(partitionList) < lookBack {
+		lookBackVal = len(partitionList)
+	}
+
+	for i := len(partitionList) - 1; i >= len(partitionList)-lookBackVal; i-- {
+		prePart := partitionList[i]
+		key.SetPartitionId(prePart)
+		keyValue := strings.TrimRight(key.String(), "/") + keyPrefix
+		keys = append(keys, common.GetKeysForPrefix(db, keyValue)...)
+		count += len(keys)
+		if count >= maxNumberOfKeys {
+			return keys
+		}
+	}
+
+	return keys
+}
@@ -0,0 +1,85 @@
+/*
+ * Copyright (c) 2021, salesforce.com, inc.
+ * All rights reserved.
+ * SPDX-License-Identifier: BSD-3-Clause
+ * For full license text, see LICENSE.txt file in the repo root or https://opensource.org/licenses/BSD-3-Clause
+ */
+
+package typed
+
+import (
+	"fmt"
+	"github.com/dgraph-io/badger/v2"
+	"github.com/salesforce/sloop/pkg/sloop/common"
+	"github.com/salesforce/sloop/pkg/sloop/store/untyped/badgerwrap"
+)
+
+// Key is /<partition>/<kind>/<namespace>/<name>/<uid>
+//
+// Partition is UnixSeconds rounded down to partition duration
+// Kind is kubernetes kind, starts with upper case
+// Namespace is kubernetes namespace, all lower
+// Name is kubernetes name, all lower
+// Uid is kubernetes $.metadata.uid
+
+type RolloutKey struct {
+	PartitionId string
+	Kind        string
+	Namespace   string
+	Name        string
+	Uid         string
+}
+
+func NewRolloutKey(partitionId string, kind string, namespace string, name string, uid string) *RolloutKey {
+	return &RolloutKey{PartitionId: partitionId, Kind: kind, Namespace: namespace, Name: name, Uid: uid}
+}
+
+func NewRolloutKeyComparator(kind string, namespace string, name string, uid string) *RolloutKey {
+	return &RolloutKey{Kind: kind, Namespace: namespace, Name: name, Uid: uid}
+}
+
+func (*RolloutKey) TableName() string {
+	return "rollout"
+}
+
+func (k *RolloutKey) Parse(key string) error {
+	err, parts := common.ParseKey(key)
+	if err != nil {
+		return err
+	}
+
+	if parts[1] != k.TableName() {
+		return fmt.Errorf("Second part of key (%v) should be %v", key, k.TableName())
+	}
+	k.PartitionId = parts[2]
+	k.Kind = parts[3]
+	k.Namespace = parts[4]
+	k.Name = parts[5]
+	k.Uid = parts[6]
+	return nil
+}
+
+func (k *RolloutKey) String() string {
+	return fmt.Sprintf("/%v/%v/%v/%v/%v/%v", k.TableName(), k.PartitionId, k.Kind, k.Namespace, k.Name, k.Uid)
+}
+
+func (*RolloutKey) ValidateKey(key string) error {
+	newKey := RolloutKey{}
+	return newKey.Parse(key)
+}
+
+func (k *RolloutKey) SetPartitionId(newPartitionId string) {
+	k.PartitionId = newPartitionId
+}
+
+func (t *WorkloadRolloutsTable) GetOrDefault(txn badgerwrap.Txn, key string) (*WorkloadRollouts, error) {
+	rec, err := t.Get(txn, key)
+	if err != nil {
+		if err != badger.ErrKeyNotFound {
+			return nil, err
+		} else {
+			return &WorkloadRollouts{}, nil
+		}
+	}
+	return rec, nil
+}
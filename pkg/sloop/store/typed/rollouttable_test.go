@@ -0,0 +1,106 @@
+/*
+ * Copyright (c) 2021, salesforce.com, inc.
+ * All rights reserved.
+ * SPDX-License-Identifier: BSD-3-Clause
+ * For full license text, see LICENSE.txt file in the repo root or https://opensource.org/licenses/BSD-3-Clause
+ */
+
+package typed
+
+import (
+	"github.com/salesforce/sloop/pkg/sloop/store/untyped"
+	"github.com/salesforce/sloop/pkg/sloop/store/untyped/badgerwrap"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+const (
+	someRolloutKey = "/rollout/001546398000/somekind/somenamespace/somename/68510937-4ffc-11e9-8e26-1418775557c8"
+)
+
+func Test_RolloutKey_OutputCorrect(t *testing.T) {
+	untyped.TestHookSetPartitionDuration(time.Hour)
+	partitionId := untyped.GetPartitionId(someTs)
+	k := NewRolloutKey(partitionId, someKind, someNamespace, someName, someUid)
+	assert.Equal(t, someRolloutKey, k.String())
+}
+
+func Test_RolloutKey_ParseCorrect(t *testing.T) {
+	untyped.TestHookSetPartitionDuration(time.Hour)
+	k := &RolloutKey{}
+	err := k.Parse(someRolloutKey)
+	assert.Nil(t, err)
+	assert.Equal(t, someMinPartition, k.PartitionId)
+	assert.Equal(t, someKind, k.Kind)
+	assert.Equal(t, someNamespace, k.Namespace)
+	assert.Equal(t, someName, k.Name)
+	assert.Equal(t, someUid, k.Uid)
+}
+
+func Test_RolloutKey_ValidateWorks(t *testing.T) {
+	assert.Nil(t, (&RolloutKey{}).ValidateKey(someRolloutKey))
+	assert.NotNil(t, (&RolloutKey{}).ValidateKey(someWatchActivityKey))
+}
+
+func Test_WorkloadRollouts_PutThenGet_SameData(t *testing.T) {
+	db, ht := helper_update_WorkloadRolloutsTable(t, (&RolloutKey{}).SetTestKeys(), (&RolloutKey{}).SetTestValue())
+	var retval *WorkloadRollouts
+	var missing *WorkloadRollouts
+	err := db.View(func(txn badgerwrap.Txn) error {
+		var txerr error
+		retval, txerr = ht.GetOrDefault(txn, someRolloutKey)
+		if txerr != nil {
+			return txerr
+		}
+		missing, txerr = ht.GetOrDefault(txn, NewRolloutKey(someMinPartition, someKind, someNamespace, "othername", someUid).String())
+		return txerr
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, (&RolloutKey{}).SetTestValue().Rollouts, retval.Rollouts)
+	assert.Len(t, missing.Rollouts, 0)
+}
+
+func Test_WorkloadRollouts_TestGetMinMaxPartitions(t *testing.T) {
+	db, ht := helper_update_WorkloadRolloutsTable(t, (&RolloutKey{}).SetTestKeys(), (&RolloutKey{}).SetTestValue())
+	var minPartition string
+	var maxPartition string
+	var found bool
+	err := db.View(func(txn badgerwrap.Txn) error {
+		found, minPartition, maxPartition = ht.GetMinMaxPartitions(txn)
+		return nil
+	})
+
+	assert.Nil(t, err)
+	assert.True(t, found)
+	assert.Equal(t, someMinPartition, minPartition)
+	assert.Equal(t, someMaxPartition, maxPartition)
+}
+
+func (*RolloutKey) GetTestKey() string {
+	k := NewRolloutKey(someMinPartition, someKind, someNamespace, someName, someUid)
+	return k.String()
+}
+
+func (*RolloutKey) GetTestValue() *WorkloadRollouts {
+	return &WorkloadRollouts{}
+}
+
+func (*RolloutKey) SetTestKeys() []string {
+	untyped.TestHookSetPartitionDuration(time.Hour)
+	var keys []string
+	var partitionId string
+	gap := 0
+	for i := 'a'; i < 'd'; i++ {
+		// add keys in ascending order
+		partitionId = untyped.GetPartitionId(someTs.Add(time.Hour * time.Duration(gap)))
+		keys = append(keys, NewRolloutKey(partitionId, someKind, someNamespace, someName, someUid).String())
+		keys = append(keys, NewRolloutKey(partitionId, someKind, someNamespace, someName, someUid+string(i)).String())
+		gap++
+	}
+	return keys
+}
+
+func (*RolloutKey) SetTestValue() *WorkloadRollouts {
+	return &WorkloadRollouts{Rollouts: []*Rollout{{Start: someTs.Unix(), LastUpdate: someTs.Unix(), Outcome: "progressing", NewImages: []string{"app=nginx:1.21"}}}}
+}
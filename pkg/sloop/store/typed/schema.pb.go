@@ -50,13 +50,18 @@ func (KubeWatchResult_WatchType) EnumDescriptor() ([]byte, []int) {
 }
 
 type KubeWatchResult struct {
-	Timestamp            *timestamp.Timestamp      `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Kind                 string                    `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
-	WatchType            KubeWatchResult_WatchType `protobuf:"varint,3,opt,name=watchType,proto3,enum=typed.KubeWatchResult_WatchType" json:"watchType,omitempty"`
-	Payload              string                    `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
-	XXX_unrecognized     []byte                    `json:"-"`
-	XXX_sizecache        int32                     `json:"-"`
+	Timestamp *timestamp.Timestamp      `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Kind      string                    `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	WatchType KubeWatchResult_WatchType `protobuf:"varint,3,opt,name=watchType,proto3,enum=typed.KubeWatchResult_WatchType" json:"watchType,omitempty"`
+	Payload   string                    `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
+	// The watch sent an object it had already reported rather than a change, like the list after a restart or a resync
+	Replay bool `protobuf:"varint,5,opt,name=replay,proto3" json:"replay,omitempty"`
+	// Set when payload is a json merge patch (RFC 7386) against the full payload stored at this time for the same object
+	// in the same partition.  Reads of the watch table put the full payload back and clear this
+	PayloadKeyframe      *timestamp.Timestamp `protobuf:"bytes,6,opt,name=payloadKeyframe,proto3" json:"payloadKeyframe,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *KubeWatchResult) Reset()         { *m = KubeWatchResult{} }
@@ -112,6 +117,20 @@ func (m *KubeWatchResult) GetPayload() string {
 	return ""
 }
 
+func (m *KubeWatchResult) GetReplay() bool {
+	if m != nil {
+		return m.Replay
+	}
+	return false
+}
+
+func (m *KubeWatchResult) GetPayloadKeyframe() *timestamp.Timestamp {
+	if m != nil {
+		return m.PayloadKeyframe
+	}
+	return nil
+}
+
 // Enough information to draw a timeline and hierarchy
 // Key: /<kind>/<namespace>/<name>/<uid>
 type ResourceSummary struct {
@@ -125,10 +144,12 @@ type ResourceSummary struct {
 	// A node might have a relationship to a rack (maybe latery, as this is virtual)
 	// We dont need relationships in both directions.  We can union them at query time
 	// Uses same key format here as this overall table
-	Relationships        []string `protobuf:"bytes,5,rep,name=relationships,proto3" json:"relationships,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	// Only owner references.  Edges has these too, along with everything else the payload points at
+	Relationships        []string        `protobuf:"bytes,5,rep,name=relationships,proto3" json:"relationships,omitempty"`
+	Edges                []*Relationship `protobuf:"bytes,6,rep,name=edges,proto3" json:"edges,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *ResourceSummary) Reset()         { *m = ResourceSummary{} }
@@ -191,6 +212,93 @@ func (m *ResourceSummary) GetRelationships() []string {
 	return nil
 }
 
+func (m *ResourceSummary) GetEdges() []*Relationship {
+	if m != nil {
+		return m.Edges
+	}
+	return nil
+}
+
+// An edge from a resource to another one named in its payload, like the node of a pod or the claim it mounts
+type Relationship struct {
+	Type                 string   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Kind                 string   `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Namespace            string   `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name                 string   `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Uid                  string   `protobuf:"bytes,5,opt,name=uid,proto3" json:"uid,omitempty"`
+	Selector             string   `protobuf:"bytes,6,opt,name=selector,proto3" json:"selector,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Relationship) Reset()         { *m = Relationship{} }
+func (m *Relationship) String() string { return proto.CompactTextString(m) }
+func (*Relationship) ProtoMessage()    {}
+func (*Relationship) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{2}
+}
+
+func (m *Relationship) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Relationship.Unmarshal(m, b)
+}
+func (m *Relationship) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Relationship.Marshal(b, m, deterministic)
+}
+func (m *Relationship) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Relationship.Merge(m, src)
+}
+func (m *Relationship) XXX_Size() int {
+	return xxx_messageInfo_Relationship.Size(m)
+}
+func (m *Relationship) XXX_DiscardUnknown() {
+	xxx_messageInfo_Relationship.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Relationship proto.InternalMessageInfo
+
+func (m *Relationship) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *Relationship) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *Relationship) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *Relationship) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Relationship) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *Relationship) GetSelector() string {
+	if m != nil {
+		return m.Selector
+	}
+	return ""
+}
+
 type EventCounts struct {
 	MapReasonToCount     map[string]int32 `protobuf:"bytes,1,rep,name=mapReasonToCount,proto3" json:"mapReasonToCount,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
@@ -202,7 +310,7 @@ func (m *EventCounts) Reset()         { *m = EventCounts{} }
 func (m *EventCounts) String() string { return proto.CompactTextString(m) }
 func (*EventCounts) ProtoMessage()    {}
 func (*EventCounts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{2}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{3}
 }
 
 func (m *EventCounts) XXX_Unmarshal(b []byte) error {
@@ -241,7 +349,7 @@ func (m *ResourceEventCounts) Reset()         { *m = ResourceEventCounts{} }
 func (m *ResourceEventCounts) String() string { return proto.CompactTextString(m) }
 func (*ResourceEventCounts) ProtoMessage()    {}
 func (*ResourceEventCounts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{3}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{4}
 }
 
 func (m *ResourceEventCounts) XXX_Unmarshal(b []byte) error {
@@ -284,7 +392,7 @@ func (m *WatchActivity) Reset()         { *m = WatchActivity{} }
 func (m *WatchActivity) String() string { return proto.CompactTextString(m) }
 func (*WatchActivity) ProtoMessage()    {}
 func (*WatchActivity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{4}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{5}
 }
 
 func (m *WatchActivity) XXX_Unmarshal(b []byte) error {
@@ -319,51 +427,548 @@ func (m *WatchActivity) GetChangedAt() []int64 {
 	return nil
 }
 
+// Status of a resource within partition, derived from its payloads.  A change is only added when the state moves, so
+// each state lasts until the next change
+type ResourceHealth struct {
+	Changes              []*HealthChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ResourceHealth) Reset()         { *m = ResourceHealth{} }
+func (m *ResourceHealth) String() string { return proto.CompactTextString(m) }
+func (*ResourceHealth) ProtoMessage()    {}
+func (*ResourceHealth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{6}
+}
+
+func (m *ResourceHealth) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResourceHealth.Unmarshal(m, b)
+}
+func (m *ResourceHealth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResourceHealth.Marshal(b, m, deterministic)
+}
+func (m *ResourceHealth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceHealth.Merge(m, src)
+}
+func (m *ResourceHealth) XXX_Size() int {
+	return xxx_messageInfo_ResourceHealth.Size(m)
+}
+func (m *ResourceHealth) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceHealth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourceHealth proto.InternalMessageInfo
+
+func (m *ResourceHealth) GetChanges() []*HealthChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+type HealthChange struct {
+	Timestamp            int64    `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	State                string   `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	Severity             string   `protobuf:"bytes,3,opt,name=severity,proto3" json:"severity,omitempty"`
+	Message              string   `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HealthChange) Reset()         { *m = HealthChange{} }
+func (m *HealthChange) String() string { return proto.CompactTextString(m) }
+func (*HealthChange) ProtoMessage()    {}
+func (*HealthChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{7}
+}
+
+func (m *HealthChange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HealthChange.Unmarshal(m, b)
+}
+func (m *HealthChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HealthChange.Marshal(b, m, deterministic)
+}
+func (m *HealthChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HealthChange.Merge(m, src)
+}
+func (m *HealthChange) XXX_Size() int {
+	return xxx_messageInfo_HealthChange.Size(m)
+}
+func (m *HealthChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_HealthChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HealthChange proto.InternalMessageInfo
+
+func (m *HealthChange) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *HealthChange) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *HealthChange) GetSeverity() string {
+	if m != nil {
+		return m.Severity
+	}
+	return ""
+}
+
+func (m *HealthChange) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+// Containers of a pod that stopped within partition, oldest first.  A restart is only added when the restart count
+// goes up or the last termination changes, so a resync adds nothing
+type ContainerRestarts struct {
+	Restarts             []*ContainerRestart `protobuf:"bytes,1,rep,name=restarts,proto3" json:"restarts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ContainerRestarts) Reset()         { *m = ContainerRestarts{} }
+func (m *ContainerRestarts) String() string { return proto.CompactTextString(m) }
+func (*ContainerRestarts) ProtoMessage()    {}
+func (*ContainerRestarts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{8}
+}
+
+func (m *ContainerRestarts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContainerRestarts.Unmarshal(m, b)
+}
+func (m *ContainerRestarts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContainerRestarts.Marshal(b, m, deterministic)
+}
+func (m *ContainerRestarts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContainerRestarts.Merge(m, src)
+}
+func (m *ContainerRestarts) XXX_Size() int {
+	return xxx_messageInfo_ContainerRestarts.Size(m)
+}
+func (m *ContainerRestarts) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContainerRestarts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContainerRestarts proto.InternalMessageInfo
+
+func (m *ContainerRestarts) GetRestarts() []*ContainerRestart {
+	if m != nil {
+		return m.Restarts
+	}
+	return nil
+}
+
+type ContainerRestart struct {
+	Timestamp            int64    `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	InitContainer        bool     `protobuf:"varint,2,opt,name=initContainer,proto3" json:"initContainer,omitempty"`
+	Restarted            bool     `protobuf:"varint,3,opt,name=restarted,proto3" json:"restarted,omitempty"`
+	RestartCount         int32    `protobuf:"varint,4,opt,name=restartCount,proto3" json:"restartCount,omitempty"`
+	Reason               string   `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	ExitCode             int32    `protobuf:"varint,6,opt,name=exitCode,proto3" json:"exitCode,omitempty"`
+	Signal               int32    `protobuf:"varint,7,opt,name=signal,proto3" json:"signal,omitempty"`
+	Message              string   `protobuf:"bytes,8,opt,name=message,proto3" json:"message,omitempty"`
+	StartedAt            int64    `protobuf:"varint,9,opt,name=startedAt,proto3" json:"startedAt,omitempty"`
+	FinishedAt           int64    `protobuf:"varint,10,opt,name=finishedAt,proto3" json:"finishedAt,omitempty"`
+	ContainerId          string   `protobuf:"bytes,11,opt,name=containerId,proto3" json:"containerId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ContainerRestart) Reset()         { *m = ContainerRestart{} }
+func (m *ContainerRestart) String() string { return proto.CompactTextString(m) }
+func (*ContainerRestart) ProtoMessage()    {}
+func (*ContainerRestart) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{9}
+}
+
+func (m *ContainerRestart) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContainerRestart.Unmarshal(m, b)
+}
+func (m *ContainerRestart) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContainerRestart.Marshal(b, m, deterministic)
+}
+func (m *ContainerRestart) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContainerRestart.Merge(m, src)
+}
+func (m *ContainerRestart) XXX_Size() int {
+	return xxx_messageInfo_ContainerRestart.Size(m)
+}
+func (m *ContainerRestart) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContainerRestart.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContainerRestart proto.InternalMessageInfo
+
+func (m *ContainerRestart) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *ContainerRestart) GetInitContainer() bool {
+	if m != nil {
+		return m.InitContainer
+	}
+	return false
+}
+
+func (m *ContainerRestart) GetRestarted() bool {
+	if m != nil {
+		return m.Restarted
+	}
+	return false
+}
+
+func (m *ContainerRestart) GetRestartCount() int32 {
+	if m != nil {
+		return m.RestartCount
+	}
+	return 0
+}
+
+func (m *ContainerRestart) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *ContainerRestart) GetExitCode() int32 {
+	if m != nil {
+		return m.ExitCode
+	}
+	return 0
+}
+
+func (m *ContainerRestart) GetSignal() int32 {
+	if m != nil {
+		return m.Signal
+	}
+	return 0
+}
+
+func (m *ContainerRestart) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *ContainerRestart) GetStartedAt() int64 {
+	if m != nil {
+		return m.StartedAt
+	}
+	return 0
+}
+
+func (m *ContainerRestart) GetFinishedAt() int64 {
+	if m != nil {
+		return m.FinishedAt
+	}
+	return 0
+}
+
+func (m *ContainerRestart) GetContainerId() string {
+	if m != nil {
+		return m.ContainerId
+	}
+	return ""
+}
+
+// Rollouts of a deployment, stateful set or daemon set that were open within partition, oldest first.  A rollout
+// starts with a change of the pod template, and a rollout that is still open is copied into every partition it is
+// updated in, so the copy with the latest lastUpdate is the one to go by
+type WorkloadRollouts struct {
+	Rollouts             []*Rollout `protobuf:"bytes,1,rep,name=rollouts,proto3" json:"rollouts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *WorkloadRollouts) Reset()         { *m = WorkloadRollouts{} }
+func (m *WorkloadRollouts) String() string { return proto.CompactTextString(m) }
+func (*WorkloadRollouts) ProtoMessage()    {}
+func (*WorkloadRollouts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{10}
+}
+
+func (m *WorkloadRollouts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WorkloadRollouts.Unmarshal(m, b)
+}
+func (m *WorkloadRollouts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WorkloadRollouts.Marshal(b, m, deterministic)
+}
+func (m *WorkloadRollouts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkloadRollouts.Merge(m, src)
+}
+func (m *WorkloadRollouts) XXX_Size() int {
+	return xxx_messageInfo_WorkloadRollouts.Size(m)
+}
+func (m *WorkloadRollouts) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkloadRollouts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkloadRollouts proto.InternalMessageInfo
+
+func (m *WorkloadRollouts) GetRollouts() []*Rollout {
+	if m != nil {
+		return m.Rollouts
+	}
+	return nil
+}
+
+type Rollout struct {
+	Start                int64    `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	End                  int64    `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+	LastUpdate           int64    `protobuf:"varint,3,opt,name=lastUpdate,proto3" json:"lastUpdate,omitempty"`
+	Outcome              string   `protobuf:"bytes,4,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	Message              string   `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	OldGeneration        int64    `protobuf:"varint,6,opt,name=oldGeneration,proto3" json:"oldGeneration,omitempty"`
+	NewGeneration        int64    `protobuf:"varint,7,opt,name=newGeneration,proto3" json:"newGeneration,omitempty"`
+	OldRevision          string   `protobuf:"bytes,8,opt,name=oldRevision,proto3" json:"oldRevision,omitempty"`
+	NewRevision          string   `protobuf:"bytes,9,opt,name=newRevision,proto3" json:"newRevision,omitempty"`
+	OldTemplateHash      string   `protobuf:"bytes,10,opt,name=oldTemplateHash,proto3" json:"oldTemplateHash,omitempty"`
+	NewTemplateHash      string   `protobuf:"bytes,11,opt,name=newTemplateHash,proto3" json:"newTemplateHash,omitempty"`
+	OldImages            []string `protobuf:"bytes,12,rep,name=oldImages,proto3" json:"oldImages,omitempty"`
+	NewImages            []string `protobuf:"bytes,13,rep,name=newImages,proto3" json:"newImages,omitempty"`
+	OldReplicaSet        string   `protobuf:"bytes,14,opt,name=oldReplicaSet,proto3" json:"oldReplicaSet,omitempty"`
+	NewReplicaSet        string   `protobuf:"bytes,15,opt,name=newReplicaSet,proto3" json:"newReplicaSet,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Rollout) Reset()         { *m = Rollout{} }
+func (m *Rollout) String() string { return proto.CompactTextString(m) }
+func (*Rollout) ProtoMessage()    {}
+func (*Rollout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{11}
+}
+
+func (m *Rollout) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Rollout.Unmarshal(m, b)
+}
+func (m *Rollout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Rollout.Marshal(b, m, deterministic)
+}
+func (m *Rollout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Rollout.Merge(m, src)
+}
+func (m *Rollout) XXX_Size() int {
+	return xxx_messageInfo_Rollout.Size(m)
+}
+func (m *Rollout) XXX_DiscardUnknown() {
+	xxx_messageInfo_Rollout.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Rollout proto.InternalMessageInfo
+
+func (m *Rollout) GetStart() int64 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *Rollout) GetEnd() int64 {
+	if m != nil {
+		return m.End
+	}
+	return 0
+}
+
+func (m *Rollout) GetLastUpdate() int64 {
+	if m != nil {
+		return m.LastUpdate
+	}
+	return 0
+}
+
+func (m *Rollout) GetOutcome() string {
+	if m != nil {
+		return m.Outcome
+	}
+	return ""
+}
+
+func (m *Rollout) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *Rollout) GetOldGeneration() int64 {
+	if m != nil {
+		return m.OldGeneration
+	}
+	return 0
+}
+
+func (m *Rollout) GetNewGeneration() int64 {
+	if m != nil {
+		return m.NewGeneration
+	}
+	return 0
+}
+
+func (m *Rollout) GetOldRevision() string {
+	if m != nil {
+		return m.OldRevision
+	}
+	return ""
+}
+
+func (m *Rollout) GetNewRevision() string {
+	if m != nil {
+		return m.NewRevision
+	}
+	return ""
+}
+
+func (m *Rollout) GetOldTemplateHash() string {
+	if m != nil {
+		return m.OldTemplateHash
+	}
+	return ""
+}
+
+func (m *Rollout) GetNewTemplateHash() string {
+	if m != nil {
+		return m.NewTemplateHash
+	}
+	return ""
+}
+
+func (m *Rollout) GetOldImages() []string {
+	if m != nil {
+		return m.OldImages
+	}
+	return nil
+}
+
+func (m *Rollout) GetNewImages() []string {
+	if m != nil {
+		return m.NewImages
+	}
+	return nil
+}
+
+func (m *Rollout) GetOldReplicaSet() string {
+	if m != nil {
+		return m.OldReplicaSet
+	}
+	return ""
+}
+
+func (m *Rollout) GetNewReplicaSet() string {
+	if m != nil {
+		return m.NewReplicaSet
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("typed.KubeWatchResult_WatchType", KubeWatchResult_WatchType_name, KubeWatchResult_WatchType_value)
 	proto.RegisterType((*KubeWatchResult)(nil), "typed.KubeWatchResult")
 	proto.RegisterType((*ResourceSummary)(nil), "typed.ResourceSummary")
+	proto.RegisterType((*Relationship)(nil), "typed.Relationship")
 	proto.RegisterType((*EventCounts)(nil), "typed.EventCounts")
 	proto.RegisterMapType((map[string]int32)(nil), "typed.EventCounts.MapReasonToCountEntry")
 	proto.RegisterType((*ResourceEventCounts)(nil), "typed.ResourceEventCounts")
 	proto.RegisterMapType((map[int64]*EventCounts)(nil), "typed.ResourceEventCounts.MapMinToEventsEntry")
 	proto.RegisterType((*WatchActivity)(nil), "typed.WatchActivity")
+	proto.RegisterType((*ResourceHealth)(nil), "typed.ResourceHealth")
+	proto.RegisterType((*HealthChange)(nil), "typed.HealthChange")
+	proto.RegisterType((*ContainerRestarts)(nil), "typed.ContainerRestarts")
+	proto.RegisterType((*ContainerRestart)(nil), "typed.ContainerRestart")
+	proto.RegisterType((*WorkloadRollouts)(nil), "typed.WorkloadRollouts")
+	proto.RegisterType((*Rollout)(nil), "typed.Rollout")
 }
 
 func init() { proto.RegisterFile("schema.proto", fileDescriptor_1c5fb4d8cc22d66a) }
 
 var fileDescriptor_1c5fb4d8cc22d66a = []byte{
-	// 505 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xcf, 0x6e, 0x9b, 0x40,
-	0x10, 0xc6, 0x0b, 0xc4, 0x49, 0x18, 0xe7, 0x8f, 0xb5, 0x69, 0x25, 0x64, 0x55, 0x2d, 0x42, 0x3d,
-	0x70, 0xa8, 0x88, 0xe4, 0x4a, 0x55, 0x94, 0x43, 0x25, 0x64, 0x73, 0x6a, 0x5d, 0x55, 0x1b, 0xd2,
-	0x9c, 0xd7, 0x66, 0x62, 0xa3, 0x00, 0x8b, 0xd8, 0xc5, 0x15, 0x8f, 0xd0, 0x37, 0xe9, 0x83, 0xf4,
-	0x5d, 0xfa, 0x1a, 0x15, 0x8b, 0xb1, 0x89, 0x63, 0xb5, 0xb9, 0x0d, 0xdf, 0xfe, 0xbe, 0xe1, 0x9b,
-	0xd9, 0x85, 0x13, 0x31, 0x5f, 0x62, 0xca, 0xbc, 0xbc, 0xe0, 0x92, 0x93, 0x9e, 0xac, 0x72, 0x8c,
-	0x86, 0x6f, 0x17, 0x9c, 0x2f, 0x12, 0xbc, 0x54, 0xe2, 0xac, 0xbc, 0xbf, 0x94, 0x71, 0x8a, 0x42,
-	0xb2, 0x34, 0x6f, 0x38, 0xe7, 0x8f, 0x06, 0xe7, 0x9f, 0xcb, 0x19, 0xde, 0x31, 0x39, 0x5f, 0x52,
-	0x14, 0x65, 0x22, 0xc9, 0x15, 0x98, 0x1b, 0xcc, 0xd2, 0x6c, 0xcd, 0xed, 0x8f, 0x86, 0x5e, 0xd3,
-	0xc8, 0x6b, 0x1b, 0x79, 0x61, 0x4b, 0xd0, 0x2d, 0x4c, 0x08, 0x1c, 0x3c, 0xc4, 0x59, 0x64, 0xe9,
-	0xb6, 0xe6, 0x9a, 0x54, 0xd5, 0xe4, 0x13, 0x98, 0x3f, 0xea, 0xe6, 0x61, 0x95, 0xa3, 0x65, 0xd8,
-	0x9a, 0x7b, 0x36, 0xb2, 0x3d, 0x95, 0xce, 0xdb, 0xf9, 0xb1, 0x77, 0xd7, 0x72, 0x74, 0x6b, 0x21,
-	0x16, 0x1c, 0xe5, 0xac, 0x4a, 0x38, 0x8b, 0xac, 0x03, 0xd5, 0xb6, 0xfd, 0x74, 0xde, 0x83, 0xb9,
-	0x71, 0x90, 0x23, 0x30, 0xfc, 0xc9, 0x64, 0xf0, 0x82, 0x00, 0x1c, 0xde, 0x7e, 0x9b, 0xf8, 0x61,
-	0x30, 0xd0, 0xea, 0x7a, 0x12, 0x7c, 0x09, 0xc2, 0x60, 0xa0, 0x3b, 0x3f, 0x75, 0x38, 0xa7, 0x28,
-	0x78, 0x59, 0xcc, 0xf1, 0xa6, 0x4c, 0x53, 0x56, 0x54, 0xf5, 0xa4, 0xf7, 0x71, 0x21, 0xe4, 0x0d,
-	0x62, 0xf6, 0x9c, 0x49, 0x37, 0x30, 0xf9, 0x08, 0xc7, 0x09, 0x5b, 0x1b, 0xf5, 0xff, 0x1a, 0x37,
-	0x2c, 0xb9, 0x06, 0x98, 0x17, 0xc8, 0x24, 0xd6, 0x87, 0x6a, 0x1d, 0xff, 0x76, 0x76, 0x68, 0xe2,
-	0xc0, 0x49, 0x84, 0x09, 0x4a, 0x8c, 0x7c, 0x19, 0x64, 0xcd, 0x3a, 0x8e, 0xe9, 0x23, 0x8d, 0xbc,
-	0x83, 0xd3, 0x02, 0x13, 0x26, 0x63, 0x9e, 0x89, 0x65, 0x9c, 0x0b, 0xab, 0x67, 0x1b, 0xae, 0x49,
-	0x1f, 0x8b, 0xce, 0x2f, 0x0d, 0xfa, 0xc1, 0x0a, 0x33, 0x39, 0xe6, 0x65, 0x26, 0x05, 0x09, 0x61,
-	0x90, 0xb2, 0x9c, 0x22, 0x13, 0x3c, 0x0b, 0xb9, 0x12, 0x2d, 0xcd, 0x36, 0xdc, 0xfe, 0xc8, 0x5d,
-	0x5f, 0x55, 0x87, 0xf6, 0xa6, 0x3b, 0x68, 0x90, 0xc9, 0xa2, 0xa2, 0x4f, 0x3a, 0x0c, 0xc7, 0xf0,
-	0x6a, 0x2f, 0x4a, 0x06, 0x60, 0x3c, 0x60, 0xa5, 0x16, 0x6e, 0xd2, 0xba, 0x24, 0x2f, 0xa1, 0xb7,
-	0x62, 0x49, 0x89, 0x6a, 0x97, 0x3d, 0xda, 0x7c, 0x5c, 0xeb, 0x57, 0x9a, 0xf3, 0x5b, 0x83, 0x8b,
-	0xf6, 0xda, 0xba, 0x91, 0xbf, 0xc3, 0x59, 0xca, 0xf2, 0x69, 0x9c, 0x85, 0x5c, 0xc9, 0x62, 0x1d,
-	0xd8, 0x5b, 0x07, 0xde, 0xe3, 0xa9, 0x83, 0x77, 0x0c, 0x4d, 0xec, 0x9d, 0x2e, 0xc3, 0x5b, 0xb8,
-	0xd8, 0x83, 0x75, 0x23, 0x1b, 0x4d, 0x64, 0xb7, 0x1b, 0xb9, 0x3f, 0x22, 0x4f, 0x17, 0xd5, 0x1d,
-	0x63, 0x0a, 0xa7, 0xea, 0xad, 0xfa, 0x73, 0x19, 0xaf, 0x62, 0x59, 0x91, 0x37, 0x00, 0x5f, 0xf9,
-	0x78, 0xc9, 0xb2, 0x05, 0xfa, 0xcd, 0xb2, 0x0d, 0xda, 0x51, 0xc8, 0x6b, 0x30, 0x9b, 0x3a, 0xf2,
-	0xa5, 0xa5, 0xab, 0xe3, 0xad, 0x30, 0x3b, 0x54, 0x4f, 0xe5, 0xc3, 0xdf, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x7e, 0x49, 0x70, 0xa9, 0xf5, 0x03, 0x00, 0x00,
+	// 1029 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xef, 0x6e, 0xe3, 0x44,
+	0x10, 0xc7, 0xf1, 0xa5, 0x89, 0x27, 0xfd, 0x13, 0xb6, 0x07, 0x58, 0x15, 0x82, 0xc8, 0xea, 0x87,
+	0x80, 0x20, 0x27, 0xf5, 0x24, 0x74, 0xba, 0x0f, 0x87, 0xa2, 0x36, 0xa2, 0xa7, 0xa3, 0x08, 0x6d,
+	0x53, 0xfa, 0x79, 0x6b, 0x4f, 0x13, 0xab, 0xf6, 0xae, 0xe5, 0xdd, 0xb4, 0x97, 0x17, 0xe1, 0x19,
+	0x78, 0x00, 0x1e, 0x01, 0x5e, 0x83, 0x77, 0xe0, 0x0d, 0xd0, 0xfe, 0xb1, 0x63, 0xa7, 0x95, 0xca,
+	0xb7, 0x99, 0xdf, 0xfc, 0x66, 0x3d, 0xf3, 0xdb, 0xd9, 0x31, 0xec, 0xca, 0x78, 0x89, 0x39, 0x9b,
+	0x14, 0xa5, 0x50, 0x82, 0x74, 0xd5, 0xba, 0xc0, 0xe4, 0xe8, 0xeb, 0x85, 0x10, 0x8b, 0x0c, 0x5f,
+	0x19, 0xf0, 0x66, 0x75, 0xfb, 0x4a, 0xa5, 0x39, 0x4a, 0xc5, 0xf2, 0xc2, 0xf2, 0xa2, 0xbf, 0x3b,
+	0x70, 0xf0, 0x61, 0x75, 0x83, 0xd7, 0x4c, 0xc5, 0x4b, 0x8a, 0x72, 0x95, 0x29, 0xf2, 0x06, 0x82,
+	0x9a, 0x16, 0x7a, 0x23, 0x6f, 0x3c, 0x38, 0x39, 0x9a, 0xd8, 0x83, 0x26, 0xd5, 0x41, 0x93, 0x79,
+	0xc5, 0xa0, 0x1b, 0x32, 0x21, 0xf0, 0xe2, 0x2e, 0xe5, 0x49, 0xd8, 0x19, 0x79, 0xe3, 0x80, 0x1a,
+	0x9b, 0xbc, 0x83, 0xe0, 0x41, 0x1f, 0x3e, 0x5f, 0x17, 0x18, 0xfa, 0x23, 0x6f, 0xbc, 0x7f, 0x32,
+	0x9a, 0x98, 0xea, 0x26, 0x5b, 0x1f, 0x9e, 0x5c, 0x57, 0x3c, 0xba, 0x49, 0x21, 0x21, 0xf4, 0x0a,
+	0xb6, 0xce, 0x04, 0x4b, 0xc2, 0x17, 0xe6, 0xd8, 0xca, 0x25, 0x9f, 0xc3, 0x4e, 0x89, 0x45, 0xc6,
+	0xd6, 0x61, 0x77, 0xe4, 0x8d, 0xfb, 0xd4, 0x79, 0xe4, 0x0c, 0x0e, 0x1c, 0xe5, 0x03, 0xae, 0x6f,
+	0x4b, 0x96, 0x63, 0xb8, 0xf3, 0x6c, 0x17, 0xdb, 0x29, 0xd1, 0x77, 0x10, 0xd4, 0xf5, 0x90, 0x1e,
+	0xf8, 0xd3, 0xb3, 0xb3, 0xe1, 0x27, 0x04, 0x60, 0xe7, 0xea, 0xd7, 0xb3, 0xe9, 0x7c, 0x36, 0xf4,
+	0xb4, 0x7d, 0x36, 0xfb, 0x79, 0x36, 0x9f, 0x0d, 0x3b, 0xd1, 0x9f, 0x1d, 0x38, 0xa0, 0x28, 0xc5,
+	0xaa, 0x8c, 0xf1, 0x72, 0x95, 0xe7, 0xac, 0x5c, 0x6b, 0x1d, 0x6f, 0xd3, 0x52, 0xaa, 0x4b, 0x44,
+	0xfe, 0x7f, 0x74, 0xac, 0xc9, 0xe4, 0x07, 0xe8, 0x67, 0xcc, 0x25, 0x76, 0x9e, 0x4d, 0xac, 0xb9,
+	0xe4, 0x2d, 0x40, 0x5c, 0x22, 0x53, 0xa8, 0x83, 0xa1, 0xff, 0x6c, 0x66, 0x83, 0x4d, 0x22, 0xd8,
+	0x4d, 0x30, 0x43, 0x85, 0xc9, 0x54, 0xcd, 0xb8, 0x15, 0xbb, 0x4f, 0x5b, 0x18, 0x39, 0x86, 0xbd,
+	0x12, 0x33, 0xa6, 0x52, 0xc1, 0xe5, 0x32, 0x2d, 0x64, 0xd8, 0x1d, 0xf9, 0xe3, 0x80, 0xb6, 0x41,
+	0xf2, 0x0d, 0x74, 0x31, 0x59, 0xa0, 0x0c, 0x77, 0x46, 0xfe, 0x78, 0x70, 0x72, 0xe8, 0x6e, 0x9b,
+	0x36, 0x48, 0xd4, 0x32, 0xa2, 0xdf, 0x3d, 0xd8, 0x6d, 0xe2, 0x7a, 0x82, 0x34, 0xdb, 0xc8, 0x15,
+	0x50, 0x63, 0x3f, 0x39, 0x55, 0x5f, 0x42, 0xc0, 0x59, 0x8e, 0xb2, 0x60, 0xb1, 0x6d, 0x34, 0xa0,
+	0x1b, 0x40, 0x67, 0x68, 0xc7, 0x0d, 0x8c, 0xb1, 0xc9, 0x10, 0xfc, 0x55, 0x9a, 0x98, 0x51, 0x09,
+	0xa8, 0x36, 0xc9, 0x11, 0xf4, 0x25, 0x66, 0x18, 0x2b, 0x51, 0x9a, 0x01, 0x09, 0x68, 0xed, 0x47,
+	0x7f, 0x78, 0x30, 0x98, 0xdd, 0x23, 0x57, 0xa7, 0x62, 0xc5, 0x95, 0x24, 0x73, 0x18, 0xe6, 0xac,
+	0xa0, 0xc8, 0xa4, 0xe0, 0x73, 0x61, 0xc0, 0xd0, 0x33, 0xed, 0x8d, 0x5d, 0x7b, 0x0d, 0xf6, 0xe4,
+	0x62, 0x8b, 0x3a, 0xe3, 0xaa, 0x5c, 0xd3, 0x47, 0x27, 0x1c, 0x9d, 0xc2, 0x67, 0x4f, 0x52, 0x75,
+	0xb1, 0x77, 0xb8, 0x76, 0x2a, 0x68, 0x93, 0xbc, 0x84, 0xee, 0x3d, 0xcb, 0x56, 0x68, 0x54, 0xe8,
+	0x52, 0xeb, 0xbc, 0xed, 0xbc, 0xf1, 0xa2, 0xbf, 0x3c, 0x38, 0xac, 0x46, 0xaf, 0x59, 0xf2, 0x6f,
+	0xb0, 0x9f, 0xb3, 0xe2, 0x22, 0xe5, 0x73, 0x61, 0x60, 0xe9, 0x0a, 0x9e, 0xd4, 0xf7, 0xf1, 0x28,
+	0x67, 0x72, 0xd1, 0x4a, 0xb0, 0x65, 0x6f, 0x9d, 0x72, 0x74, 0x05, 0x87, 0x4f, 0xd0, 0x9a, 0x25,
+	0xfb, 0xb6, 0xe4, 0x71, 0xb3, 0xe4, 0xc1, 0x09, 0x79, 0x2c, 0x54, 0xb3, 0x8d, 0x0b, 0xd8, 0x33,
+	0xef, 0x6d, 0x1a, 0xab, 0xf4, 0x3e, 0x55, 0x6b, 0xf2, 0x15, 0xc0, 0x2f, 0xe2, 0x74, 0xc9, 0xf8,
+	0x02, 0xa7, 0x56, 0x6c, 0x9f, 0x36, 0x10, 0x3d, 0x02, 0xd6, 0x4e, 0xa6, 0x2a, 0xec, 0x98, 0xf0,
+	0x06, 0x88, 0x7e, 0x84, 0xfd, 0xaa, 0xc1, 0x73, 0x64, 0x99, 0x5a, 0x92, 0xef, 0xa1, 0x17, 0x9b,
+	0x70, 0x25, 0x44, 0x35, 0x98, 0x36, 0x6e, 0x53, 0x69, 0xc5, 0x89, 0x3e, 0xc2, 0x6e, 0x33, 0xa0,
+	0x3f, 0xd7, 0xde, 0x8a, 0x7e, 0x73, 0xf3, 0xbd, 0x84, 0xae, 0x54, 0x4c, 0xa1, 0x1b, 0x52, 0xeb,
+	0xd8, 0x09, 0xbb, 0xc7, 0x32, 0x55, 0x6b, 0x37, 0xa4, 0xb5, 0xaf, 0xf7, 0x5a, 0x8e, 0x52, 0xb2,
+	0x45, 0x35, 0xa6, 0x95, 0x1b, 0x9d, 0xc3, 0xa7, 0xa7, 0x82, 0x2b, 0x96, 0x72, 0x2c, 0xa9, 0x3e,
+	0xbf, 0x54, 0x92, 0xbc, 0x86, 0x7e, 0xe9, 0x6c, 0x57, 0xfe, 0x17, 0xae, 0xfc, 0x6d, 0x2e, 0xad,
+	0x89, 0xd1, 0x3f, 0x1d, 0x18, 0x6e, 0x87, 0x9f, 0x69, 0xe4, 0x18, 0xf6, 0x52, 0x9e, 0xaa, 0x3a,
+	0xcb, 0x34, 0xd4, 0xa7, 0x6d, 0x50, 0x9f, 0xe1, 0x3e, 0x82, 0x89, 0xe9, 0xac, 0x4f, 0x37, 0x80,
+	0x5e, 0x25, 0xce, 0xb1, 0x0f, 0xe5, 0x85, 0x19, 0xd9, 0x16, 0x66, 0x97, 0xb7, 0x9e, 0x7b, 0xf7,
+	0x22, 0x9d, 0xa7, 0x25, 0xc3, 0x8f, 0xfa, 0x53, 0x89, 0xdd, 0xda, 0x5d, 0x5a, 0xfb, 0x3a, 0x47,
+	0xa6, 0x0b, 0xce, 0xb2, 0xb0, 0x67, 0x22, 0xce, 0x6b, 0x4a, 0xd9, 0x6f, 0x49, 0xa9, 0xeb, 0x74,
+	0x45, 0x4d, 0x55, 0x18, 0xd8, 0x5e, 0x6b, 0x40, 0x4f, 0xd8, 0x6d, 0xca, 0x53, 0xb9, 0x34, 0x61,
+	0x30, 0xe1, 0x06, 0x42, 0x46, 0x30, 0x88, 0xab, 0x96, 0xdf, 0x27, 0xe1, 0xc0, 0x9c, 0xdd, 0x84,
+	0xa2, 0x77, 0x30, 0xbc, 0x16, 0xe5, 0x9d, 0xfe, 0x71, 0x50, 0x91, 0x65, 0x62, 0xa5, 0x24, 0xf9,
+	0x16, 0xfa, 0xa5, 0xb3, 0xdd, 0x4d, 0xed, 0x57, 0x2f, 0xce, 0xc2, 0xb4, 0x8e, 0x47, 0xff, 0xfa,
+	0xd0, 0x73, 0xa8, 0x1b, 0xa1, 0x52, 0xb9, 0x3b, 0xb1, 0x8e, 0x7e, 0x56, 0xe8, 0x76, 0x9f, 0x4f,
+	0xb5, 0xa9, 0xab, 0xd6, 0x0b, 0xff, 0xaa, 0x48, 0xf4, 0xbc, 0xf9, 0xb6, 0xea, 0x0d, 0xa2, 0xd5,
+	0x10, 0x2b, 0x15, 0x8b, 0x7a, 0xff, 0x55, 0x6e, 0x53, 0xa7, 0x6e, 0x5b, 0xa7, 0x63, 0xd8, 0x13,
+	0x59, 0xf2, 0x13, 0x72, 0x2c, 0xcd, 0x2e, 0x36, 0xd2, 0xfb, 0xb4, 0x0d, 0x6a, 0x16, 0xc7, 0x87,
+	0x06, 0xab, 0x67, 0x59, 0x2d, 0x50, 0xab, 0x26, 0xb2, 0x84, 0xe2, 0x7d, 0x2a, 0x35, 0xc7, 0xde,
+	0x48, 0x13, 0xd2, 0x0c, 0x8e, 0x0f, 0x35, 0x23, 0xb0, 0x8c, 0x06, 0x44, 0xc6, 0x70, 0x20, 0xb2,
+	0x64, 0x8e, 0x79, 0x91, 0x31, 0x85, 0xe7, 0x4c, 0x2e, 0xcd, 0xf5, 0x04, 0x74, 0x1b, 0xd6, 0x4c,
+	0x8e, 0x0f, 0x2d, 0xa6, 0xbd, 0xa7, 0x6d, 0x58, 0xcf, 0x82, 0xc8, 0x92, 0xf7, 0x39, 0xd3, 0x1b,
+	0x60, 0xd7, 0xfc, 0xb8, 0x36, 0x80, 0x8e, 0x72, 0x7c, 0x70, 0xd1, 0x3d, 0x1b, 0xad, 0x01, 0xa7,
+	0x0f, 0xc5, 0x22, 0x4b, 0x63, 0x76, 0x89, 0x2a, 0xdc, 0x37, 0xdf, 0x68, 0x83, 0x4e, 0x9f, 0x06,
+	0xeb, 0xc0, 0xb2, 0x5a, 0xe0, 0xcd, 0x8e, 0xf9, 0x11, 0xbf, 0xfe, 0x6f, 0x00, 0x80, 0xfe, 0x9e,
+	0x49, 0xb1, 0x09, 0x00, 0x00,
 }
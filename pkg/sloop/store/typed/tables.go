@@ -18,6 +18,9 @@ type Tables interface {
 	EventCountTable() *ResourceEventCountsTable
 	WatchTable() *KubeWatchResultTable
 	WatchActivityTable() *WatchActivityTable
+	HealthTable() *ResourceHealthTable
+	RestartTable() *ContainerRestartsTable
+	RolloutTable() *WorkloadRolloutsTable
 	Db() badgerwrap.DB
 	GetMinAndMaxPartition() (bool, string, string, error)
 	GetTableNames() []string
@@ -34,6 +37,9 @@ type tablesImpl struct {
 	eventCountTable      *ResourceEventCountsTable
 	watchTable           *KubeWatchResultTable
 	watchActivityTable   *WatchActivityTable
+	healthTable          *ResourceHealthTable
+	restartTable         *ContainerRestartsTable
+	rolloutTable         *WorkloadRolloutsTable
 	db                   badgerwrap.DB
 }
 
@@ -43,6 +49,9 @@ func NewTableList(db badgerwrap.DB) Tables {
 	t.eventCountTable = OpenResourceEventCountsTable()
 	t.watchTable = OpenKubeWatchResultTable()
 	t.watchActivityTable = OpenWatchActivityTable()
+	t.healthTable = OpenResourceHealthTable()
+	t.restartTable = OpenContainerRestartsTable()
+	t.rolloutTable = OpenWorkloadRolloutsTable()
 	t.db = db
 	return t
 }
@@ -63,6 +72,18 @@ func (t *tablesImpl) WatchActivityTable() *WatchActivityTable {
 	return t.watchActivityTable
 }
 
+func (t *tablesImpl) HealthTable() *ResourceHealthTable {
+	return t.healthTable
+}
+
+func (t *tablesImpl) RestartTable() *ContainerRestartsTable {
+	return t.restartTable
+}
+
+func (t *tablesImpl) RolloutTable() *WorkloadRolloutsTable {
+	return t.rolloutTable
+}
+
 func (t *tablesImpl) Db() badgerwrap.DB {
 	return t.db
 }
@@ -105,11 +126,11 @@ func (t *tablesImpl) GetMinAndMaxPartitionWithTxn(txn badgerwrap.Txn) (bool, str
 }
 
 func (t *tablesImpl) GetTableNames() []string {
-	return []string{t.watchTable.tableName, t.resourceSummaryTable.tableName, t.eventCountTable.tableName, t.watchActivityTable.tableName}
+	return []string{t.watchTable.tableName, t.resourceSummaryTable.tableName, t.eventCountTable.tableName, t.watchActivityTable.tableName, t.healthTable.tableName, t.restartTable.tableName, t.rolloutTable.tableName}
 }
 
 func (t *tablesImpl) GetTables() []interface{} {
 	intfs := new([]interface{})
-	*intfs = append(*intfs, t.eventCountTable, t.resourceSummaryTable, t.watchTable, t.watchActivityTable)
+	*intfs = append(*intfs, t.eventCountTable, t.resourceSummaryTable, t.watchTable, t.watchActivityTable, t.healthTable, t.restartTable, t.rolloutTable)
 	return *intfs
 }
@@ -22,6 +22,9 @@ import (
 //go:generate genny -in=$GOFILE -out=resourcesummarytablegen_test.go gen "ValueType=ResourceSummary KeyType=ResourceSummaryKey"
 //go:generate genny -in=$GOFILE -out=eventcounttablegen_test.go gen "ValueType=ResourceEventCounts KeyType=EventCountKey"
 //go:generate genny -in=$GOFILE -out=watchactivitytablegen_test.go gen "ValueType=WatchActivity KeyType=WatchActivityKey"
+//go:generate genny -in=$GOFILE -out=healthtablegen_test.go gen "ValueType=ResourceHealth KeyType=HealthKey"
+//go:generate genny -in=$GOFILE -out=restarttablegen_test.go gen "ValueType=ContainerRestarts KeyType=RestartKey"
+//go:generate genny -in=$GOFILE -out=rollouttablegen_test.go gen "ValueType=WorkloadRollouts KeyType=RolloutKey"
 
 func helper_ValueType_ShouldSkip() bool {
 	// Tests will not work on the fake types in the template, but we want to run tests on real objects
@@ -75,10 +75,11 @@ type RangeReadStats struct {
 	RowsVisitedCount              int
 	RowsPassedKeyPredicateCount   int
 	RowsPassedValuePredicateCount int
+	RowsMissingKeyframeCount      int
 	Elapsed                       time.Duration
 }
 
 func (stats RangeReadStats) Log(requestId string) {
-	glog.V(common.GlogVerbose).Infof("reqId: %v range read on table %v took %v.  Partitions scanned %v.  Rows scanned %v, past key predicate %v, past value predicate %v",
-		requestId, stats.TableName, stats.Elapsed, stats.PartitionCount, stats.RowsVisitedCount, stats.RowsPassedKeyPredicateCount, stats.RowsPassedValuePredicateCount)
+	glog.V(common.GlogVerbose).Infof("reqId: %v range read on table %v took %v.  Partitions scanned %v.  Rows scanned %v, past key predicate %v, past value predicate %v, missing keyframe %v",
+		requestId, stats.TableName, stats.Elapsed, stats.PartitionCount, stats.RowsVisitedCount, stats.RowsPassedKeyPredicateCount, stats.RowsPassedValuePredicateCount, stats.RowsMissingKeyframeCount)
 }
@@ -69,9 +69,10 @@ func (k *WatchTableKey) SetPartitionId(newPartitionId string) {
 }
 
 func (k *WatchTableKey) IsNameAlreadyDelimited() bool {
-	// Currently only '.' or '/' are supported as delimiters
+	// Currently only '.', '/' or '-' are supported as delimiters.  Kubernetes names never end with '-', so a name like
+	// "mydeployment-" can only be a prefix, like the one of the replica sets of a deployment
 	nameLength := len(k.Name)
-	if nameLength > 0 && (k.Name[nameLength-1:] == "." || k.Name[nameLength-1:] == "/") {
+	if nameLength > 0 && (k.Name[nameLength-1:] == "." || k.Name[nameLength-1:] == "/" || k.Name[nameLength-1:] == "-") {
 		return true
 	}
 
@@ -8,6 +8,7 @@
 package typed
 
 import (
+	badger "github.com/dgraph-io/badger/v2"
 	"github.com/salesforce/sloop/pkg/sloop/store/untyped"
 	"github.com/salesforce/sloop/pkg/sloop/store/untyped/badgerwrap"
 	"github.com/stretchr/testify/assert"
@@ -195,6 +196,54 @@ func Test_GetPreviousKey_Fail(t *testing.T) {
 	assert.Equal(t, &WatchTableKey{}, partRes)
 }
 
+func Test_GetLastValue(t *testing.T) {
+	untyped.TestHookSetPartitionDuration(time.Hour)
+	db, err := (&badgerwrap.MockFactory{}).Open(badger.DefaultOptions(""))
+	assert.Nil(t, err)
+	wt := OpenKubeWatchResultTable()
+	err = db.Update(func(txn badgerwrap.Txn) error {
+		for _, ts := range []time.Time{someTs, someTs.Add(time.Minute), someTs.Add(2 * time.Hour)} {
+			key := NewWatchTableKey(untyped.GetPartitionId(ts), someKind, someNamespace, someName, ts)
+			err := wt.Set(txn, key.String(), &KubeWatchResult{Payload: ts.String()})
+			if err != nil {
+				return err
+			}
+		}
+		// Shares the name prefix, and is the only result in the middle partition
+		ts := someTs.Add(time.Hour)
+		return wt.Set(txn, NewWatchTableKey(untyped.GetPartitionId(ts), someKind, someNamespace, someName+"b", ts).String(), &KubeWatchResult{})
+	})
+	assert.Nil(t, err)
+
+	err = db.View(func(txn badgerwrap.Txn) error {
+		keyComparator := NewWatchTableKeyComparator(someKind, someNamespace, someName, zeroData)
+
+		// From the middle partition we need to go back to the newest result in the first one
+		key, value, err := wt.GetLastValue(txn, keyComparator, someTs, someTs.Add(time.Hour))
+		assert.Nil(t, err)
+		assert.Equal(t, NewWatchTableKey(someMinPartition, someKind, someNamespace, someName, someTs.Add(time.Minute)), key)
+		assert.Equal(t, someTs.Add(time.Minute).String(), value.Payload)
+		assert.Equal(t, NewWatchTableKeyComparator(someKind, someNamespace, someName, zeroData), keyComparator)
+
+		// But not further back than the partition of startTime
+		key, value, err = wt.GetLastValue(txn, keyComparator, someTs.Add(time.Hour), someTs.Add(time.Hour))
+		assert.Nil(t, err)
+		assert.Nil(t, key)
+		assert.Nil(t, value)
+
+		// Long after the last partition
+		_, value, err = wt.GetLastValue(txn, keyComparator, someTs, someTs.Add(24*time.Hour))
+		assert.Nil(t, err)
+		assert.Equal(t, someTs.Add(2*time.Hour).String(), value.Payload)
+
+		_, value, err = wt.GetLastValue(txn, NewWatchTableKeyComparator(someKind+"c", someNamespace, someName, zeroData), someTs, someMaxTs)
+		assert.Nil(t, err)
+		assert.Nil(t, value)
+		return nil
+	})
+	assert.Nil(t, err)
+}
+
 func Test_String(t *testing.T) {
 	someKindWatchKey := NewWatchTableKey(someMaxPartition, someKind, someNamespace, someName, someTs)
 	someKindWatchKeyStr := someKindWatchKey.String()
@@ -212,6 +261,10 @@ func Test_String(t *testing.T) {
 	someKindWatchKeyStr = someKindWatchKey.String()
 	assert.Equal(t, someKindWatchKeyStr, "/watch/001546405200/somekind/somenamespace/somename/")
 
+	someKindWatchKey = NewWatchTableKey(someMaxPartition, someKind, someNamespace, someName+"-", time.Time{})
+	someKindWatchKeyStr = someKindWatchKey.String()
+	assert.Equal(t, someKindWatchKeyStr, "/watch/001546405200/somekind/somenamespace/somename-")
+
 	someKindWatchKey = NewWatchTableKey(someMaxPartition, someKind, someNamespace, someName+".xx", time.Time{})
 	someKindWatchKeyStr = someKindWatchKey.String()
 	assert.Equal(t, someKindWatchKeyStr, "/watch/001546405200/somekind/somenamespace/somename.xx/")
@@ -0,0 +1,228 @@
+/*
+ * Copyright (c) 2021, salesforce.com, inc.
+ * All rights reserved.
+ * SPDX-License-Identifier: BSD-3-Clause
+ * For full license text, see LICENSE.txt file in the repo root or https://opensource.org/licenses/BSD-3-Clause
+ */
+
+package typed
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/dgraph-io/badger/v2"
+	"github.com/evanphx/json-patch"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/salesforce/sloop/pkg/sloop/store/untyped/badgerwrap"
+)
+
+// Watch results can be stored as a json merge patch against a keyframe, which is a full payload stored earlier for
+// the same object in the same partition.  Big objects like nodes usually change a few fields at a time, so most
+// updates shrink to a small fraction of the payload.  Patches are always against the keyframe rather than the
+// result before them, so a read needs one extra lookup however long the history is.  The first result of an object
+// in every partition is a keyframe, and so is any result whose patch would not be much smaller than the payload.
+// Partitions are deleted a batch of keys at a time, so for a while a patch can outlive its keyframe.  Reads treat those
+// results as deleted already, since the partition is on its way out anyway: Get returns badger.ErrKeyNotFound and
+// RangeRead skips them.
+
+var (
+	metricWatchTablePatchCount    = promauto.NewCounter(prometheus.CounterOpts{Name: "sloop_watchtable_patch_count"})
+	metricWatchTableKeyframeCount = promauto.NewCounter(prometheus.CounterOpts{Name: "sloop_watchtable_keyframe_count"})
+	metricWatchTablePatchBytes    = promauto.NewCounter(prometheus.CounterOpts{Name: "sloop_watchtable_patch_bytes"})
+	metricWatchTablePatchedBytes  = promauto.NewCounter(prometheus.CounterOpts{Name: "sloop_watchtable_patched_payload_bytes"})
+)
+
+var errMissingKeyframe = errors.New("keyframe is gone")
+
+// A patch bigger than this share of the payload is not worth the extra lookup on reads
+const maxPatchRatio = 0.5
+
+// Values kept in a compact form put themselves back together after a read.  Called by the generated Get and RangeRead
+type storedValueExpander interface {
+	expand(txn badgerwrap.Txn, key string) error
+}
+
+func expandStoredValue(txn badgerwrap.Txn, key string, value interface{}) error {
+	expander, ok := value.(storedValueExpander)
+	if !ok {
+		return nil
+	}
+	return expander.expand(txn, key)
+}
+
+func (value *KubeWatchResult) expand(txn badgerwrap.Txn, key string) error {
+	if value.PayloadKeyframe == nil {
+		return nil
+	}
+	keyframeKey, err := watchKeyframeKey(key, value.PayloadKeyframe)
+	if err != nil {
+		return err
+	}
+	keyframe, err := getStoredKubeWatchResult(txn, keyframeKey)
+	if err == badger.ErrKeyNotFound {
+		return errors.Wrapf(errMissingKeyframe, "failed to get keyframe %v", keyframeKey)
+	} else if err != nil {
+		return errors.Wrapf(err, "failed to get keyframe %v", keyframeKey)
+	}
+	payload, err := jsonpatch.MergePatch([]byte(keyframe.Payload), []byte(value.Payload))
+	if err != nil {
+		return errors.Wrapf(err, "failed to apply patch to keyframe %v", keyframeKey)
+	}
+	value.Payload = string(payload)
+	value.PayloadKeyframe = nil
+	return nil
+}
+
+// SetAsPatch stores value as a patch against the last keyframe of the object when that saves enough space, and as a
+// keyframe otherwise.  Get and RangeRead return the full payload either way
+func (t *KubeWatchResultTable) SetAsPatch(txn badgerwrap.Txn, key string, value *KubeWatchResult) error {
+	keyframeKey, keyframe, err := t.getLastKeyframe(txn, key)
+	if err != nil {
+		return err
+	}
+	if keyframe == nil {
+		metricWatchTableKeyframeCount.Inc()
+		return t.Set(txn, key, value)
+	}
+
+	patch, ok := createPayloadPatch(keyframe.Payload, value.Payload)
+	if !ok {
+		metricWatchTableKeyframeCount.Inc()
+		return t.Set(txn, key, value)
+	}
+	stored := proto.Clone(value).(*KubeWatchResult)
+	stored.Payload = patch
+	stored.PayloadKeyframe, err = ptypes.TimestampProto(keyframeKey.Timestamp)
+	if err != nil {
+		return errors.Wrapf(err, "invalid keyframe timestamp in %v", keyframeKey.String())
+	}
+	metricWatchTablePatchCount.Inc()
+	metricWatchTablePatchBytes.Add(float64(len(patch)))
+	metricWatchTablePatchedBytes.Add(float64(len(value.Payload)))
+	return t.Set(txn, key, stored)
+}
+
+// Returns the keyframe used by the last result stored for the object before key in the same partition, or nil when
+// there is none.  A key that is already stored gets no keyframe, because results may be patched against it
+func (t *KubeWatchResultTable) getLastKeyframe(txn badgerwrap.Txn, key string) (*WatchTableKey, *KubeWatchResult, error) {
+	watchKey := &WatchTableKey{}
+	err := watchKey.Parse(key)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "invalid key for table %v: %v", t.tableName, key)
+	}
+	prefix := NewWatchTableKey(watchKey.PartitionId, watchKey.Kind, watchKey.Namespace, watchKey.Name, time.Time{}).String()
+
+	iterOpt := badger.DefaultIteratorOptions
+	iterOpt.Prefix = []byte(prefix)
+	iterOpt.Reverse = true
+	itr := txn.NewIterator(iterOpt)
+	itr.Seek([]byte(key))
+	if !itr.ValidForPrefix([]byte(prefix)) {
+		itr.Close()
+		return nil, nil, nil
+	}
+	lastKey := string(itr.Item().Key())
+	itr.Close()
+	if lastKey == key {
+		return nil, nil, nil
+	}
+
+	last, err := getStoredKubeWatchResult(txn, lastKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	if last.PayloadKeyframe == nil {
+		keyframeKey := &WatchTableKey{}
+		err = keyframeKey.Parse(lastKey)
+		return keyframeKey, last, err
+	}
+	keyframeKeyStr, err := watchKeyframeKey(lastKey, last.PayloadKeyframe)
+	if err != nil {
+		return nil, nil, err
+	}
+	keyframe, err := getStoredKubeWatchResult(txn, keyframeKeyStr)
+	if err == badger.ErrKeyNotFound {
+		// Only while the partition is being dropped.  Starting over with a keyframe is always fine
+		return nil, nil, nil
+	} else if err != nil {
+		return nil, nil, err
+	}
+	keyframeKey := &WatchTableKey{}
+	err = keyframeKey.Parse(keyframeKeyStr)
+	return keyframeKey, keyframe, err
+}
+
+// Returns the patch, or false when the payload should be stored whole
+func createPayloadPatch(keyframePayload string, payload string) (string, bool) {
+	patch, err := jsonpatch.CreateMergePatch([]byte(keyframePayload), []byte(payload))
+	if err != nil || float64(len(patch)) > maxPatchRatio*float64(len(payload)) {
+		return "", false
+	}
+	// Merge patches can not set a value to null, and the library reads numbers as float64.  Only keep patches that
+	// give back exactly what we were sent
+	patched, err := jsonpatch.MergePatch([]byte(keyframePayload), patch)
+	if err != nil || !sameJson(string(patched), payload) {
+		return "", false
+	}
+	return string(patch), true
+}
+
+func sameJson(a string, b string) bool {
+	aObj, err := decodeJsonWithNumbers(a)
+	if err != nil {
+		return false
+	}
+	bObj, err := decodeJsonWithNumbers(b)
+	if err != nil {
+		return false
+	}
+	return reflect.DeepEqual(aObj, bObj)
+}
+
+func decodeJsonWithNumbers(data string) (interface{}, error) {
+	decoder := json.NewDecoder(strings.NewReader(data))
+	decoder.UseNumber()
+	var obj interface{}
+	err := decoder.Decode(&obj)
+	return obj, err
+}
+
+// The keyframe is in the same partition as the patch, and for the same object
+func watchKeyframeKey(key string, keyframeTs *timestamp.Timestamp) (string, error) {
+	watchKey := &WatchTableKey{}
+	err := watchKey.Parse(key)
+	if err != nil {
+		return "", err
+	}
+	ts, err := ptypes.Timestamp(keyframeTs)
+	if err != nil {
+		return "", errors.Wrapf(err, "invalid keyframe timestamp in %v", key)
+	}
+	return NewWatchTableKey(watchKey.PartitionId, watchKey.Kind, watchKey.Namespace, watchKey.Name, ts).String(), nil
+}
+
+// Reads a value the way it is stored, without expanding patches
+func getStoredKubeWatchResult(txn badgerwrap.Txn, key string) (*KubeWatchResult, error) {
+	item, err := txn.Get([]byte(key))
+	if err != nil {
+		return nil, err
+	}
+	valueBytes, err := item.ValueCopy([]byte{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "value copy failed for %v", key)
+	}
+	value := &KubeWatchResult{}
+	err = proto.Unmarshal(valueBytes, value)
+	if err != nil {
+		return nil, errors.Wrapf(err, "protobuf unmarshal failed for %v", key)
+	}
+	return value, nil
+}
@@ -0,0 +1,150 @@
+/*
+ * Copyright (c) 2021, salesforce.com, inc.
+ * All rights reserved.
+ * SPDX-License-Identifier: BSD-3-Clause
+ * For full license text, see LICENSE.txt file in the repo root or https://opensource.org/licenses/BSD-3-Clause
+ */
+
+package typed
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	badger "github.com/dgraph-io/badger/v2"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/salesforce/sloop/pkg/sloop/store/untyped"
+	"github.com/salesforce/sloop/pkg/sloop/store/untyped/badgerwrap"
+	"github.com/stretchr/testify/assert"
+)
+
+var somePatchTime = time.Date(2019, 3, 4, 3, 4, 5, 6, time.UTC)
+
+// A node with a lot of images, where only the heartbeat changes
+func helper_bigNodePayload(heartbeat int) string {
+	var images []string
+	for idx := 0; idx < 50; idx++ {
+		images = append(images, fmt.Sprintf(`{"names":["registry.example.com/team/image-%v@sha256:%064d"],"sizeBytes":%v}`, idx, idx, 100000+idx))
+	}
+	return fmt.Sprintf(`{"metadata":{"name":"node1","resourceVersion":"%v"},"status":{"conditions":[{"type":"Ready","status":"True","lastHeartbeatTime":"2019-03-04T03:04:%02dZ"}],"images":[%v]}}`,
+		heartbeat, heartbeat, strings.Join(images, ","))
+}
+
+func helper_watchKey(offset time.Duration) string {
+	ts := somePatchTime.Add(offset)
+	return NewWatchTableKey(untyped.GetPartitionId(ts), "Node", "", "node1", ts).String()
+}
+
+func helper_setAsPatch(t *testing.T, db badgerwrap.DB, offset time.Duration, payload string) {
+	ts, err := ptypes.TimestampProto(somePatchTime.Add(offset))
+	assert.Nil(t, err)
+	err = db.Update(func(txn badgerwrap.Txn) error {
+		return OpenKubeWatchResultTable().SetAsPatch(txn, helper_watchKey(offset), &KubeWatchResult{Kind: "Node", WatchType: KubeWatchResult_UPDATE, Timestamp: ts, Payload: payload})
+	})
+	assert.Nil(t, err)
+}
+
+func helper_storedWatchResult(t *testing.T, db badgerwrap.DB, offset time.Duration) *KubeWatchResult {
+	var stored *KubeWatchResult
+	err := db.View(func(txn badgerwrap.Txn) error {
+		var err error
+		stored, err = getStoredKubeWatchResult(txn, helper_watchKey(offset))
+		return err
+	})
+	assert.Nil(t, err)
+	return stored
+}
+
+func Test_SetAsPatch_ReadsGiveFullPayload(t *testing.T) {
+	untyped.TestHookSetPartitionDuration(time.Hour)
+	db, err := (&badgerwrap.MockFactory{}).Open(badger.DefaultOptions(""))
+	assert.Nil(t, err)
+
+	fullBytes, storedBytes := 0, 0
+	for idx := 0; idx < 20; idx++ {
+		payload := helper_bigNodePayload(idx)
+		helper_setAsPatch(t, db, time.Duration(idx)*time.Second, payload)
+		fullBytes += len(payload)
+		storedBytes += len(helper_storedWatchResult(t, db, time.Duration(idx)*time.Second).Payload)
+	}
+	assert.Nil(t, helper_storedWatchResult(t, db, 0).PayloadKeyframe)
+	assert.NotNil(t, helper_storedWatchResult(t, db, 19*time.Second).PayloadKeyframe)
+	assert.True(t, storedBytes*10 < fullBytes, "stored %v of %v bytes", storedBytes, fullBytes)
+
+	err = db.View(func(txn badgerwrap.Txn) error {
+		value, err := OpenKubeWatchResultTable().Get(txn, helper_watchKey(7*time.Second))
+		assert.Nil(t, err)
+		assert.True(t, sameJson(helper_bigNodePayload(7), value.Payload))
+		assert.Nil(t, value.PayloadKeyframe)
+
+		results, _, err := OpenKubeWatchResultTable().RangeRead(txn, NewWatchTableKeyComparator("Node", "", "node1", time.Time{}), nil,
+			func(result *KubeWatchResult) bool { return strings.Contains(result.Payload, "03:04:1") }, somePatchTime, somePatchTime.Add(time.Minute))
+		assert.Nil(t, err)
+		assert.Len(t, results, 10)
+		for key, value := range results {
+			assert.True(t, sameJson(helper_bigNodePayload(key.Timestamp.Second()-5), value.Payload))
+		}
+		return nil
+	})
+	assert.Nil(t, err)
+}
+
+func Test_SetAsPatch_Keyframes(t *testing.T) {
+	untyped.TestHookSetPartitionDuration(time.Hour)
+	db, err := (&badgerwrap.MockFactory{}).Open(badger.DefaultOptions(""))
+	assert.Nil(t, err)
+
+	helper_setAsPatch(t, db, 0, helper_bigNodePayload(0))
+	helper_setAsPatch(t, db, time.Second, helper_bigNodePayload(1))
+	keyframeTs, _ := ptypes.TimestampProto(somePatchTime)
+	assert.Equal(t, keyframeTs, helper_storedWatchResult(t, db, time.Second).PayloadKeyframe)
+
+	// A patch as big as the payload is not worth it
+	helper_setAsPatch(t, db, 2*time.Second, `{"metadata":{"name":"node1"}}`)
+	assert.Nil(t, helper_storedWatchResult(t, db, 2*time.Second).PayloadKeyframe)
+
+	// Values a merge patch can not express
+	helper_setAsPatch(t, db, 3*time.Second, `{"metadata":{"name":"node1","generation":12345678901234567,"labels":{"a":"b"}},"spec":{"taints":null}}`)
+	helper_setAsPatch(t, db, 4*time.Second, `{"metadata":{"name":"node1","generation":12345678901234568,"labels":{"a":"b"}},"spec":{"taints":null}}`)
+	assert.Nil(t, helper_storedWatchResult(t, db, 4*time.Second).PayloadKeyframe)
+	helper_setAsPatch(t, db, 5*time.Second, `{"metadata":{"name":"node1","generation":12345678901234568,"labels":{"a":null}},"spec":{"taints":null}}`)
+	assert.Nil(t, helper_storedWatchResult(t, db, 5*time.Second).PayloadKeyframe)
+
+	// Every partition starts with a keyframe
+	helper_setAsPatch(t, db, time.Hour, helper_bigNodePayload(0))
+	assert.Nil(t, helper_storedWatchResult(t, db, time.Hour).PayloadKeyframe)
+	helper_setAsPatch(t, db, time.Hour+time.Second, helper_bigNodePayload(1))
+	keyframeTs, _ = ptypes.TimestampProto(somePatchTime.Add(time.Hour))
+	assert.Equal(t, keyframeTs, helper_storedWatchResult(t, db, time.Hour+time.Second).PayloadKeyframe)
+}
+
+func Test_SetAsPatch_RangeReadSkipsMissingKeyframe(t *testing.T) {
+	untyped.TestHookSetPartitionDuration(time.Hour)
+	db, err := (&badgerwrap.MockFactory{}).Open(badger.DefaultOptions(""))
+	assert.Nil(t, err)
+
+	for idx := 0; idx < 3; idx++ {
+		helper_setAsPatch(t, db, time.Duration(idx)*time.Second, helper_bigNodePayload(idx))
+	}
+	helper_setAsPatch(t, db, time.Hour, helper_bigNodePayload(0))
+	// Dropping a partition can delete the keyframe before the patches against it
+	err = db.Update(func(txn badgerwrap.Txn) error {
+		return txn.Delete([]byte(helper_watchKey(0)))
+	})
+	assert.Nil(t, err)
+
+	err = db.View(func(txn badgerwrap.Txn) error {
+		results, stats, err := OpenKubeWatchResultTable().RangeRead(txn, NewWatchTableKeyComparator("Node", "", "node1", time.Time{}), nil, nil,
+			somePatchTime, somePatchTime.Add(2*time.Hour))
+		assert.Nil(t, err)
+		assert.Len(t, results, 1)
+		assert.Equal(t, 2, stats.RowsMissingKeyframeCount)
+
+		_, err = OpenKubeWatchResultTable().Get(txn, helper_watchKey(time.Second))
+		assert.Equal(t, badger.ErrKeyNotFound, err)
+		return nil
+	})
+	assert.Nil(t, err)
+}
@@ -31,6 +31,13 @@ type MockDb struct {
 type MockTxn struct {
 	readOnly bool
 	db       *MockDb
+	// Values from before this transaction changed them, so a failed Update can put them back
+	undo map[string]mockUndo
+}
+
+type mockUndo struct {
+	value   []byte
+	existed bool
 }
 
 type MockItem struct {
@@ -63,8 +70,19 @@ func (b *MockDb) Sync() error {
 func (b *MockDb) Update(fn func(txn Txn) error) error {
 	b.lock.Lock()
 	defer b.lock.Unlock()
-	txn := &MockTxn{readOnly: false, db: b}
-	return fn(txn)
+	txn := &MockTxn{readOnly: false, db: b, undo: map[string]mockUndo{}}
+	err := fn(txn)
+	if err != nil {
+		// Like badger, nothing from a failed Update is committed
+		for key, undo := range txn.undo {
+			if undo.existed {
+				b.data[key] = undo.value
+			} else {
+				delete(b.data, key)
+			}
+		}
+	}
+	return err
 }
 
 func (b *MockDb) View(fn func(txn Txn) error) error {
@@ -136,6 +154,7 @@ func (t *MockTxn) Set(key, val []byte) error {
 	if t.readOnly {
 		return badger.ErrReadOnlyTxn
 	}
+	t.saveUndo(key)
 	t.db.data[string(key)] = val
 	return nil
 }
@@ -144,10 +163,19 @@ func (t *MockTxn) Delete(key []byte) error {
 	if t.readOnly {
 		return badger.ErrReadOnlyTxn
 	}
+	t.saveUndo(key)
 	delete(t.db.data, string(key))
 	return nil
 }
 
+func (t *MockTxn) saveUndo(key []byte) {
+	if _, ok := t.undo[string(key)]; ok {
+		return
+	}
+	value, existed := t.db.data[string(key)]
+	t.undo[string(key)] = mockUndo{value: value, existed: existed}
+}
+
 func (t *MockTxn) NewIterator(opt badger.IteratorOptions) Iterator {
 	keys := []string{}
 	for k, _ := range t.db.data {
@@ -166,6 +166,26 @@ func Test_MockBadger_AddThenDelete_NotFound(t *testing.T) {
 	assert.Equal(t, "Key not found", fmt.Sprintf("%v", err.Error()))
 }
 
+func Test_MockBadger_FailedUpdate_NothingCommitted(t *testing.T) {
+	db := helper_OpenDb(t)
+	defer db.Close()
+	otherKey := []byte("/otherKey")
+	helper_Set(t, db, testKey, testValue1)
+
+	err := db.Update(
+		func(txn Txn) error {
+			assert.Nil(t, txn.Set(testKey, testValue2))
+			assert.Nil(t, txn.Set(otherKey, testValue2))
+			assert.Nil(t, txn.Delete(testKey))
+			return fmt.Errorf("failed")
+		})
+	assert.NotNil(t, err)
+
+	assert.Equal(t, testValue1, helper_GetNoError(t, db, testKey))
+	_, err = helper_Get(t, db, otherKey)
+	assert.Equal(t, badger.ErrKeyNotFound, err)
+}
+
 func Test_MockBadger_DeleteAMissingKey_NoError(t *testing.T) {
 	db := helper_OpenDb(t)
 	defer db.Close()
@@ -161,7 +161,7 @@ func webfilesDebughistogramHtml() (*asset, error) {
 	return a, nil
 }
 
-var _webfilesDebuglistkeysHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x57\x7f\x6f\xdb\x38\x12\xfd\xdf\x9f\x62\x8e\x28\x60\xfb\x6a\x9b\xb1\x73\x17\xdc\xb9\x34\x17\x9b\xa4\x45\x8b\xa6\xdd\xdd\x24\xc0\x2e\x50\x14\x0b\x5a\x1a\x5b\xac\x69\x51\x4b\x52\xfe\xb1\x41\xbe\xfb\x82\xa4\x2c\xcb\x89\x93\xb4\x01\x12\x51\xd4\x9b\x37\x6f\x86\x43\x0e\xc3\xfe\xd5\xef\xb7\x2e\x74\xb1\x35\x72\x9e\x39\xe8\x24\x5d\x18\x9d\x0c\xff\xdf\x03\x2b\x14\xda\x99\x36\x09\x0e\x12\xbd\xec\x81\xcc\x93\x41\xeb\x67\xa5\x20\x00\x2d\x18\xb4\x68\x56\x98\x0e\x5a\x37\xbf\x5e\xfe\xd1\xbf\x92\x09\xe6\x16\xfb\x1f\x52\xcc\x9d\x9c\x49\x34\x63\x38\xbf\xb9\xec\x9f\xf6\x2f\x94\x28\x2d\xb6\xde\x69\x03\xb3\x52\x29\x50\x11\x09\x0e\x37\xae\x07\x16\x11\xae\x3e\x5c\xbc\xfd\x7c\xf3\x76\xe0\x36\x0e\x66\x52\x21\xc8\x1c\x5c\x86\x60\xb0\xd0\x60\xb4\x76\xa0\x0d\x64\xce\x15\x76\x4c\xa9\x2e\x30\xb7\xba\xf4\xba\xb4\x99\xd3\x8a\xcd\xd2\x03\x67\xfd\x3e\x6f\xb1\xcc\x2d\x95\x7f\xa0\x48\x79\x0b\x00\x80\xd9\xc4\xc8\xc2\x81\xdb\x16\x38\x21\xde\x3f\xfd\x26\x56\x22\xce\x92\x88\xf1\x3f\xa9\x4e\xca\x25\xe6\x6e\xb0\x36\xd2\x61\x87\xb0\xa9\xb0\x08\x99\xc1\xd9\xa4\x4d\x09\xbc\x86\xb5\xcc\x53\xbd\x1e\x28\x9d\x08\x27\x75\x3e\x28\x84\xcb\x72\xb1\xc4\x81\x2d\x94\x74\x9d\x36\x6d\x77\xbf\x0c\xbf\xc2\x6b\x20\xb4\x0d\x94\x93\xee\x9b\xe8\x9f\x46\x57\x87\x6a\xac\x49\x26\x64\x8d\x53\x1f\xb9\xa5\x29\x4e\xcb\xf9\xe0\x9b\x25\xfc\x7b\xd0\x56\x69\x5d\xfc\x59\xca\x63\x06\x4e\x3a\x85\xfc\xc6\x23\xe0\xd2\xb3\xc2\x6f\x25\x9a\x2d\x9c\x8b\x74\x8e\x86\xd1\xf8\x3d\x62\x95\xcc\x17\x60\x50\x4d\xda\x36\xd3\xc6\x25\xa5\x03\x99\xe8\xbc\x1d\x53\xd5\x96\x4b\x31\x47\xba\xe9\xc7\xb9\x98\x88\x5a\xc3\x4c\xac\xfc\xfc\x40\x26\xda\x07\xdb\x62\x34\x66\x9c\x4d\x75\xba\x05\x9d\x2b\x2d\xd2\x09\xf1\x7f\xdf\xeb\x25\x5e\xe3\xac\xd3\x7d\x43\x38\xb4\xbe\x00\x13\x20\xd3\x09\xc9\xf4\x12\xaf\x64\xbe\x20\xdc\x03\x18\x15\x1c\xbe\x86\x8f\xc1\x11\x09\x19\xa1\x84\xc7\x18\x3e\x61\x5e\x46\x08\x9b\x1a\xca\x5b\x2d\x96\x8d\x78\x0c\x2c\x84\xda\xb6\x55\x80\x70\x79\x0e\x97\xd2\x60\xe2\xd4\x96\xd1\x6c\xe4\xa1\x4e\x4c\x15\xc2\x74\x9e\x68\xa5\xcd\x84\x58\xa9\x56\x68\x08\xac\x65\xea\xb2\x09\xf9\xef\xc9\x49\xb1\x21\x9c\x39\xc3\x99\x4b\xc1\xba\xad\xc2\x09\x29\x44\x9a\xca\x7c\x3e\x86\x51\xf8\xda\x62\x33\x6d\x96\x20\x12\xbf\xf0\x3b\x71\x4a\x5a\xb7\xc0\xad\xa5\x04\x96\xe8\x32\x9d\x4e\xc8\x1c\x77\x15\xc5\x94\x98\xa2\x82\x99\xf7\x18\x04\x10\x7e\x1b\x74\x7c\x16\x4b\x1c\x33\x1a\x3e\xf3\x18\x4d\x5c\x69\x54\x98\x38\xf0\x05\xb5\xb3\x08\x79\xaa\x8c\xeb\x32\x65\xba\xf0\x22\x60\x25\x54\x89\x13\xb2\x16\x2e\xc9\x08\x0f\x0f\x46\xe3\xb7\x27\xc1\x06\xad\x2d\x97\x84\xc7\xe7\x8b\x70\x5c\x61\xee\x12\x5d\xe6\x8e\xf0\xfd\xf8\x45\xb3\xa0\xc5\xa7\x6a\x25\xdd\xb6\x92\xb6\x7b\x7d\xd1\x58\xe6\x0e\x4d\x2e\x14\xe1\xbb\xd1\x8b\x26\x42\x29\xc2\x85\x7a\x00\x64\x34\x66\xd4\xe7\x38\xfc\xb6\xe2\xb4\xcc\x8b\x72\x77\x18\x18\x91\x4a\x1d\xd3\x6c\x70\x8e\x1b\x52\xa5\xdf\xa2\x30\x49\xf6\x4b\x60\x23\xfb\xe4\x05\x84\xce\x93\x4c\xe4\x73\x9c\x90\xbf\x7c\xfd\x5d\x84\x97\x8e\xcb\xa4\xed\x12\x48\x32\x4c\x16\x98\x3e\x2e\x81\x68\x5c\x95\xec\x74\x0b\xd7\xfe\x7d\x57\x05\xcf\x0a\x2b\x84\x71\x32\x0a\x79\x46\x5c\x03\xf5\x9c\xc0\xc7\xc2\xf6\x86\x7b\x71\xb7\xd2\x6f\xc8\xba\x42\x9b\xd9\x4b\xe5\x0a\x12\x25\xac\xad\x43\xda\xaf\x4a\x83\x75\x81\xdb\x65\x2c\xcc\x8f\x18\x82\x7d\xbb\x81\x77\x52\x39\x34\xcd\xd2\x6f\xd8\x36\x83\xf7\x47\xf4\x2e\xd8\x9a\x28\xe4\x62\x4f\x5b\xcb\x8a\x4b\x9d\xca\xd5\x11\x85\x8d\xa4\x54\xdb\x3a\x95\xb6\x50\x62\x3b\xce\x75\x8e\x4f\x48\x57\x5a\x2f\xa6\x22\x59\x10\x7e\xa5\xf5\x02\xce\x45\xb2\x80\x6b\x9f\xc2\x23\x9b\xf6\xf1\xc6\xad\xad\x83\xde\x3d\x57\x0d\x3f\x52\xbf\x43\xc2\x87\xf0\x5e\x97\xe6\x71\xa5\x1f\x41\x9f\x12\x7e\x1a\xd0\xf6\xbb\xe0\x67\x84\x9f\xfd\x00\x7c\x38\x22\x7c\x38\xfa\x01\x83\xd1\x7f\xbc\xfa\x4b\x71\x64\x67\x1f\xa3\x3f\xfb\x9f\x87\xff\x8e\xb8\xf8\xbe\x60\x4f\xcf\x08\x1f\x05\xfc\x11\x39\x4f\x6c\xf1\x87\x2b\x5a\x1a\xd5\x28\xc6\x9b\xb0\x7d\xc6\x77\x1f\x65\x9e\x52\x7f\x18\xdb\x42\x24\x18\x46\xf7\xf0\xc4\x12\x3f\x55\x9d\x35\x73\x58\xed\xbd\x9f\xa7\xab\xb3\x21\x6b\x29\x36\x46\xaf\x2d\xe1\x9f\xc4\x06\xae\xf5\xda\x3e\xde\x1a\x4f\x3a\xde\xd9\x06\xbf\x35\xd1\x33\x27\x9d\x2d\xa7\x4b\xe9\x7b\x13\xa3\xbe\x93\xf9\xa7\x4b\x39\xa3\xbe\xeb\xd1\xd0\x62\x7c\xeb\x36\x94\xef\xfa\x6b\xd5\x34\xb5\x49\xd1\x84\x12\xad\xae\x17\xa1\x4b\xf2\x5b\xed\x84\x82\x8f\xb8\xb5\xf0\xc9\x87\x8c\x69\xe4\x73\x29\xbf\xbb\x1b\xf8\xf9\x6a\xfa\xfe\x7e\xef\xe8\x08\xc3\x8d\xfc\x1b\x41\xcf\x76\x24\x81\xb1\x66\x62\x85\x41\x4f\x17\xa0\x1e\xe9\xc9\xfc\xdc\xb3\x94\x41\x54\x5c\xe4\x86\xaa\x03\x2e\x0f\x39\xc2\x75\x24\x11\x31\x1b\x6c\x1a\x2a\xe7\x4a\x5a\xc7\xe8\x94\x8f\xab\x59\x5d\x9d\xdc\x77\x77\xc6\x9f\x0f\xf0\x6a\x81\xdb\x1e\xbc\x0a\xa5\x0b\xe3\x09\x0c\xa2\x9f\x46\x4d\x4a\xbe\xbb\xdf\xb4\xe3\x15\x62\x25\x71\xfd\xd3\x62\x72\x77\x37\xb8\xbf\x6f\xf3\xf0\xf0\xd7\x9c\x8a\x16\xf3\xf4\xfe\xbe\xc5\xa8\x77\xc4\xa8\xbf\x58\xf9\x95\x39\x7a\x25\x9c\x85\xc3\xf5\xc1\x85\x90\x35\x6f\x86\x16\xdd\x2d\x6e\x5c\xa7\x2e\x97\x1e\x34\x87\xc3\x93\x93\x13\xd2\xdd\x21\x2f\x8d\x2e\x52\xbd\xce\x3b\xd5\xf5\xa3\x07\xfb\x41\xbc\x71\x74\x0f\x49\xeb\x93\xb9\x07\x07\xe3\xc1\xbf\x8f\x91\xd6\xe7\x62\x0f\x0e\xc6\xc3\x87\xb4\xf5\x96\xea\xc1\xc1\x98\xee\x81\xd7\xbe\x55\x76\x0e\xbb\x62\x0f\x1e\xbd\xc7\x6e\xd5\x6d\x35\xb2\x43\xe3\xbf\x0a\xff\x04\x00\x00\xff\xff\xb5\xd8\x81\x2e\x0c\x0d\x00\x00")
+var _webfilesDebuglistkeysHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x56\x6b\x6f\xdb\x36\x17\xfe\xae\x5f\x71\x5e\xa2\x80\x9d\xb7\xb6\x19\x3b\x5b\xb0\xb9\x12\x87\x25\x69\xd1\xa2\x69\xb7\x25\x01\x36\xa0\x28\x06\x5a\x3a\xb6\x58\x53\xa2\x46\x52\xbe\x2c\xf0\x7f\x1f\x48\xca\xb7\xc4\x89\x53\x04\x31\x29\xf2\x39\xcf\xb9\x92\x87\xf1\xff\xba\xdd\xe8\x52\x55\x4b\x2d\x26\xb9\x85\x76\x7a\x02\x83\xd3\xfe\xcf\x1d\x30\x5c\xa2\x19\x2b\x9d\x62\x2f\x55\x45\x07\x44\x99\xf6\xa2\x5f\xa5\x04\x0f\x34\xa0\xd1\xa0\x9e\x61\xd6\x8b\x6e\x7f\xbf\xfa\xab\x7b\x2d\x52\x2c\x0d\x76\x3f\x64\x58\x5a\x31\x16\xa8\x87\x70\x71\x7b\xd5\x3d\xeb\x5e\x4a\x5e\x1b\x8c\xde\x29\x0d\xe3\x5a\x4a\x90\x01\x09\x16\x17\xb6\x03\x06\x11\xae\x3f\x5c\xbe\xfd\x7c\xfb\xb6\x67\x17\x16\xc6\x42\x22\x88\x12\x6c\x8e\xa0\xb1\x52\xa0\x95\xb2\xa0\x34\xe4\xd6\x56\x66\x48\xa9\xaa\xb0\x34\xaa\x76\x76\x29\x3d\xa1\x0d\x9b\xa1\x7b\xca\xba\x5d\x16\xc5\xb9\x2d\xa4\x1b\x90\x67\x2c\x02\x00\x88\x4d\xaa\x45\x65\xc1\x2e\x2b\x4c\x88\xd3\x4f\xbf\xf1\x19\x0f\xab\x24\x60\xdc\x5f\xa6\xd2\xba\xc0\xd2\xf6\xe6\x5a\x58\x6c\x93\x78\xc4\x0d\x42\xae\x71\x9c\xb4\x28\x81\xd7\x30\x17\x65\xa6\xe6\x3d\xa9\x52\x6e\x85\x2a\x7b\x15\xb7\x79\xc9\x0b\xec\x99\x4a\x0a\xdb\x6e\xd1\xd6\xc9\x97\xfe\x57\x78\x0d\x84\xb6\x80\x32\x72\xf2\xc6\x73\xc7\x34\xa8\xda\xb7\xc6\xe8\x34\x21\x73\x1c\x39\xcf\x0d\xcd\x70\x54\x4f\x7a\xdf\x0c\x61\x2f\x41\x1b\xa9\x54\xf5\x77\x2d\x0e\x09\x58\x61\x25\xb2\x5b\x87\x80\x2b\xc7\x0a\x7f\xd4\xa8\x97\x70\xc1\xb3\x09\xea\x98\x86\xfd\x80\x95\xa2\x9c\x82\x46\x99\xb4\x4c\xae\xb4\x4d\x6b\x0b\x22\x55\x65\x2b\x84\xaa\x25\x0a\x3e\x41\xba\xe8\x86\xb5\x10\x88\x8d\x0d\x63\x3e\x73\xeb\x3d\x91\x2a\xe7\x6c\x14\xd3\x10\xf1\x78\xa4\xb2\x25\xa8\x52\x2a\x9e\x25\xc4\xfd\xbe\x57\x05\xde\xe0\xb8\x7d\xf2\x86\x30\x88\xbe\x40\xcc\x41\x64\x09\xc9\x55\x81\xd7\xa2\x9c\x12\xe6\x00\x31\xe5\x0c\xbe\xfa\x4d\xaf\x88\xf8\x88\x50\xc2\x82\x0f\x9f\xb0\xac\x03\x24\x1e\x69\xca\xa2\x28\xce\x07\x2c\x38\xe6\x5d\x6d\x99\xc6\x41\xb8\xba\x80\x2b\xa1\x31\xb5\x72\x19\xd3\x7c\xe0\xa0\x96\x8f\x24\xc2\x68\x92\x2a\xa9\x74\x42\x8c\x90\x33\xd4\x04\xe6\x22\xb3\x79\x42\x7e\x3c\x3d\xad\x16\x84\xc5\x56\xb3\xd8\x66\x60\xec\x52\x62\x42\x2a\x9e\x65\xa2\x9c\x0c\x61\xe0\x77\xa3\x78\xac\x74\x01\x3c\x75\x89\x5f\x1b\x27\x85\xb1\x53\x5c\x1a\x4a\xa0\x40\x9b\xab\x2c\x21\x13\x5c\x57\x54\x2c\xf9\x08\x25\x8c\x9d\x46\x6f\x00\x61\x77\x6e\x80\xcf\xbc\xc0\x61\x4c\xfd\x36\x0b\xde\x78\xbc\x41\x89\xa9\x05\x57\x50\x6b\x09\x1f\xa7\x46\x78\x53\xa6\xb1\xaa\x9c\x11\x30\xe3\xb2\xc6\x84\xcc\xb9\x4d\x73\xc2\xfc\x10\xd3\xb0\xf7\x24\x58\xa3\x31\x75\x41\x58\x18\x8f\xc2\x71\x86\xa5\x4d\x55\x5d\x5a\xc2\xb6\xf3\xa3\x62\xde\x16\x17\xaa\x99\xb0\x4b\xc2\xf6\x3e\x8f\x0a\xe7\xc8\xa5\xcd\x09\x0b\xe3\x51\xb8\x46\x63\xb9\xb6\xde\x25\x37\x39\x2e\xa0\xa4\x54\xb5\x13\x08\x93\xa3\x02\xa2\xb4\xa8\x4b\x2e\x09\x5b\xcf\x8e\x8a\x70\x29\x09\xe3\xf2\x01\x30\xa6\x21\xc5\x2e\xe9\xfe\x3f\x0a\xcb\xa2\xac\xea\xf5\xed\xa4\x79\x26\x54\xc8\xbb\xc6\x09\x2e\x48\x53\x0f\x06\xb9\x4e\xf3\xdf\x3c\x1b\x59\xab\x69\x10\xaa\x4c\x73\x5e\x4e\x30\x21\xff\xb8\x03\x71\xe9\x3f\xda\x36\x17\xe6\x84\x40\x9a\x63\x3a\xc5\xec\x71\x4d\x06\xe1\xe6\x0c\x8d\x96\x70\xe3\xbe\xd7\x65\xf9\xac\x61\x15\xd7\x56\x04\x43\x9e\x31\x6e\x07\xf5\x9c\x81\x8f\x0d\xdb\x0a\x6e\x8d\xbb\x13\x05\xee\x1c\x99\xdd\xe8\x65\x62\x06\xa9\xe4\xc6\x6c\x5c\xda\x66\x65\x87\x75\x8a\xcb\xc2\x95\x25\x61\x1f\xd1\x3b\xfb\x76\x01\xef\x84\xb4\xa8\x77\xcf\xe2\x4e\x46\x77\x9d\x77\x3d\x63\xed\xec\x86\xc8\xc7\x62\x4b\xbb\x31\xcb\x4b\xd3\x4c\xcc\x0e\x58\xb8\x13\x94\xe6\x9e\xc9\x84\xa9\x24\x5f\x0e\x4b\x55\xe2\x13\xa6\x4b\xa5\xa6\x23\x9e\x4e\x09\xbb\x56\x6a\x0a\x17\x3c\x9d\xc2\x8d\x8b\xe7\x81\x5b\xe4\xf1\x4d\xb2\x91\xf6\xf6\x6e\xb9\x36\xf0\x03\xf5\xdb\x27\xac\x0f\xef\x55\xad\x1f\x57\xfa\x01\xf4\x19\x61\x67\x1e\x6d\x5e\x04\x3f\x27\xec\xfc\x3b\xe0\xfd\x01\x61\xfd\xc1\x77\x08\x0c\x7e\x70\xd6\x5f\xf1\xe5\xcb\xe8\xcf\x7f\x72\xf0\x3f\x11\xa7\x2f\xc2\x9f\x9d\x9d\x13\x36\xf0\xf8\x03\xe6\x3c\x71\xc4\x1f\x66\xb4\xd6\x72\xa7\x18\x6f\xfd\xd9\x1e\xde\x7f\x14\x65\x46\x5d\x77\x30\x15\x4f\xd1\xcf\x56\xf0\x44\x8a\x9f\xaa\xce\x0d\xb3\xcf\xf6\x56\xcf\xd3\xd5\xb9\x63\x56\xc1\x17\x5a\xcd\x0d\x61\x9f\xf8\x02\x6e\xd4\xdc\x3c\x3e\x1a\x4f\x2a\x5e\xcb\x7a\xbd\x1b\xa2\xfd\x30\xec\x09\x9b\x7a\x54\x08\xd7\x2c\x63\xea\x5a\xab\x1b\x6d\xc6\x62\xea\xda\x30\xf5\x3d\x8f\x45\xde\xe9\x75\xc3\x6f\xba\xb8\xd2\x19\xea\x84\xf4\x9b\x0a\x6e\xda\x36\xbb\x53\x96\x4b\xf8\x88\x4b\x03\x9f\x9c\xcb\x98\x05\x3e\x9b\xb1\xfb\xfb\x9e\x5b\x6f\x96\x57\xab\xad\xa2\x03\x0c\xb7\xe2\x5f\x04\x35\x5e\x93\x78\xc6\x0d\x53\x5c\x69\x74\x74\x5e\x99\x43\x3a\x32\xb7\xf6\x2c\xa5\xa3\x68\x92\x8c\xd9\x61\x2e\x07\x39\xc0\x75\x20\x10\xfe\x27\x8a\x47\xbe\x72\xae\x85\xb1\x31\x1d\xb1\x61\xb3\xaa\x9a\x9b\xfb\xfe\x5e\xbb\xfb\x01\x5e\x4d\x71\xd9\x81\x57\xbe\x74\x61\x98\x80\x8f\xc3\x6a\xb5\x53\x93\x82\xad\x1f\x5c\xad\xf0\xa6\x99\x09\x9c\xff\x32\x4d\xee\xef\x7b\xab\x55\xcb\xf9\xea\xcc\xe2\x6b\x5a\x2c\xb3\xd5\x2a\x8a\xa9\x53\x14\x53\xf7\xd2\x73\x99\x39\xf8\x46\x1d\xfb\xcb\xf5\xc1\x0b\xb5\x81\x06\x3a\x83\xf6\x0e\x17\xb6\xbd\x29\x97\x0e\xec\x4e\xfb\xa7\xa7\xa7\xe4\x64\x8d\xbc\xd2\xaa\xca\xd4\xbc\x6c\x37\x4f\xa3\x0e\x6c\x27\xfe\x81\xb1\x85\x06\xd2\xcd\xcd\xdc\x81\xbd\x79\xef\xff\x87\x48\x37\xf7\x62\x07\xf6\xe6\xfd\x87\xb4\x9b\x23\xd5\x81\xbd\x39\xdd\x02\x6f\x5c\x0f\x6f\xef\x77\xc5\x0e\x3c\xfa\x0e\xdd\xea\x24\xda\x89\x0e\xcd\x6d\x21\x59\xf4\xdf\x00\xeb\x47\x23\x73\x9d\x0d\x00\x00")
 
 func webfilesDebuglistkeysHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -176,7 +176,7 @@ func webfilesDebuglistkeysHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "webfiles/debuglistkeys.html", size: 3340, mode: os.FileMode(420), modTime: time.Unix(1659992879, 0)}
+	info := bindataFileInfo{name: "webfiles/debuglistkeys.html", size: 3485, mode: os.FileMode(420), modTime: time.Unix(1792320648, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -261,7 +261,7 @@ func webfilesFilterJs() (*asset, error) {
 	return a, nil
 }
 
-var _webfilesIndexHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x58\xed\x52\x1b\xb9\xd2\xfe\xcf\x55\xf4\xaa\xea\x2d\xa0\x96\x99\xc1\x36\x61\xb3\xc4\x9e\xda\x60\x48\x60\x43\xf2\xb2\x31\x24\x24\xa7\x4e\xa5\xe4\x51\xdb\x23\x2c\x4b\x13\x49\x63\xe3\xb8\xb8\xf7\x53\x92\x6c\x3c\x36\xe6\x23\xb5\xf1\x1f\x4b\x1a\xf5\xd3\x9f\x6a\x75\xab\xf9\x5b\x14\x6d\xb4\x55\x31\xd1\xbc\x9f\x5b\xd8\xca\xb6\xa1\xbe\x5b\xfb\x73\x07\x0c\x15\x68\x7a\x4a\x67\x18\x67\x6a\xb8\x03\x5c\x66\xf1\xc6\x6b\x21\xc0\x6f\x34\xa0\xd1\xa0\x1e\x21\x8b\x37\x3a\xe7\x47\x57\xd1\x19\xcf\x50\x1a\x8c\x4e\x19\x4a\xcb\x7b\x1c\xf5\x01\x1c\x76\x8e\xa2\x46\xd4\x16\xb4\x34\xb8\xf1\x46\x69\xe8\x95\x42\x80\x08\x3b\xc1\xe2\x8d\xdd\x01\x83\x08\x67\xa7\xed\xe3\x0f\x9d\xe3\xd8\xde\x58\xe8\x71\x81\xc0\x25\xd8\x1c\x41\x63\xa1\x40\x2b\x65\x41\x69\xc8\xad\x2d\xcc\x41\x92\xa8\x02\xa5\x51\xa5\x93\x4b\xe9\x7e\x32\x43\x33\xc9\x12\xb3\x28\x4a\x37\x9a\xbf\x1d\xfd\x7f\xfb\xe2\xcb\xf9\x31\xe4\x76\x28\xdc\x3c\x8a\x4c\x59\x14\x1a\x8d\x81\x13\x3b\x14\x97\x72\x20\xd5\x58\x5e\x50\xdd\x47\xbb\x03\x7f\x77\x2e\xa5\x46\xa3\xc4\x08\xd9\x27\xaa\x39\xed\x0a\x04\x0f\x64\xec\x44\x20\xd8\x49\x81\x2d\xe2\xa4\x4e\x32\x63\x48\xba\xd1\x4c\xfc\x87\x74\xa3\x99\x23\x65\xe9\x06\x00\x40\xd3\x64\x9a\x17\xb6\xba\xf9\x9a\x8e\x68\x58\x25\x61\x8f\xfb\x31\x95\x95\x43\x94\x36\x1e\x6b\x6e\x71\x8b\x34\xbb\xd4\x20\xe4\x1a\x7b\xad\xcd\x84\xc0\xef\x30\xe6\x92\xa9\x71\x2c\x54\x46\x2d\x57\x32\x2e\xa8\xcd\x25\x1d\x62\x6c\x0a\xc1\xed\xd6\x66\xb2\xb9\xfd\x9f\xda\x7f\xe1\x77\x20\xc9\x26\x24\x29\xd9\x7e\x15\xf8\x27\x81\xd5\x4c\x9a\x21\x5a\xea\x2d\x17\xe1\xf7\x92\x8f\x5a\xa4\xad\xa4\x45\x69\x23\x27\x1f\x81\x2c\xcc\x66\x82\x3a\x33\xbd\x82\x2c\xa7\xda\xa0\x6d\x95\xb6\x17\xbd\x9c\x49\xdc\xb4\xdc\x0a\x4c\x3b\x42\xa9\x62\x3a\xe5\x3d\xd8\x92\x08\x71\xbb\xd4\x1a\xa5\xf5\x90\x37\x16\x08\xd9\xbe\xbd\x85\x08\xa6\xd3\x95\x2f\xb7\xb7\xd3\x29\x4a\x76\x7b\xdb\x4c\x02\x4e\xc0\x14\x5c\x0e\x40\xa3\x68\x11\x6f\x46\x93\x23\x5a\xb2\x6a\xe5\x60\x12\x32\xc6\xae\x0b\x0c\x93\x18\x27\x42\x1c\xec\xbf\x8c\xb2\x69\x72\xa5\x6d\x56\x5a\xe0\x99\x92\x9b\x01\x68\x93\x0f\x69\x1f\x93\x9b\x28\xac\x05\xfb\xde\x81\xf5\xe8\xc8\xad\xc7\x3c\x53\x9b\xc9\xa3\x52\x05\x29\xe6\x21\x98\x31\x19\x5f\x1b\x86\x82\x8f\x74\x2c\xd1\x26\xb2\x18\x26\x5d\xa5\xac\xb1\x9a\x16\x7f\xed\xc5\x2f\xe2\x46\xc2\xb8\xf1\x2a\x2c\x3e\xc4\x43\x2e\xbd\xe8\x77\x51\x00\xc0\xa5\xc5\xbe\xe6\x76\xd2\x22\x26\xa7\x8d\x97\x7b\xd1\xc5\xd5\x4b\x5b\xff\xe3\x38\xfb\x78\xdc\xc0\x84\xe7\x97\x7f\xfc\x18\xfe\x73\xf3\x49\x66\x47\xaf\x27\x2f\xca\xd3\x77\x3f\xf6\xf4\xf1\xa0\x7f\x7a\x85\xef\x91\xed\xbd\xdf\xbd\x16\xbd\xd3\xa3\xf3\x51\x7f\xbf\xfc\xfe\xee\xb4\x7e\x73\xa5\xeb\x55\xf4\x4c\x2b\x63\x94\xe6\x7d\x2e\x5b\x84\x4a\x25\x27\x43\x55\x86\xd0\x0d\x21\xbb\xd1\xec\x2a\x36\x49\x37\x9a\x8c\x8f\xc0\x2b\xdc\x22\x8c\x9b\x42\xd0\xc9\x01\xf4\x04\xde\xbc\x82\x31\x67\x36\x3f\xa8\xed\xee\xfe\xdf\x2b\xc8\xd1\x9d\x7d\x3f\x99\xdb\xdf\x11\x72\xd6\x22\xde\x31\x02\x7b\x56\xd2\x11\x81\x4c\x50\x63\x56\x16\x17\xc1\xdf\x34\x05\x95\x73\x76\x3d\x25\x6d\x64\xf8\x0f\x3c\xa8\xef\x16\x37\x24\x04\x19\x8c\x76\xe3\x7a\x33\x71\xfb\xd2\x66\x57\x3f\x49\x5a\xab\x3b\xd2\x77\x65\x17\xb5\x44\x8b\x06\x4e\xb8\xb1\x4a\x4f\xe0\x13\x37\x25\x15\xfc\x87\x3f\x44\x15\x40\x0f\xfa\x78\x28\x2f\x78\x0a\xda\x45\x01\x3d\xa5\x5b\x24\x5b\xda\xb8\xc4\x72\xb6\x76\xd0\x4c\xfc\x7e\xc7\x22\xa9\x08\xce\x65\x51\x56\xf3\x02\xf1\x66\x5b\xc1\x83\x11\x15\x25\xb6\xc8\x9a\x33\x44\x80\x71\xe3\x72\x12\x6b\x11\xab\x4b\x24\x55\x3d\xfc\xf1\x5a\xf0\xea\x29\x3d\x04\x9a\x39\x9d\x5b\x84\xc0\x10\x6d\xae\x58\x8b\xf4\xb1\x9a\x82\xfc\xce\xdf\xa2\x08\x3e\x28\x0b\x26\x57\x63\x2e\xfb\x3e\xed\x7e\x2f\x51\x4f\x80\x69\x55\x00\x53\x63\x09\xd4\xc0\x18\x41\x49\x31\x81\x9c\x8e\xdc\x68\xbe\x87\x5a\x4f\x30\x54\x2e\x95\xf9\x5c\x79\x0f\xbc\x6a\xbc\x1e\x17\x16\xb5\x27\x25\xe9\x3f\xee\xaf\x6a\x2c\x48\xd2\xfb\x10\x06\x05\x66\x16\x5c\xe6\x6b\x91\x40\xe9\xed\x56\x85\x82\x9c\x33\x86\x72\x6e\x16\x97\x03\x3d\xd5\x3a\x69\x66\x26\xf3\x8c\x96\x3f\x57\xe4\x0c\xe4\xc8\x8e\x25\xbb\xe0\x43\x24\xe9\xb1\x64\xe0\x46\x0f\xf8\x76\x7e\x10\x96\x57\xee\x79\x9d\x51\x8b\x96\x0f\x31\x72\x49\x5d\x10\x30\x16\x8b\x16\xa9\x05\x85\x56\x79\xce\x54\xbe\xb7\x9c\x3c\xc1\xa4\x5b\x5a\xab\xe4\x5d\x20\x7d\x50\xe3\x39\x94\x74\x43\xc7\xca\x0d\x56\x84\x4f\x9c\xf4\x3e\x96\x1e\xb6\x4a\x30\xb9\x50\x6a\xd0\xa5\xd9\x80\xa4\x67\x4a\x0d\xe0\x90\x66\x03\xf8\x48\x65\xff\x51\xdb\x2c\x79\xf1\x0e\xa1\xe2\xc8\x05\xea\x7d\xed\x54\xe1\xe2\x78\xae\x50\x2d\x27\x69\x0d\x4e\x54\xa9\x9b\x49\xf8\xf2\x24\x49\x23\x27\x69\xc3\x93\x98\x67\xd3\xec\xe7\x24\xdd\xff\x49\x9a\x5a\xdd\xc9\x56\xff\x49\xaa\xfa\x9e\xd7\xe8\x88\x4e\x9e\xcf\x68\xff\xa5\xa7\xf9\x8c\x38\x78\xbe\x15\x1a\x4e\xa7\xba\x27\x7a\x40\xba\xbb\x83\x73\x97\x59\x9e\x08\x06\xe7\x50\x53\xd0\x0c\x49\xfa\xc6\x2f\xc0\x87\xf9\xca\xb3\xc3\x61\x81\x51\x89\x87\x0a\xf0\x7a\x09\x97\x57\x9f\x29\xee\x80\x4b\x76\x27\xe9\x3b\x2e\xd9\x01\x3c\x2d\xe5\x42\x28\x4f\x3e\x93\x3a\x40\xfd\x3b\xeb\x19\xa5\x2d\x49\x3b\x4a\x2f\xdd\x19\x8f\xd9\xca\x53\x54\x24\x0a\x08\x4f\x79\xde\x58\xaa\xad\xf5\x89\xac\xe3\x86\x3e\x95\x3d\x3b\x6e\x86\xca\x58\x1c\xa1\xb4\x86\xa4\xef\x95\xb1\x70\xec\x27\xcf\xa6\x77\x92\x93\xd4\xc5\xc5\x2f\x0c\xba\x21\xb5\x59\x1e\x50\x21\xf8\xf3\x11\x13\xde\xbf\x79\x17\x91\x17\x80\x56\x22\x6f\x86\xfe\x80\x40\x55\x38\x53\x76\x87\xbc\xea\x82\x66\xe2\xee\xde\xca\xdc\xdd\x3a\x17\xaa\xdf\x17\x08\x66\xcc\x6d\x96\x83\x55\xfe\xb6\x85\x82\x4e\x84\xa2\xcc\x15\xdc\xb2\x8f\x66\xe9\xee\xf3\x65\xd5\xbc\x88\xf2\x64\x91\xab\xd5\x29\x97\xa8\x57\xc3\xae\xf0\xd2\xcf\xd0\x2e\x7c\x55\xd2\x71\xf8\xe7\x33\xfc\x76\xc0\x6f\x26\x45\xba\xce\xb2\x4b\x5c\x48\x0a\x8f\x5f\x2f\x59\x8e\xd9\xa0\xab\x6e\x48\x95\x69\xdb\x2d\x12\x50\x32\xa8\xb2\xbc\x8e\x7a\x6b\x9b\x80\xa7\x43\xb6\x26\x5a\x7c\x41\x77\x57\x2f\x72\x86\x1a\xb4\x2a\xdd\xe9\x9a\x95\x6b\x2b\xd1\x12\xbc\x5c\x31\xf8\xfc\xe2\x5d\x2c\xdd\xf3\x5b\x33\xaf\xa7\x67\x5c\xba\xbc\x97\xd7\x2b\xb4\x74\x56\xd8\x33\xec\x96\xfd\x64\x5e\x7b\x1e\xb9\x19\xbc\x47\x59\x36\x13\xba\x5a\xc6\xcd\x49\x82\x01\x18\xb5\xd4\xf5\x12\xae\x6b\x20\xe9\x11\xb5\xd4\x85\x23\x82\x6b\x76\x2f\x72\x6e\xc0\x57\x39\x8f\xc0\xcc\x5b\x8a\x3e\xb7\x79\xd9\x75\x9d\x76\xb2\x68\xbc\x43\xb7\x43\xc0\xfa\x0e\xb5\x45\xbe\x75\x05\x75\x7c\x3a\xbe\xfd\x85\xb6\x62\xae\x18\x83\xb7\xdc\x9e\x94\xdd\x05\x93\xe9\x54\x3b\x37\x40\x7c\x86\x3d\x7b\x48\xb5\xd7\xbc\x5a\x1e\xce\x99\x4f\xa7\xf1\xa5\x16\xae\xb4\x5c\xe5\x30\x9d\xc6\x17\xbe\xea\xac\xa2\x86\x22\x73\x63\xd9\xea\x8b\x0e\x80\x35\xbe\xe5\xa8\x71\x51\xfc\x8f\xfa\x95\xa0\x9d\xd5\xec\x9b\xa1\x9d\x80\x7b\xfd\xc4\xab\xcd\xf4\x3e\xf4\xac\x99\x36\x3a\x5b\x58\x8a\x35\xae\x8d\xef\xfc\x59\x23\x1e\xbd\x88\xaf\x8d\x0f\x94\x6a\xd3\x1b\x26\x4b\x61\xb3\x84\x90\x29\x86\xf1\xb5\xaf\x1d\xbd\xc1\xc3\x30\x6a\xc4\x7b\x71\xcd\xf7\x68\xd7\x4b\x2d\xda\x6a\x93\x56\x7f\xb1\x1f\xb5\x3b\x57\x4a\x5f\x8d\xbe\x66\x17\x03\xca\x6f\xf6\xbf\x8c\xd4\xfe\x49\x51\x64\x5f\xdf\xa2\xed\x7e\x79\xff\xf6\x73\xe7\x8d\x38\x1c\xbf\x3c\xe9\xb5\xff\x56\xad\x65\xac\x87\x5a\xb2\x7f\xa9\x43\xc9\x93\x5a\x5c\xab\xc7\xb5\xb9\x36\x25\x7f\xa6\x2a\x9f\xe8\x8f\xf3\x3f\xff\xf8\xda\x1e\x5b\x1c\xbc\x36\xa3\xfe\xf9\x61\xe7\x72\x7c\xfe\xe6\x1d\xd3\xe3\xa3\x46\x29\x2f\x7b\x9d\xb7\x9f\xbe\x68\x9a\x5f\x7e\xbf\xfc\x69\x55\x82\x2e\x3e\x05\xba\xc3\xc0\x8d\x6f\x17\x04\x37\x16\x54\x0f\xc2\x2e\x03\x12\x91\x21\x83\xee\x04\x34\xce\x5e\x76\x72\x3b\x14\x3b\xd0\xc5\x8c\x96\x06\x81\x4b\x89\xda\xbf\xe2\x40\x46\x25\x48\x65\x21\x2c\x67\xa2\x64\x95\xcc\xb9\x36\x5e\x4a\x59\x0c\xfa\xde\x46\xf4\x86\x2b\x13\xfa\x72\x3f\x9c\x1b\x08\xa8\x99\xc8\xcc\x1d\xe9\x07\x5e\x6d\xd6\xfa\x66\xc5\x1f\xeb\x9e\x04\x46\x25\x06\x76\xa3\x12\x7f\x15\xa3\x85\x3a\xb2\xd0\xaa\xaf\xd1\x98\xbf\x76\xe3\x7a\xbc\xbb\x98\xff\x32\x9d\x70\x88\x9a\x67\x83\x78\x96\x9c\xb8\x4a\xae\x0d\xe3\xbd\x9e\xe0\xdd\xc4\xfd\x8f\x38\x8e\x3d\xb3\xf5\x3c\xe0\x97\x30\x11\xbc\xfb\x4c\x1e\xf7\x99\x2c\x5e\x7a\xfc\xdd\xfe\x70\xb2\x58\x64\xe6\x24\x81\xcf\x39\x4a\xd7\xf1\x6a\xf4\xf7\xa7\x0b\xd9\x82\xf6\x11\xac\x8b\xe1\x31\x17\x02\x0c\x86\xc6\x37\x53\x5a\xbb\xea\x2c\x54\x30\x5c\x49\xe3\x0a\x15\xff\xc9\xb5\xcf\x91\x6b\x9f\x0d\x74\xa9\x41\xe6\x12\x75\x41\x8d\x1b\x71\x37\xd2\x74\x88\xae\x82\x5b\xbc\x08\x52\x4b\xfd\x6d\x71\xa9\x05\xb4\x1c\x8b\x50\xd8\x98\xd7\x92\x7d\x44\x5b\x6a\x39\xff\xba\xe5\x12\xf7\x11\xf6\x68\x29\xec\xd9\xac\x71\xba\xbd\x25\x3b\x50\x59\x77\xd5\xed\xea\xda\x5d\x6d\x7e\x7b\x3b\x7f\x2e\x84\xea\x53\x64\x1f\xed\xb1\x40\x37\x3c\x9c\x9c\xb2\xad\xe5\xcb\x6d\x3b\x76\x77\x06\xb4\x96\xe4\x5c\xfb\xe6\xb8\xd6\x01\xfe\x26\xfb\x56\xf2\x75\x2e\x08\x09\xbf\x99\x84\xa7\xa8\xff\x05\x00\x00\xff\xff\xef\xab\xd7\xea\x91\x16\x00\x00")
+var _webfilesIndexHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x58\x6f\x6f\xdb\x38\xd2\x7f\xef\x4f\x31\x4b\x3c\x0f\x9c\x60\x6b\x29\xb6\xd3\x6c\x37\x95\x85\x6d\x9c\xb4\xc9\x36\xed\x65\xeb\xa4\x9b\xee\xe1\x50\xd0\xe2\xd8\x62\x4c\x93\x2a\x49\xd9\x71\x0d\x7f\xf7\x03\x29\xff\x91\xec\xa4\x4d\x71\x85\x03\x84\xa4\x38\xbf\x19\xce\x0c\x67\x38\x13\xfd\xd2\x68\xd4\xba\x2a\x9b\x69\x3e\x4c\x2d\xec\x25\xfb\xd0\x3a\x68\xfe\xfe\x0c\x0c\x15\x68\x06\x4a\x27\x18\x24\x6a\xfc\x0c\xb8\x4c\x82\xda\x2b\x21\xc0\x6f\x34\xa0\xd1\xa0\x9e\x20\x0b\x6a\xbd\xab\xd3\xdb\xc6\x25\x4f\x50\x1a\x6c\x5c\x30\x94\x96\x0f\x38\xea\x63\x38\xe9\x9d\x36\xda\x8d\xae\xa0\xb9\xc1\xda\x6b\xa5\x61\x90\x0b\x01\xa2\xd8\x09\x16\xef\xed\x33\x30\x88\x70\x79\xd1\x3d\x7b\xdf\x3b\x0b\xec\xbd\x85\x01\x17\x08\x5c\x82\x4d\x11\x34\x66\x0a\xb4\x52\x16\x94\x86\xd4\xda\xcc\x1c\x87\xa1\xca\x50\x1a\x95\x3b\xb9\x94\x1e\x86\x4b\x34\x13\x56\x98\x35\x1a\x71\x2d\xfa\xe5\xf4\x5f\xdd\xeb\x4f\x57\x67\x90\xda\xb1\x70\xf3\x46\xc3\xe4\x59\xa6\xd1\x18\x38\xb7\x63\x71\x23\x47\x52\x4d\xe5\x35\xd5\x43\xb4\xcf\xe0\xcf\xde\x8d\xd4\x68\x94\x98\x20\xfb\x48\x35\xa7\x7d\x81\xe0\x81\x8c\x9d\x09\x04\x3b\xcb\xb0\x43\x9c\xd4\x61\x62\x0c\x89\x6b\x51\xe8\x3f\xc4\xb5\x28\x45\xca\xe2\x1a\x00\x40\x64\x12\xcd\x33\x5b\xde\x7c\x47\x27\xb4\x58\x25\xc5\x1e\xf7\x63\x2a\xc9\xc7\x28\x6d\x30\xd5\xdc\xe2\x1e\x89\xfa\xd4\x20\xa4\x1a\x07\x9d\x7a\x48\xe0\x57\x98\x72\xc9\xd4\x34\x10\x2a\xa1\x96\x2b\x19\x64\xd4\xa6\x92\x8e\x31\x30\x99\xe0\x76\xaf\x1e\xd6\xf7\xff\xdd\xfc\x0f\xfc\x0a\x24\xac\x43\x18\x93\xfd\x97\x1e\x3b\x0a\x0b\x56\x4b\x69\xc6\x68\xa9\xd7\x5c\x03\xbf\xe4\x7c\xd2\x21\x5d\x25\x2d\x4a\xdb\x70\xf2\x11\x48\x8a\xd9\x52\x50\xa7\xa6\x97\x90\xa4\x54\x1b\xb4\x9d\xdc\x0e\x1a\x2f\x96\x12\x47\x96\x5b\x81\x71\x4f\x28\x95\xcd\xe7\x7c\x00\x7b\x12\x21\xe8\xe6\x5a\xa3\xb4\x1e\xf2\xde\x02\x21\xfb\x8b\x05\x34\x60\x3e\xdf\xfa\xb2\x58\xcc\xe7\x28\xd9\x62\x11\x85\x05\x4e\x81\x29\xb8\x1c\x81\x46\xd1\x21\x5e\x8d\x26\x45\xb4\x64\x5b\xcb\x85\x4a\xc8\x14\xfb\xce\x31\x4c\x68\x9c\x08\x81\xfb\xb2\x8d\x52\x37\xa9\xd2\x36\xc9\x2d\xf0\x44\xc9\x7a\x01\x54\xe7\x63\x3a\xc4\xf0\xbe\x51\xac\x79\xb0\xfa\x1a\x6c\x40\x27\x6e\x3d\xe0\x89\xaa\x87\xdf\x94\xca\x13\x92\x95\x0b\x26\x4c\x06\x77\x86\xa1\xe0\x13\x1d\x48\xb4\xa1\xcc\xc6\x61\x5f\x29\x6b\xac\xa6\xd9\x1f\x87\xc1\xf3\xa0\x1d\x32\x6e\xfc\x11\x36\x1f\x82\x31\x97\x5e\xf4\xb5\x17\x00\x70\x69\x71\xa8\xb9\x9d\x75\x88\x49\x69\xfb\xc5\x61\xe3\xfa\xf6\x85\x6d\xfd\x76\x96\x7c\x38\x6b\x63\xc8\xd3\x9b\xdf\xbe\x8e\xff\xba\xff\x28\x93\xd3\x57\xb3\xe7\xf9\xc5\xdb\xaf\x87\xfa\x6c\x34\xbc\xb8\xc5\x77\xc8\x0e\xdf\x1d\xdc\x89\xc1\xc5\xe9\xd5\x64\x78\x94\x7f\x79\x7b\xd1\xba\xbf\xd5\xad\x32\x7a\xa2\x95\x31\x4a\xf3\x21\x97\x1d\x42\xa5\x92\xb3\xb1\xca\x9d\xea\xa2\xb0\x70\xd9\x5a\xd4\x57\x6c\x16\xd7\x22\xc6\x27\xe0\xcd\xd0\x21\x8c\x9b\x4c\xd0\xd9\x31\x0c\x04\xde\xbf\x84\x29\x67\x36\x3d\x6e\x1e\x1c\xfc\xff\x4b\x48\xd1\xdd\x7d\x3f\x59\xe9\xdf\x11\x72\xd6\x21\xde\x30\x02\x07\x56\xd2\x09\x81\x44\x50\x63\xb6\x16\x37\xce\x1f\x99\x8c\xca\x15\xbb\x81\x92\xb6\x61\xf8\x57\x3c\x6e\x1d\x64\xf7\xa4\x70\x32\x98\x1c\x04\xad\x28\x74\xfb\xe2\xa8\xaf\xbf\x4b\xda\x6c\x39\xd2\xb7\x79\x1f\xb5\x44\x8b\x06\xce\xb9\xb1\x4a\xcf\xe0\x23\x37\x39\x15\xfc\xab\xbf\x44\x25\x40\x0f\x5a\xb8\xf2\xd0\xc2\x9e\x40\x09\xc1\xd2\x5d\xcd\x3e\x34\xf7\x17\x8b\x0d\x4b\x41\xfb\x28\x60\xa0\x74\x87\x24\x15\xc7\xae\x70\x5c\xae\x1d\x47\xa1\xdf\xef\xb8\x2c\x7d\xca\xfd\x45\x06\x05\x26\x16\x38\xdb\x01\x01\x25\x93\x94\xca\x21\x76\xc8\xf6\xa5\x77\x6e\x07\x1d\xa8\x87\x75\xf8\x15\x50\x26\x8a\xe1\xcd\x87\x8b\xae\x1a\x67\x4a\xa2\xb4\x7b\x36\xe5\x26\x98\x50\x91\xe3\xfe\x03\x21\xc3\x20\xd5\x49\x4a\xe2\xda\x7c\xae\x1d\xfe\xe6\x84\x8b\xc5\x4a\x2e\xf7\x8b\x54\xe6\xf6\x83\x07\xea\x90\xf9\x3c\x58\x2c\x48\xa1\x1b\xfc\x02\x01\xfc\xdf\xd6\x7d\x76\xd7\xbc\x38\x0e\xb2\xe5\xcd\x8e\x3d\x51\x14\x16\x48\x8e\xa3\x5f\x5e\x73\x08\x8b\xfd\x65\xd5\xa3\x30\x08\xdf\x0a\x25\x3f\xd9\x00\x5c\x66\x79\x39\x2e\x93\x07\x6d\xb1\xd1\x41\x55\xa4\xc5\x82\x00\xe3\xc6\xe5\x04\xd6\x21\x56\xe7\x48\x2a\x87\x91\xac\x2c\xf0\x40\xe9\x31\xd0\xc4\xe9\xa2\x43\x08\x8c\xd1\xa6\x8a\x75\xc8\x10\xcb\x29\xc0\xfd\x5c\x4e\x82\xf7\xca\x82\x49\xd5\x94\xcb\xa1\x4f\x7b\x5f\x72\xd4\x33\x60\x5a\x65\xc0\xd4\x54\x02\x35\x30\x45\x50\x52\xcc\x20\xa5\x13\x37\x5a\xed\xa1\xd6\x13\x8c\x95\x4b\x25\x3e\x57\xed\x80\x97\x95\x37\xe0\xc2\xa2\xf6\xa4\x24\xfe\xcb\xfd\x2b\x2b\x0b\xc2\x78\x17\x62\xe9\xb6\x2e\xf3\x74\x48\x41\xe9\xf5\x56\x86\x82\x94\x33\x86\x72\xa5\x96\x8d\xb5\x77\x8f\xba\x52\x99\x67\x54\xfd\x5c\x92\x73\xe5\x5c\x67\x92\x5d\xf3\x31\x92\xf8\x4c\x32\x70\xa3\x47\x6c\xbb\x0a\x44\xd5\x95\x1d\xab\x33\x6a\xd1\xf2\x31\x36\xdc\x0d\x11\x04\x8c\xc5\xac\x43\x9a\x85\x23\x6c\xf3\x5c\x1e\x79\x67\x39\xfc\x0e\x93\x7e\x6e\xad\x92\x6b\x47\x7a\xaf\xa6\x2b\x28\xe9\x86\x8e\x95\x1b\x54\x51\xa2\x90\xf1\x89\x3b\x54\x5c\x7b\x5c\x2b\x85\xca\x85\x52\xa3\x3e\x4d\x46\x24\xbe\x54\x6a\x04\x27\x34\x19\xc1\x07\x77\xbb\xbf\xa5\x9b\x8a\x15\xd7\x08\x25\x43\x6e\x50\x2b\x84\x0f\x44\x87\x66\x4a\xe2\x26\x9c\xab\x5c\x6f\x6e\xfb\x6a\xeb\x23\x24\xed\x94\xc4\x6d\x4f\x62\x9e\x4c\x73\x94\x92\xf8\xe8\x07\x69\x9a\x2d\x27\x5b\xeb\x07\xa9\x5a\x87\x8e\x0a\x4e\xe9\xec\xe9\x8c\x8e\x5e\x78\x9a\xbf\x11\x47\x4f\x26\x6a\xb7\xdd\x99\x5a\x9e\xe8\x11\xe9\xd6\x17\x67\x1d\x59\xbe\xe3\x0c\xce\xb1\x4c\x46\x13\x24\xf1\x6b\xbf\x00\xef\x57\x2b\x4f\x76\x87\x0d\x46\xc9\x1f\x4a\xc0\x0f\x4b\x58\x5d\x7d\xa2\xb8\x23\x2e\xd9\x5a\xd2\xb7\x5c\xb2\x63\xf8\xbe\x94\x1b\xa1\x3c\xf9\x52\x6a\x3f\x7e\x44\xb6\xa7\x8a\x63\x94\xb6\x24\xee\x29\x5d\xc9\x19\x0f\x4b\xb1\x8c\x06\x8e\xa2\x24\x51\x81\xf0\x3d\xcb\x1b\x4b\xb5\x75\x81\x87\xc4\x3d\x37\xf4\xa1\xec\xc9\x7e\x33\x56\xc6\xe2\x04\xa5\x35\x24\x7e\xa7\x8c\x85\x33\x3f\x79\x32\xbd\x93\x9c\xc4\xef\xe9\x63\x2c\x7f\x58\x6d\x0e\x70\x4c\xad\x7b\x59\x38\x54\x28\xec\xf9\x0d\x15\x96\xc3\xa3\xcb\xa5\xeb\x80\xb8\x06\x2a\xa9\xb4\x84\xfe\x88\x40\x65\x38\x93\xf7\xc7\xbc\x6c\x82\x28\x74\xb9\xb7\x34\x77\x59\xe7\x5a\x0d\x87\x02\xc1\x4c\xb9\x4d\x52\xb0\xca\x67\x5b\xc8\xe8\x4c\x28\xca\x5c\xc1\x23\x87\x68\x2a\xb9\xcf\x65\x93\xf5\x23\xd6\x93\x35\x5c\xad\x44\xb9\x44\xbd\x65\xf0\x28\xf3\xd2\x2f\xd1\xae\xdd\xf9\xe2\x9e\xc3\xbf\x5a\xe2\x77\x0b\xfc\x28\xcc\xb6\x08\x0b\xcd\x56\xb8\x90\x18\x2a\x7b\x76\x0e\x9c\xa4\x98\x8c\xfa\xea\x9e\x94\x99\x76\xdd\x62\xf9\x1d\x59\x5e\x47\xbd\xb7\x4f\xc0\xd3\x21\xab\x4a\xb0\x79\x50\xaf\x84\x10\x9c\xa1\x06\xad\x72\x77\xbb\x96\xcf\xe5\x0a\xc9\xca\xca\xeb\xc5\x22\x75\xb9\xe9\x66\x69\xc7\x6e\x51\xda\x8a\x2f\xb9\x1c\x99\x28\x4c\x5b\x25\x5a\xba\x2c\xef\x18\xf6\xf3\x61\xb8\x7a\xfb\x9f\xba\x19\xbc\x43\x99\x47\x21\xdd\x8a\x0c\x6b\x92\x42\x01\x8c\x5a\xea\x0a\x43\x57\x4b\x92\xf8\x94\x5a\xea\xdc\x11\xc1\x35\x1b\xae\x53\x6e\xc0\xbf\x72\xbe\x01\xb3\x2a\xe9\x86\xdc\xa6\x79\xdf\x75\x3a\xc2\x4d\xe3\xa3\xa8\x36\x09\x58\xdf\x21\xe8\x90\xcf\x7d\x41\x1d\x9f\x9e\x6f\x3f\x40\x57\x31\xf7\x18\x83\x37\xdc\x9e\xe7\xfd\x0d\x93\xf5\x73\xfb\x12\x07\xf6\x84\x6a\x7f\xf2\xc5\x62\x97\xf9\x7c\x1e\xdc\x68\xb1\x58\xec\x72\x98\xcf\x83\x6b\x5f\x39\x97\x51\xfd\x93\xba\x56\xab\x6a\x7d\x53\x81\xb1\xf6\xe7\x14\x35\x6e\x8a\xaf\xc9\xb0\xe4\xb4\xcb\x9a\xa9\x5e\x94\x73\xb0\x53\xcf\xbd\xac\xc7\xbb\xd0\xcb\x66\x86\xd1\xc9\x46\x53\xac\x7d\x67\x7c\xe7\x85\xb5\x83\xc9\xf3\xe0\xce\x90\x78\xab\xe9\x50\x4c\xd6\xc7\x75\x7f\x15\x04\x57\xc6\x04\x77\xfe\xed\xe8\x15\x5e\x0c\x1b\xed\xe0\x30\x68\xfa\x1a\xf9\xae\x52\x22\x6f\x17\xc9\xad\xe7\x47\x8d\x6e\xef\x56\xe9\xdb\xc9\x3f\xc9\xf5\x88\xf2\xfb\xa3\x4f\x13\x75\x74\x9e\x65\xc9\x3f\x6f\xd0\xf6\x3f\xbd\x7b\xf3\x77\xef\xb5\x38\x99\xbe\x38\x1f\x74\xff\x54\x9d\x2a\xd6\x63\x25\xf1\xff\x78\x86\x9c\x87\xcd\xa0\xd9\x0a\x9a\xab\xd3\xe4\xfc\x89\x47\xf9\x48\xbf\x5e\xfd\xfe\xdb\x3f\xdd\xa9\xc5\xd1\x2b\x33\x19\x5e\x9d\xf4\x6e\xa6\x57\xaf\xdf\x32\x3d\x3d\x6d\xe7\xf2\x66\xd0\x7b\xf3\xf1\x93\xa6\xe9\xcd\x97\x9b\x1f\x3e\x4a\x6d\x13\x02\xdd\x65\xe0\xc6\x97\x0b\x82\x1b\x0b\x6a\x00\xc5\x81\x0d\x48\x44\x86\x0c\xfa\x33\xd7\xd3\xf3\xae\x1d\xb8\x56\xd0\x33\xe8\x63\xe2\xba\x69\xc0\xa5\x44\xd7\x80\x1b\x0b\x48\xa8\x04\xa9\x2c\x14\xcb\x89\xc8\x59\x29\x72\xae\x9a\x5f\x15\x4d\xe5\x32\x1b\x0d\xbd\x8e\xe8\x3d\x57\xa6\xe8\x8b\xf8\xe1\x4a\x41\x40\xcd\x4c\x26\xee\x4a\x3f\xd2\x35\x7b\xd0\x36\x5b\xf6\x78\xa8\x25\x33\xc9\xb1\x60\x37\xc9\xf1\x67\x31\xda\x1c\x47\x66\x5a\x0d\x5d\x33\xf1\x8f\x83\xa0\x15\x1c\x6c\xe6\x3f\xed\x4c\x38\x46\xcd\x93\x51\xb0\x0c\x4e\x5c\x85\x77\x86\xf1\xc1\x40\xf0\x7e\xe8\xfe\x4f\x38\x4e\x3d\xb3\x87\x79\xc0\x4f\x61\x22\x78\xff\x89\x3c\x76\x99\x6c\x3a\x6d\x3e\xb7\x3f\x1e\x2c\x36\x91\x39\x0c\xe1\xef\x14\xa5\xab\x78\x35\xfa\xfc\xe9\x5c\x36\xa3\x43\x04\xd7\xed\x80\x29\x17\x02\x0c\x16\x85\x6f\xa2\xb4\x76\xaf\xb3\xe2\x05\xc3\x95\x34\xee\x7d\xe7\x3f\xb9\xf2\xb9\xc1\xd4\x54\x1a\x70\xad\x55\xe6\x02\x75\x46\x8d\x1b\x71\x37\xd2\x74\x8c\xee\x05\xb7\x66\xec\x52\x89\xcf\x16\x37\x5a\x40\xc7\xb1\x28\x1e\x36\xe6\x95\x64\x1f\xd0\xe6\x5a\xae\xbe\xee\x91\xf9\x3c\x38\xc5\x01\xcd\x85\xbd\x5c\x16\x4e\x8b\x05\x79\x06\xa5\x75\xf7\xba\xdd\x5e\x5b\xbf\xcd\x17\x8b\x55\xbb\xb6\xd2\x0a\x1e\xa2\x3d\x13\xe8\x86\x27\xb3\x0b\xb6\x57\x4d\x6e\xfb\xab\x66\x50\x59\xce\x07\x7b\xbe\x0f\x1a\xc0\x67\xb2\xcf\x39\x7f\xc8\x04\x45\xc0\x8f\xc2\xbe\x62\xb3\xb8\xf6\xdf\x01\x00\x7e\xa4\x1a\x5b\x11\x18\x00\x00")
 
 func webfilesIndexHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -276,12 +276,12 @@ func webfilesIndexHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "webfiles/index.html", size: 5777, mode: os.FileMode(420), modTime: time.Unix(1659992879, 0)}
+	info := bindataFileInfo{name: "webfiles/index.html", size: 6161, mode: os.FileMode(420), modTime: time.Unix(1792315322, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _webfilesResourceCss = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x92\x41\x4f\x1b\x31\x10\x85\xef\xfb\x2b\x46\x42\x95\x00\xc5\xd9\x40\xcb\x01\x73\x6a\x21\xa8\x48\xa8\xaa\xc4\xa5\x37\xe4\xb5\x67\x77\x2d\x26\x9e\xd5\x78\x36\x24\x42\xfd\xef\xd5\x6e\x36\xa5\x8d\xa0\xbd\x59\x9e\xe7\xf7\x3e\x3f\xbb\x3c\x2d\xe0\x14\xae\xb9\xdb\x4a\x6c\x5a\x85\x63\x7f\x02\xe7\x8b\xb3\xcb\x19\x64\x47\x98\x6b\x16\x8f\x73\xcf\xab\x19\xc4\xe4\xe7\x83\xf6\x33\x11\x8c\xda\x0c\x82\x19\x65\x8d\x61\xdc\x7f\xf8\x7e\xf3\xc3\xdc\x47\x8f\x29\xa3\xb9\x0b\x98\x34\xd6\x11\xc5\xc2\x97\x87\x1b\xf3\xd1\x5c\x93\xeb\x33\x0e\xc2\x5b\x16\xa8\x7b\x22\xa0\x9d\x18\x14\x37\x3a\x83\x8c\x08\xf7\x77\xd7\xcb\x6f\x0f\xcb\xb9\x6e\x14\xea\x48\x08\x31\x81\xb6\x08\x82\x1d\x83\x30\x2b\xb0\x40\xab\xda\x65\x5b\x96\xdc\x61\xca\xdc\x0f\x80\x2c\x4d\x39\xb9\xe5\xf2\x20\xaf\x2c\x8a\x56\x57\x34\x83\x8a\xc3\x16\x5e\x0a\x00\x00\x5e\xa3\xd4\xc4\xcf\x16\x5c\xaf\x7c\x55\xfc\x2c\x8a\x23\xc1\x9d\xd9\x23\xae\x31\xe9\xa3\xba\x8a\x70\x92\xd7\x9c\xd4\xd4\x6e\x15\x69\x6b\xe1\x2b\xd2\x1a\x35\x7a\x37\x54\x94\xb2\xc9\x28\xb1\xbe\x1a\x75\x15\x4b\x40\x31\x9e\x89\x5c\x97\xd1\xc2\x7e\xb5\x1b\x3f\xc7\xa0\xad\x85\xb3\xc5\xe2\xc3\x18\x39\xdf\x47\x9a\xca\x89\x21\x57\x21\xed\x03\x23\x91\x85\xa3\xe5\xc5\xf2\xf2\x76\x71\x80\xe7\x39\xa9\x8b\x09\x65\xd2\x1e\xb8\x1e\x79\xe2\xfc\x1b\x9c\xd8\xa9\x1d\x5f\x6b\x87\xd0\xb9\x10\x62\x6a\xec\x79\xb7\x81\x8b\x6e\x33\x61\x3b\xff\xd4\x08\xf7\x29\xd8\x55\x0c\x69\x50\x57\xd4\x4f\xd0\x9e\x89\xc5\xc2\x73\x1b\x15\x47\xff\x37\x7b\xd2\x30\x83\x77\x26\xed\xc4\xb2\x2b\xc7\xc2\x59\xb7\x81\xcc\x14\x03\xd0\x90\xd4\x08\x6e\xff\x66\x83\x4f\x03\xd8\xbb\x4f\xa2\x62\x93\xb6\xc6\xb7\x91\xc2\xf1\x30\x38\x79\x79\xbd\x80\xf9\x13\x37\xaf\xf8\x09\xaf\xfe\x65\xd4\x0e\x1f\x01\xde\x38\xff\x8a\xf6\xfe\xf1\xfd\xc5\x86\xef\x6b\x1c\xc5\x26\x59\x20\xac\xf5\xb0\xd4\xbd\xe7\xff\xba\x1d\x77\x7a\xc9\x2c\xb6\xe3\x98\x14\x65\x68\xe1\x57\x00\x00\x00\xff\xff\x99\x8a\x0e\x7b\xa1\x03\x00\x00")
+var _webfilesResourceCss = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x92\x4f\x6b\xdb\x40\x10\xc5\xef\xfa\x14\x03\xa1\x90\x04\xcb\x7f\xd2\xe6\x90\xf5\xa9\x4d\x1c\x1a\x08\xa5\x90\x4b\x6f\x66\xad\x1d\x49\x4b\x46\x3b\xcb\xec\xca\x96\x31\xf9\xee\x45\xb2\x9c\xc4\x8e\xda\x42\x6f\x42\xfb\xe6\xcd\x6f\xde\xcc\xe4\x32\x81\x4b\xb8\x65\xbf\x15\x5b\x94\x11\xce\xb3\x0b\xb8\x9a\xce\x6e\x46\x10\x34\x61\xc8\x59\x32\x1c\x67\x5c\x8d\xc0\xba\x6c\xdc\x6a\xbf\x12\x41\xa7\x0d\x20\x18\x50\xd6\x68\xba\xff\x4f\x3f\xef\x7e\xa5\x8f\x36\x43\x17\x30\x7d\x30\xe8\xa2\xcd\x2d\x8a\x82\x6f\x4f\x77\xe9\xe7\xf4\x96\x74\x1d\xb0\x15\xde\xb3\x40\x5e\x13\x01\xed\xc5\x10\xb1\x89\x23\x08\x88\xf0\xf8\x70\xbb\xf8\xf1\xb4\x18\xc7\x26\x42\x6e\x09\xc1\x3a\x88\x25\x82\xa0\x67\x10\xe6\x08\x2c\x50\xc6\xe8\x83\x9a\x4c\xd8\xa3\x0b\x5c\xb7\x80\x2c\xc5\xa4\x77\x0b\x93\x93\x7e\x93\x24\x29\x63\x45\x23\x58\xb1\xd9\xc2\x2e\x01\x00\xe0\x35\x4a\x4e\xbc\x51\xa0\xeb\xc8\xf3\xe4\x25\x49\xce\x04\xf7\x66\x4b\x5c\xa3\x8b\xcb\xa8\x57\x84\xbd\x3c\x67\x17\xd3\x5c\x57\x96\xb6\x0a\xbe\x23\xad\x31\xda\x4c\xb7\x11\xb9\x90\x06\x14\x9b\xcf\x3b\xdd\x8a\xc5\xa0\xa4\x19\x13\x69\x1f\x50\xc1\xe1\x6b\xff\xbc\xb1\x26\x96\x0a\x66\xd3\xe9\xa7\xae\xe5\xf8\xd0\x32\x5d\x69\x49\x49\xaf\x90\x0e\x0d\x2d\x91\x82\xb3\xc5\xf5\xe2\xe6\x7e\x7a\x82\x97\xb1\x8b\xda\x3a\x14\xd8\x0d\xb9\x9e\x65\xc4\xe1\x15\x9c\x58\x47\xd5\x6d\x6b\x8f\xe0\xb5\x31\xd6\x15\xea\xca\x37\x70\xed\x9b\x1e\x5b\x67\xcf\x85\x70\xed\x8c\xaa\xac\x71\xad\x7a\x45\x75\x0f\x9d\x31\xb1\x28\xd8\x94\x36\x62\xe7\x3f\x98\x53\x34\x23\x18\x4e\x30\x96\x3d\xcb\x3e\x1c\x05\x33\xdf\x40\x60\xb2\x06\xa8\xed\x54\x08\x6e\x8f\xd9\xe0\x8b\x6f\x4e\x66\x3e\x32\x14\xe5\x62\x99\x66\xa5\x25\x73\xde\x3e\x5c\xec\xde\x06\x48\xdf\xe3\x86\x8a\x9f\x71\xfe\x37\xa3\xb2\x3d\x04\x18\xa8\x7f\x43\xfb\x73\xf9\x61\xb0\xf6\x7c\x53\x4d\xb6\x70\x0a\x08\xf3\x78\x1a\xea\xc1\xf3\x5f\xd9\x76\x7f\x6a\x09\x2c\xca\xb3\x75\x11\xe5\xf8\x4a\x96\x85\x68\x5f\x2e\x09\xd7\x48\xff\x77\x98\x2d\x9d\x82\xab\xd7\x05\x7c\x24\xea\x77\x70\x50\x4e\x7d\x33\xc8\xb0\xb6\x1a\x76\xef\x47\xd8\x6f\x71\x40\x59\xd9\x10\xac\x2b\x8e\xd5\x46\xcb\xb3\xa0\x19\x2c\x88\xb6\xc2\x63\xf5\x87\x0b\xe9\xe9\x66\x53\xdf\xcc\x93\x97\xe4\xf7\x00\x3c\x5f\x48\xaf\xc3\x04\x00\x00")
 
 func webfilesResourceCssBytes() ([]byte, error) {
 	return bindataRead(
@@ -296,12 +296,12 @@ func webfilesResourceCss() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "webfiles/resource.css", size: 929, mode: os.FileMode(420), modTime: time.Unix(1659992879, 0)}
+	info := bindataFileInfo{name: "webfiles/resource.css", size: 1219, mode: os.FileMode(420), modTime: time.Unix(1792319281, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _webfilesResourceHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x3a\x79\x6f\xdb\x46\xf6\xff\xfb\x53\xbc\xb0\x45\x49\xfd\x6a\x91\xb6\x8b\x1f\xb0\x4b\x8b\xea\x61\xa7\xd8\x34\x8e\x13\x54\x6e\xd0\x45\x51\x04\x23\xf2\x51\x9a\x98\x9a\xe1\xce\x0c\x15\x6b\x55\x7d\xf7\xc5\xcc\x50\xe2\xa5\xcb\x4e\x16\xc1\x02\xe1\x3f\x9e\xe3\x5d\xf3\xe6\xcd\xbb\xac\xc1\xb3\x7e\xff\xe4\x8a\xe7\x0b\x41\x27\x53\x05\x5e\xdc\x83\x8b\xb3\xf3\xbf\x9f\x82\x24\x19\xca\x94\x8b\x18\xfd\x98\xcf\x4e\x81\xb2\xd8\x3f\xf9\x31\xcb\xc0\x00\x4a\x10\x28\x51\xcc\x31\xf1\x4f\x46\x6f\xae\x7f\xef\xdf\xd0\x18\x99\xc4\xfe\x8b\x04\x99\xa2\x29\x45\x11\xc2\x4f\xa3\xeb\xfe\x77\xfd\xab\x8c\x14\x12\x4f\x7e\xe6\x02\xd2\x22\xcb\x20\xb3\x90\xa0\xf0\x41\x9d\x82\x44\x84\x9b\x17\x57\xcf\x6f\x47\xcf\x7d\xf5\xa0\x20\xa5\x19\x02\x65\xa0\xa6\x08\x02\x73\x0e\x82\x73\x05\x5c\xc0\x54\xa9\x5c\x86\x41\xc0\x73\x64\x92\x17\x5a\x2e\x2e\x26\x41\x49\x4d\x06\x0d\x66\xfd\xfe\xf0\x64\xf0\xec\xfa\xf5\xd5\xdd\x3f\xdf\x3c\x87\xa9\x9a\x65\xc3\x93\x81\xfe\x03\x19\x61\x93\xc8\x41\xe6\xe8\x05\x24\xc9\xf0\x04\x00\x60\x30\x43\x45\x20\x9e\x12\x21\x51\x45\xce\x6f\x77\x3f\xf7\xff\xe6\x94\x5b\x19\x65\xf7\x20\x30\x8b\x5c\x39\xe5\x42\xc5\x85\x02\x1a\x73\xe6\x82\x5a\xe4\x18\xb9\x74\x46\x26\x18\x3c\xf4\xed\xda\x54\x60\x1a\xb9\x1f\x70\xac\xcf\x21\x83\x94\xcc\xf5\xba\x4f\x63\xee\x06\x6d\x7a\x8e\x54\x8b\x0c\xe5\x14\x51\x39\x96\x98\xa3\x75\x12\xc4\x52\x3a\x96\x90\xb3\x21\x24\x33\xce\x73\x5f\xef\x7c\x0c\x15\x81\xa5\xe6\x0e\x12\xb2\x88\x6b\x9d\x17\x2c\xbf\x9f\x68\x33\x08\x58\x2e\xf8\x44\xa0\x94\x3f\x9c\xf9\x17\xfe\x59\x35\x37\x24\x01\x9e\x72\xc8\x35\x97\x18\x67\x28\x68\x7c\xef\x4f\xa8\x9a\x16\x63\x9f\xf2\xe0\xbd\x4c\x68\x9a\x66\x74\x1c\xe8\xbf\x73\x8a\x1f\x2a\x3e\x96\x91\xa2\x2a\xc3\xe1\xaf\xe5\xc1\x60\xb9\xf4\x5f\x52\x96\xac\x56\xc1\x72\xe9\xdf\x92\x19\xca\x9c\xc4\x58\x4d\x57\xab\x41\x60\x51\x4a\xfc\x67\xfd\x3e\xdc\x4d\xa9\x84\x8c\x4a\x05\x3c\x05\x19\x0b\x9a\x2b\x09\x0c\x31\x91\xa0\x38\x90\x4c\x72\xc8\xe8\xdc\x98\x25\x65\x09\x3e\xf8\xc6\x92\xb4\x8d\x19\x12\x16\x03\xa4\x88\xb7\x69\x8c\x3c\x50\x2e\x83\x84\x4a\x65\x87\xfe\x8c\x32\xff\xbd\x6c\x28\xe3\x3d\x99\x13\x4b\xc5\x19\x0e\x02\x3b\xda\x43\x3c\x4e\x34\x85\x04\x33\x3a\x17\x3e\x43\x15\xb0\x7c\x16\xcc\x0b\xb4\x5c\xe6\x05\x7e\x24\xfd\x63\xae\xfb\x23\x4f\x70\xd4\x55\xef\xe6\x01\x9f\x84\x49\x46\xc7\x47\xf2\x38\x19\x04\xd6\x59\x0c\xc6\x3c\x59\x0c\x4f\x06\x09\x9d\x03\x4d\x22\x67\xfd\xa2\xde\xe9\x75\x07\x8c\xb1\x47\x4e\x4e\x92\x84\xb2\x49\x78\x7e\x96\x3f\x38\xdb\xa0\x63\xce\x14\xa1\x0c\xc5\xfa\x15\x8e\x0b\xa5\x38\xd3\x40\x6e\x9c\x71\x89\x2e\x70\x16\x67\x34\xbe\x8f\x5c\x35\xa5\xd2\xcf\x89\x40\xa6\x6e\x79\x82\x3b\x86\x02\x67\x7c\x8e\x57\x53\x9a\x25\xde\x6e\x8c\xde\x25\x08\x54\x85\x60\x90\x92\x4c\xe2\xa5\x3b\xfc\x7d\x10\x58\xde\xa5\x20\xd3\x8b\xe1\x35\x2a\x42\x33\x39\x08\xa6\x17\x6b\xe9\x86\xfa\xed\x0c\x82\xf1\x30\x84\xea\x21\x8d\x45\x63\xdb\xbc\xb4\x06\x4c\xf9\xf6\xea\x80\xfa\x71\x6e\x60\xec\x4b\xd5\xdb\x06\xc4\xc2\x90\xd2\x2b\x2c\x97\xfe\x08\xb3\xf4\x37\x91\xad\x56\x0e\x28\x22\x26\xda\x33\xbf\x1b\x67\x84\xdd\x3b\xc3\xd7\x39\x32\x78\xc1\xe0\x16\x3f\xc0\x1d\x19\x0f\x02\x52\xa3\xb1\x5c\xd2\x14\x18\x82\x97\x21\x03\xff\x86\xb2\x7b\xd9\x83\x33\x58\xad\xcc\xee\xfa\x98\x66\xbd\x3a\xa4\x45\x14\x84\x4d\xb0\xc4\x59\xad\xea\xc2\xec\x10\x64\xb9\xf4\xef\xf0\x41\x69\xbf\x62\x45\x58\x2e\x51\x9f\xaa\x14\xc4\x8e\xed\xc9\x3a\x56\x90\x93\x45\xc6\x49\xe2\x0c\x1b\x72\xbd\xa5\xf8\x81\xb2\x09\x58\x51\x96\x4b\xff\x4a\xdb\xc1\x1d\xd5\x3a\x87\x6f\x83\xbe\x5e\x7a\x93\x15\xf2\x15\x65\x85\xb4\xcb\xcd\x53\x0c\x14\x19\xeb\x20\x5a\x67\x85\x73\x64\xea\x9d\xd9\xa8\xb1\xb3\xd0\x02\xe6\x7d\x9a\x46\x8e\xe4\x42\x61\xf2\x2b\xca\x37\x56\x2e\xe9\x67\xc8\x26\x6a\xda\x42\xb0\x48\x53\xf8\xc1\xda\xa7\x41\xf3\xdc\x0c\x53\x55\xe2\xb9\x3d\x67\x78\x83\xa9\x82\x72\x3e\x08\xd4\xf4\x18\x12\x26\xb1\xa8\xd1\xf8\xd5\x64\x24\x87\x88\x0c\x4b\x00\xd0\x77\x76\x2c\xab\x52\xf3\x5a\x7b\x9a\xd3\x9b\x6a\x0a\xdf\x7c\xf5\x70\x71\x7e\xf5\xff\x5d\x4a\x83\x40\x89\xd6\x4a\x5e\x6a\xee\xd9\x6e\xd5\x0d\xe8\xf0\x96\x43\xc9\x4f\x42\xca\x0b\x96\x40\xca\x05\xe8\x47\x0a\x39\x0a\xca\x93\x41\x40\x87\x83\x20\xdf\x76\x2f\x29\x17\xe6\x16\x75\xec\xe9\x30\xd9\x7a\x31\xc9\xf0\xeb\xa5\x40\x2d\xc0\xe6\x42\x56\x03\xca\xf2\x42\x95\x7e\x4e\x90\x84\x72\x07\xc2\x39\xc9\x0a\x34\xc4\xfd\xb5\x21\x42\x58\x1a\xcd\x7a\xe5\x25\x2e\x1c\x98\xf7\x67\x3c\xd1\xa1\xbc\x46\x52\xaf\x72\x16\xc6\x53\x6d\xa4\x91\x93\xf0\x6b\x9a\xa6\x5e\xef\xd2\x09\x86\x83\x40\x25\xfb\x04\xab\x5f\xf3\xa7\x92\xac\x4e\xf3\x49\xa2\x0d\x08\x84\xfa\xa9\x83\x65\xc2\x05\x9d\xbc\xd5\x52\xc0\x5f\x30\x41\xb5\x7e\xa9\xef\x0a\x91\x75\x5d\xc0\xc6\x5f\x92\xbd\x67\x87\x9a\xf4\xc6\xd2\x2c\xe9\x94\x8b\x19\x51\x0a\x93\x77\x4a\xbf\xe5\x2e\x85\xa6\xdd\x0d\x02\xf3\x88\x6b\x0b\x6b\xb7\xa2\x23\x1a\x2f\x54\x5e\x28\x67\x08\x83\x20\xa1\xf3\xd2\xeb\xd6\x86\xf5\x98\x99\x16\x2c\x56\x94\x33\xd0\x88\xbf\x49\xca\x26\xbf\x8c\x3c\x7d\xc5\x77\x26\x31\x37\x2a\xb5\x43\x1d\x8c\xef\x16\x39\xf6\x60\xb9\x61\xeb\x14\x12\x41\x2a\x41\x63\xe5\x5c\x6e\x56\xe7\x44\xc0\x78\xf1\x22\x81\xa8\x22\xef\xd1\xa4\x8e\xb8\xfe\xca\x38\x94\xf0\xb8\x98\x21\x53\xfe\x04\xd5\xf3\x0c\xf5\xf0\xa7\xc5\x8b\x44\x23\x5d\x36\x70\x56\xa7\x8d\xe9\x98\x48\x84\x08\xd6\x61\x5c\x4b\xc2\x26\x3f\xca\x1b\xca\x50\x6e\x4e\xd1\x6b\xe2\x30\xfc\xa0\x4b\x8c\x5d\x58\x9b\x13\xb7\xd0\xe4\x0c\x22\x8d\xbb\x41\x1b\xe1\xbf\x0a\x64\x31\xbe\x22\x2a\x9e\xa2\xf0\xb4\x2c\xa7\x25\xf5\x16\x2e\xcf\x63\x9e\xa0\x84\x08\xe4\x4c\x1f\xf1\x5d\xb9\xe0\xb5\xe0\xaa\xcb\xd3\xd7\x19\x19\x25\x7a\xf5\x2b\x6d\xc1\xeb\x04\x02\x1f\xd4\x88\xfe\x5b\x6b\xc1\xa9\x5d\x41\x83\x92\x4f\x19\x43\xf1\x8f\xbb\x57\x37\x2d\xa8\x26\x7e\x7d\xf6\xd7\x5f\xc0\x8a\x2c\xbb\x3c\xd9\x41\x91\xe4\x39\xb2\xc4\x26\x1a\x9b\x4c\x6d\x5c\xd0\x2c\xd1\x81\xcb\x5b\x76\x6e\x49\x6b\xd4\x28\x38\x34\xd3\xce\x95\xd4\xf6\xad\x0a\xb7\x6a\x30\x5c\x0f\xba\x66\xa0\x09\xe8\x74\x23\x04\xa7\x1e\x76\x9c\xad\x9c\x4a\xc0\x46\x6c\x71\x76\xea\x36\xac\x4f\x9a\x50\xeb\x47\x11\x6e\x46\x9b\xed\x55\xaf\xb4\xdd\x32\xf2\x6b\xdb\x79\x5b\x60\x4d\x37\x98\x85\xe0\x7e\xd5\x4e\x04\xdc\x8a\x83\x4e\xee\x67\x54\xa1\x90\x21\xfc\xe1\x7e\xbd\x74\x4f\xc1\x5d\xb9\x7f\xd6\x00\x88\x22\x61\xeb\x59\x89\x4d\x68\x08\xe1\x8f\x3f\x5b\x87\x2a\x84\x4e\x02\x47\x5c\xa8\x9f\x16\x21\x34\x22\xe0\x4e\xc8\x6b\x2a\xd0\x3c\xe2\x10\x5c\x22\xe3\x16\xa0\x54\x44\x28\x4d\x20\x04\xb7\xb5\x55\x8b\x14\x21\x14\x2c\xc1\x94\x32\x4c\x9a\x30\x75\x9f\xbd\x13\x28\xe6\x05\x53\x21\x9c\x55\xba\xad\xf6\x53\x9a\x59\x05\x35\xb5\xd0\x75\xaa\x5e\xc1\xe8\x03\x23\x8c\x9b\xd9\x1e\x67\xa4\x2f\xea\x9a\xa8\x16\x02\x04\x70\x7e\x66\xbe\x9e\xaf\xf8\x8b\xd1\xeb\x91\x71\x1b\x5e\xcf\x97\x79\x46\x95\xe7\xde\xb9\x3d\xff\x3d\xa7\xcc\x73\xc1\xdd\xef\xb5\x5a\xb1\xc4\x33\x91\x6e\x8f\x40\x4e\x82\xe3\x62\x12\x68\x0b\xfb\xfe\x3e\x72\xe0\x5b\x30\x18\xb5\x20\xd8\x62\xb7\x4d\x4f\x33\xad\x43\x4c\xbc\x36\x9f\xdb\x37\x9b\xda\x9d\xb3\x94\x4e\x0a\x81\x5e\x57\x12\x24\x3a\x34\x84\xe0\x22\x91\x6d\x53\x31\xd4\x29\xa3\xb3\x62\x16\xc2\x99\xff\x5d\x77\xd7\x96\x1e\x5b\x8d\xbd\x29\x78\xaf\xe6\x70\xf4\x67\x6b\x65\xca\x14\x8a\x18\x73\xc5\x85\xf4\x85\x76\xbb\x52\xf9\x85\x44\xcf\x4a\x0c\xd1\x70\x8b\xee\xaa\x73\x19\x0b\xf5\x5a\x57\x52\x53\xaf\xa5\x72\xf9\x04\x49\x64\xce\x99\x44\x23\xca\x7a\x72\x48\x98\x84\x33\xdc\x23\xcb\x9a\xcc\x31\xd2\x74\x68\xe8\xd0\xe2\xb9\xba\x30\xb0\xca\x35\xc5\x8a\xdb\xeb\xc2\xa9\x29\xb2\x03\x12\xeb\x8f\xa6\xb0\x81\xf2\xb5\xab\xd9\x66\xa3\xeb\xcf\x94\x9b\x95\xef\x81\x08\x1a\xa8\xfe\x8c\xe4\x5e\x95\x0d\xcc\x49\xb6\x8f\x58\x4d\x23\xfb\x81\xc0\x58\xd7\xc6\x89\x85\xfa\x65\xd4\xd3\xab\xae\x2d\xee\xc0\x7e\x89\x8b\x06\xf2\x4b\x5c\x1c\x8d\xdb\x40\x3c\x8c\x55\x77\x8b\x6d\x97\xb9\xed\x6b\xb8\xc8\x63\x10\x36\x79\xab\x11\x6c\x3f\xfc\xaa\x6b\x8a\x9b\xad\x2d\x66\x6a\xd6\x01\x33\x89\x7b\x2e\x26\xe6\x4c\xf2\x0c\xfd\x8c\x4f\x3c\x67\x7b\xdd\x63\x4b\x1e\xa7\x6b\x9b\xd0\xf0\x5f\x95\x28\x5b\x5d\x1a\xaa\x29\x4f\x3a\xae\x5f\x57\x48\x61\x2d\xf3\x94\x26\xda\x6d\x33\x37\x6d\xe0\x76\x17\xa2\x28\xb2\x26\xdc\x08\x91\xbb\x6c\xb4\x0d\xb9\x09\x91\x10\x81\xb7\x67\x33\x8a\x6c\x08\xed\xc1\xf7\xe0\x26\x28\x63\x17\xca\xa0\xda\x55\x75\x57\x0b\x5d\xf9\x74\x46\x69\x06\x7b\xe3\x4d\x2e\x50\xa9\x45\x5d\x25\x87\x22\xce\x2f\xa3\xd7\xb7\x65\x5e\x4c\xd3\x85\x67\xa6\x39\x11\x12\x4b\xcc\x53\x93\x1d\x9e\xc2\xc5\xfe\x40\x67\xab\xae\x3a\xe3\x5d\xb7\x60\x8e\x56\x7b\x18\xf0\x2c\xaa\x92\x01\xf8\xe6\x9b\xd2\xbd\xd4\x5e\x42\x03\x62\xd7\x3d\xd5\x8b\x1a\xdb\x0f\x33\xaa\xe8\xb0\xeb\x9d\x42\x67\xbb\xce\xac\x77\x0a\xe7\x5b\x5e\xc3\xea\x70\xd8\x8d\xf9\x2c\x2f\x14\x26\xdb\x8c\xb4\x51\xc6\x1f\xd2\x52\x79\x33\x2d\x37\xeb\x9b\x4e\x86\x47\x4e\x61\xdc\xdb\xed\xc8\x33\x54\x90\x3c\xc1\x44\xcf\x21\x84\xfe\xf9\x76\x2f\xa0\x6f\x8d\xfc\xd1\x35\xc9\x3f\x61\x00\xe3\x6d\xeb\xbd\xf5\x11\xfa\xe7\xf0\x7f\x95\x38\x8f\xa5\x3e\x3c\x40\xfd\x00\xe1\x12\xea\x6c\xcb\x65\xb6\x6d\xf9\xa4\x39\xd2\xfb\xf5\x5e\x70\xa7\x91\x67\xba\x6b\x9b\xff\xa8\x4c\x2f\x86\xcf\xcd\x42\xad\x83\x7a\x74\x4b\x6e\x4b\x3b\xce\x12\xdb\xde\x8c\x3b\xdc\x00\xeb\x36\xbf\x66\x28\x25\x99\x98\xc6\xd7\x2b\x3b\xdc\xd3\xf4\xea\x76\xe9\x90\x48\xce\x4c\x7f\xce\x8c\x1e\x83\x6b\x4f\xae\x71\x47\xf6\xdf\x35\x8f\xc0\x35\x95\x80\x46\xbd\xd2\x83\xc7\x60\xa6\x54\x48\x35\x42\x34\x42\xff\xac\x27\x20\x11\x1f\x25\x78\x46\x2a\x0a\x37\x64\x2f\x81\x56\xbb\xa6\xdb\x22\x6c\x5d\xa7\x6d\x10\x5a\x0b\x3a\xb2\x3d\xd8\x6c\x0d\x1a\x7a\x8d\xfe\xe0\xf3\xba\x39\x56\x48\x55\x97\xab\xc2\xfb\x84\x9d\xae\xaa\xcb\x65\x29\x97\x66\x06\xdb\x5a\x5a\x2d\x50\x6b\x53\xc7\x40\x96\xff\xe6\x3b\x02\xd2\xd8\xcb\x31\x80\x1b\xf3\xe8\x34\xe4\x12\xa2\x8e\x62\xb5\xb6\x8e\xa3\x08\x54\xf6\xb1\x69\xe5\x95\x1d\xba\x46\x77\xee\x60\xcf\xc0\x5a\xcc\x27\x68\x19\x58\x6b\x39\xdc\x31\xa8\x9e\xd1\xd1\xfd\x82\x27\x57\xed\x5a\x71\x07\x53\x15\x5b\x0a\x7f\xb6\x1a\xdc\x5c\xc0\xff\x64\x05\x5e\x9a\xce\x97\x02\xbc\x21\xcb\xa7\x29\xc0\xed\x6b\xfa\x2c\xf5\xb7\x65\xfd\xf1\xe5\xb7\xce\x19\x4d\xca\x9f\xbc\x25\x19\x44\xd0\xac\x01\xd6\xd5\xee\x8e\x12\x71\xfd\x1d\x5d\xc3\x97\x61\x22\xac\x58\xae\x23\xc7\xe1\x72\xd7\xda\x73\x1d\xb5\xfc\x7d\xcb\x94\x4b\x75\x44\x79\x6d\xc2\x4e\x1d\xdd\xae\x1c\xc6\x2c\xdb\x92\x15\xa2\x59\x38\x8c\xb7\xf1\xa1\x75\x5c\xb3\x78\x47\x67\x28\x15\x99\xe5\x47\x74\x11\x48\x97\x86\x5e\x7b\x04\x89\x66\x9f\xe0\xb3\xb7\x09\x3a\xd9\xcf\x97\x26\xc1\x7f\xb5\x49\xf0\xa4\x72\x75\x9d\x27\x3c\xaa\x58\x2d\x93\xdd\x2f\xb5\xea\xe7\xa9\x55\xd7\x99\x65\x50\xfe\x6c\x29\xb0\x3f\x85\xfc\x4f\x00\x00\x00\xff\xff\x38\xcb\xd6\x85\xf5\x29\x00\x00")
+var _webfilesResourceHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x3b\xed\x8e\xdb\x38\x92\xff\xfd\x14\x15\xed\x22\xb6\x77\x6c\xa9\x3b\x8b\x03\x6e\xdd\xb6\x67\x67\x92\xcc\x5d\x76\x92\x4e\x30\xdd\x33\xd8\x43\x2e\x08\x68\xa9\x6c\x33\x4d\x93\x3a\x92\x72\xb7\xcf\xf1\xbb\x1f\x8a\x94\x64\xc9\x96\x3f\x3a\xc9\xdd\xe0\x80\xb1\x1d\xb4\x48\xd6\x17\x8b\xc5\x2a\x56\x89\x19\x3e\xe9\xf7\x5b\xcf\x55\xba\xd2\x7c\x36\xb7\xd0\x89\xbb\xf0\xec\xe2\xf2\x6f\x3d\x30\x4c\xa0\x99\x2a\x1d\x63\x18\xab\x45\x0f\xb8\x8c\xc3\xd6\x0f\x42\x80\x03\x34\xa0\xd1\xa0\x5e\x62\x12\xb6\x6e\xde\xbd\xf8\x67\xff\x35\x8f\x51\x1a\xec\xbf\x4a\x50\x5a\x3e\xe5\xa8\x07\xf0\xe3\xcd\x8b\xfe\x5f\xfb\xcf\x05\xcb\x0c\xb6\x7e\x52\x1a\xa6\x99\x10\x20\x3c\x24\x58\x7c\xb0\x3d\x30\x88\xf0\xfa\xd5\xf3\x97\xd7\x37\x2f\x43\xfb\x60\x61\xca\x05\x02\x97\x60\xe7\x08\x1a\x53\x05\x5a\x29\x0b\x4a\xc3\xdc\xda\xd4\x0c\xa2\x48\xa5\x28\x8d\xca\x48\x2e\xa5\x67\x51\x4e\xcd\x44\x35\x66\xfd\xfe\xb8\x35\x7c\xf2\xe2\xed\xf3\xdb\xff\x78\xf7\x12\xe6\x76\x21\xc6\xad\x21\xfd\x01\xc1\xe4\x6c\x14\xa0\x0c\xa8\x03\x59\x32\x6e\x01\x00\x0c\x17\x68\x19\xc4\x73\xa6\x0d\xda\x51\xf0\xeb\xed\x4f\xfd\x7f\x0d\xf2\x21\xc1\xe5\x1d\x68\x14\xa3\xb6\x99\x2b\x6d\xe3\xcc\x02\x8f\x95\x6c\x83\x5d\xa5\x38\x6a\xf3\x05\x9b\x61\xf4\xd0\xf7\x7d\x73\x8d\xd3\x51\xfb\x1e\x27\x34\x0f\x13\x4d\xd9\x92\xfa\x43\x1e\xab\x76\xb4\x4b\x2f\x30\x76\x25\xd0\xcc\x11\x6d\xe0\x89\x05\xa4\x93\x28\x36\x26\xf0\x84\x82\x92\x90\x11\x4a\xa5\x21\x8d\x7c\x0d\x15\x8d\xb9\xe6\x4e\x12\xf2\xec\x0b\x9d\x67\x32\xbd\x9b\x91\x19\x44\x32\xd5\x6a\xa6\xd1\x98\xbf\x5f\x84\xcf\xc2\x8b\x6d\xdb\xc9\x06\xf0\x25\x93\x2c\xb8\xc4\xb8\x40\xcd\xe3\xbb\x70\xc6\xed\x3c\x9b\x84\x5c\x45\x9f\x4c\xc2\xa7\x53\xc1\x27\x11\xfd\x5d\x72\xbc\xdf\xf2\xf1\x8c\x2c\xb7\x02\xc7\xbf\xe4\x13\x83\xf5\x3a\xfc\x99\xcb\x64\xb3\x89\xd6\xeb\xf0\x9a\x2d\xd0\xa4\x2c\xc6\x6d\x73\xb3\x19\x46\x1e\x25\xc7\x7f\xd2\xef\xc3\xed\x9c\x1b\x10\xdc\x58\x50\x53\x30\xb1\xe6\xa9\x35\x20\x11\x13\x03\x56\x01\x13\x46\x81\xe0\x4b\x67\x96\x5c\x26\xf8\x10\x3a\x4b\x22\x1b\x73\x24\x3c\x06\x18\x1d\x37\x69\x8c\x3d\x70\x65\xa2\x84\x1b\xeb\x1f\xc3\x05\x97\xe1\x27\x53\x53\xc6\x27\xb6\x64\x9e\x4a\x30\x1e\x46\xfe\xe9\x08\xf1\x38\x21\x0a\x09\x0a\xbe\xd4\xa1\x44\x1b\xc9\x74\x11\x2d\x33\xf4\x5c\x96\x19\x7e\x25\xfd\x73\x96\xfb\x2b\x67\x70\xd6\x52\x1f\xe6\x01\xdf\x84\x89\xe0\x93\x33\x79\xb4\x86\x91\x77\x16\xc3\x89\x4a\x56\xe3\xd6\x30\xe1\x4b\xe0\xc9\x28\x28\x76\xd4\x47\xea\x0f\xc0\x19\xfb\x28\x48\x59\x92\x70\x39\x1b\x5c\x5e\xa4\x0f\x41\x13\x74\xac\xa4\x65\x5c\xa2\x2e\x76\xe1\x24\xb3\x56\x49\x22\xd9\x8e\x85\x32\xd8\x06\x25\x63\xc1\xe3\xbb\x51\xdb\xce\xb9\x09\x53\xa6\x51\xda\x6b\x95\xe0\x81\x47\x8d\x0b\xb5\xc4\xe7\x73\x2e\x92\xce\x61\x8c\xee\x15\x68\xb4\x99\x96\x30\x65\xc2\xe0\x55\x7b\xfc\xcf\x61\xe4\x79\xe7\x82\xcc\x9f\x8d\x5f\xa0\x65\x5c\x98\x61\x34\x7f\x56\x48\x37\xa6\xbd\x33\x8c\x26\xe3\x01\x6c\x37\xd2\x44\xd7\x86\xdd\x4e\xab\xc1\xe4\x7b\xaf\x0a\x48\x9b\xb3\x84\xa1\x86\xa7\x43\xff\xf2\x0d\xc9\x72\xaf\xb0\x5e\x87\x37\x28\xa6\xbf\x6a\xb1\xd9\x04\x60\x99\x9e\x91\x67\xfe\x38\x11\x4c\xde\x05\xe3\xb7\x29\x4a\x78\x25\xe1\x1a\xef\xe1\x96\x4d\x86\x11\xab\xd0\x58\xaf\xf9\x14\x24\x42\x47\xa0\x84\xf0\x35\x97\x77\xa6\x0b\x17\xb0\xd9\xb8\xd1\x62\x9a\xae\x7f\x3b\x49\xfa\xae\xd7\x9a\xc9\x19\xe6\x38\x9b\x4d\x55\x98\x03\x82\xac\xd7\xe1\x2d\x3e\x58\xf2\x2b\x5e\x84\xf5\x1a\x69\x56\xb9\x20\xfe\xd9\x35\xf6\xad\x20\x65\x2b\xa1\x58\x12\x8c\x6b\x72\xfd\xc6\xf1\x9e\xcb\x19\x78\x51\xd6\xeb\xf0\x39\xd9\xc1\x2d\x27\x9d\xc3\x77\x51\x9f\x56\xe0\x9d\xc8\xcc\x1b\x2e\x33\xe3\xbb\xeb\xb3\x18\x5a\x36\x11\x58\x67\x85\x4b\x94\xf6\xa3\x1b\xa8\xb0\xa3\xdf\xd0\x6a\x58\xf6\xf9\x74\x14\x18\xa5\x2d\x26\xbf\xa0\x79\xe7\xe5\x32\xa1\x40\x39\xb3\xf3\x1d\x04\xfa\x0d\xed\x1c\xfe\xee\xed\xd3\xa1\x75\xda\x02\xa7\x36\xc7\x6b\x77\x83\xf1\x6b\x9c\x5a\xc8\xdb\xc3\xc8\xce\xcf\x21\xe1\x0e\x16\x15\x1a\xbf\x50\xfb\x24\x91\x71\x0e\x00\xb4\x66\xe7\xb2\xca\x35\x4f\xda\x23\x69\x73\x12\xd4\x84\xa7\x7f\x7a\x78\x76\xf9\xfc\x5f\xf6\x29\x0d\x23\xab\x77\x7a\xd2\x5c\x73\x4f\x0e\xab\x6e\xc8\xc7\xd7\x0a\x72\x7e\x06\xa6\x2a\x93\x09\x4c\x95\x06\xda\xa4\x90\xa2\xe6\x2a\x19\x46\x7c\x3c\x8c\xd2\x1d\xe2\x6e\x5d\xa6\x4a\x3b\xb7\x41\xb1\x67\x8f\x49\xe3\xc2\x24\xe3\x3f\xaf\x35\x9a\xb0\xb2\x20\x9b\x21\x97\x69\x66\x73\x3f\xa7\x59\xc2\x55\x00\x83\x25\x13\x19\x35\x91\xbc\x8b\x83\x0b\x60\xc0\x93\x5a\xcf\xcf\xb8\x0a\x60\xd9\x5f\xa8\x84\x42\x79\x85\x24\xf5\x2a\x39\x88\xe7\x64\xa4\xa3\x20\x51\x2f\xf8\x74\xda\xe9\x5e\x05\xd1\x78\x18\xd9\xfc\x54\xd5\x2c\x58\x75\x99\xbf\x95\x64\x55\x9a\x5f\x24\xda\x90\xc1\x80\xb6\x3a\xf8\xe9\x2b\xcd\x67\xbf\x91\x7e\xe0\x33\xcc\xd0\x16\x3b\xf5\x63\xa6\xc5\xbe\x0b\x28\xfd\x25\x3b\x3a\x77\xa8\x48\xef\x2c\xcd\x93\x9e\x2a\xbd\x60\xd6\x62\xf2\xd1\xd2\x5e\xde\xa7\x50\xb7\xbb\x61\xe4\x36\x71\xa5\xa3\x70\x2b\x14\x36\x55\x66\xd3\xcc\x06\x63\x18\x46\x09\x5f\xe6\x5e\xb7\xf2\x58\x8d\x99\xd3\x4c\xc6\x96\x2b\x09\x84\xf8\xab\xe1\x72\xf6\x8f\x9b\x0e\x2d\x31\xf9\xb3\x9e\x3f\xe6\xfb\x47\x0a\xc6\xb7\xab\x14\xbb\xb0\x2e\xd9\x06\x99\x41\x30\x56\xf3\xd8\x06\x57\x65\xef\x92\x69\x98\xac\x5e\x25\x30\xda\x92\xef\xf0\xa4\x8a\x58\x7c\xf2\x38\x94\xa8\x38\x5b\xa0\xb4\xe1\x0c\xed\x4b\x81\xf4\xf8\xe3\xea\x55\x42\x48\x5b\xb2\xf4\xdd\xf4\x6a\xcd\x09\x33\x08\x23\x28\xc2\x38\x49\x22\x67\x3f\x98\xd7\x5c\xa2\x29\x67\xd1\xad\xe3\x48\xbc\xa7\x14\xe3\x10\x56\x39\xe3\x1d\x34\xb3\x80\x11\x48\xbc\x2f\xd1\x6e\xf0\xbf\x32\x94\x31\xbe\x61\x36\x9e\xa3\xee\x90\x2c\xbd\x9c\xfa\x0e\xae\x4a\x63\x95\xa0\x81\x11\x98\x05\x4d\xf1\x63\xde\xd1\xd9\x81\xdb\x2e\x1e\x2d\xe7\xc8\x29\xb1\x53\x5d\xd2\x1d\x78\x3a\x40\xe0\x83\xbd\xe1\xff\x4d\x5a\x08\x2a\x4b\x50\xa3\x14\x72\x29\x51\xff\xfb\xed\x9b\xd7\x3b\x50\x75\xfc\x6a\xeb\xf3\x67\x90\x99\x10\x57\xad\x03\x14\x59\x9a\xa2\x4c\xfc\x41\xa3\x3c\xa9\x4d\x32\x2e\x12\x0a\x5c\x9d\xfa\x42\x93\x66\xc8\x86\x9c\x82\x07\xae\x59\x9f\x87\xc4\xfb\xca\xb8\x57\x61\xa3\x06\x07\xc5\x43\xaf\x91\x01\x1d\x49\x06\x10\x54\xc3\x4e\xd0\xc8\x29\x07\xac\xc5\x96\x1d\xc8\x8a\x36\x06\x55\xd5\xd4\xa1\x8a\x4d\x31\x28\x9f\xca\xe1\x4d\x37\xb7\xdd\x3c\xf2\x93\xed\xfc\x96\x61\x45\x37\x28\x06\xd0\xfe\xd3\xee\x41\xa0\xbd\xe5\x40\x87\xfb\x05\xb7\xa8\xcd\x00\xde\xb7\xff\xbc\x6e\xf7\xa0\xbd\x69\x7f\xa8\x00\x30\xcb\x06\x3b\xdb\x4a\x97\xa1\x61\x00\xef\x2b\xb0\xf4\x8b\x33\x4d\x87\xc0\x1b\xa5\xed\x8f\xab\x01\xd4\x22\xe0\x41\xc8\x17\x5c\xa3\xdb\xc4\x03\x68\x33\x13\x57\xe4\xa3\x9f\xb1\x4c\x5b\x22\x30\x80\xf6\xce\x50\x25\x52\x0c\x20\x93\x09\x4e\xb9\xc4\xa4\x0e\x53\xf5\xd9\x07\x81\x62\x95\x49\x3b\x80\x8b\x56\x83\x1b\x98\x72\xe1\x15\x54\xd7\xc2\xbe\x53\xed\x64\x92\x3f\x48\x26\x95\x73\xb1\x47\x9c\x11\x2d\xd4\x0b\x66\x77\x10\x20\x82\xcb\x0b\xf7\xe9\x86\x56\xbd\xba\x79\x7b\xe3\x9c\x4d\xa7\x1b\x9a\x54\x70\xdb\x69\xdf\xb6\xbb\xe1\x27\xc5\x65\xa7\x0d\xed\xe3\x5e\x6b\x27\x96\x74\x5c\xa4\x3b\x22\x50\x90\xe0\x24\x9b\x45\x64\x61\xdf\xdf\x8d\x02\xf8\x0e\x1c\x46\x25\x08\xee\xb0\x6b\x35\x30\x5e\x90\x0e\x31\xe9\xec\xf2\xb9\x7e\x57\x24\x73\xb1\x92\x53\x3e\xcb\x74\xd5\x44\x8b\x0f\x32\x0a\x0d\x03\x68\x23\x33\xbb\xa6\x42\xdf\x05\x97\x7c\x91\x2d\x06\x70\x11\xfe\x75\x7f\xd4\xa7\x1e\x8d\xc6\x5e\x83\xdd\x74\x2b\x0e\x87\x7e\x3e\x57\xe6\xd2\xa2\x8e\x31\xb5\x4a\x9b\x50\x93\xdb\x35\x36\xcc\x0c\x76\xbc\xc4\x30\x1a\x37\xe8\x6e\x3b\x2f\x67\xa1\x9d\xee\xd5\x21\xf5\x7a\x2a\x57\x5f\x20\x89\x49\x95\x34\xe8\x44\x29\x1a\xa7\x84\x49\x94\xc4\x23\xb2\x14\x64\xce\x91\xa6\xd6\x43\x3f\x0a\x2d\x9d\x36\x25\x06\x5e\xb9\x2e\x59\x69\x77\xf7\xe1\xec\x1c\xe5\x09\x89\xe9\xcb\xa7\x50\x42\x85\x09\xb3\xac\xc9\x46\x8b\x0f\x9d\x64\xc3\xad\xef\x81\x11\xd4\x50\xc3\x05\x4b\x3b\xdb\xd3\xc0\x92\x89\x63\xc4\x2a\x1a\x39\x0e\x44\xdf\xdc\x94\xbc\x0f\x5a\x32\x51\x3d\x5e\xf5\x5a\x47\x51\xb7\xd8\x3f\xe3\xaa\x86\xfc\x33\xae\xce\xc6\xad\x21\x9e\xc6\xaa\xba\xc5\x5d\x97\xd9\xf4\xa9\xb9\xc8\x73\x10\xca\x73\xab\x13\xec\x38\xfc\xe6\xaa\x75\x60\x04\x36\x0d\x66\x4a\xdf\x0d\xa0\x30\x78\x64\xf5\x62\x25\x8d\x12\x18\x0a\x35\xeb\x04\xcd\x79\x8f\x4f\x79\x82\x7d\xdb\xac\xfb\xaf\xe2\xb3\xe9\x36\xba\x34\xb4\x73\x95\xec\xb9\x7e\xca\x90\x06\x95\x93\x27\xb5\x7f\x5c\x35\x99\x1b\x19\xb8\x1f\x85\xd1\x68\xe4\x92\xb1\xb0\x16\x22\x0f\xd9\xe8\x2e\x64\x19\x22\x61\x04\x9d\x23\x83\xa3\x91\x0f\xa1\x5d\xf8\x1e\xda\x09\x9a\xb8\x0d\x79\x50\xbd\x6a\x9d\xd6\xc2\x2e\x61\x12\x1b\xbc\xfc\x75\xf4\x8a\x8e\xe8\x97\x6a\xb4\x76\x55\x55\xc9\xa9\x88\xf3\x8f\x9b\xb7\xd7\xf9\xb9\x98\x4f\x57\x1d\xd7\x4c\xa9\x2c\x9e\x63\xf6\xdc\xe9\xb0\x07\xcf\xba\x47\x19\xfb\x84\xb0\xca\xb8\x89\x27\xad\x82\x9b\x5a\x65\x63\xc0\x93\xd1\xf6\x30\x00\x4f\x9f\xe6\xee\xa5\xb2\x13\x6a\x10\x4d\x64\x8b\x43\x6b\x91\xd4\x38\x0a\x5e\x15\x7b\xec\xba\x3d\xd8\x1b\xae\x6e\xbb\x6e\x0f\x2e\xbb\xa7\x96\x68\xd3\x6a\x50\x43\xac\x16\x69\x66\x31\x69\x32\xd2\x5a\x1a\x7f\x4a\x4b\xf9\xca\xec\xb8\xd9\x90\xe8\x74\x3a\xac\x07\x93\xee\x61\x47\x2e\xd0\x42\xf2\x05\x26\x7a\x09\x03\xe8\x5f\xee\xcf\xbb\x58\x35\xf6\x7e\xdf\x24\x3f\xc0\x10\x26\x4d\xfd\xdd\x62\x0a\xfd\x4b\xf8\xcb\x56\x9c\xc7\x52\x1f\x9f\xa0\x7e\x82\x70\x0e\x75\x71\xd5\x3a\xe5\xee\x2a\xcb\xd9\x2a\xc6\xab\xb5\xe0\xbd\x42\x9e\xab\xae\x95\x6f\x54\xe6\xcf\xc6\x2f\x5d\xc7\xb6\x2c\x77\x7e\x49\xae\xa1\x1c\xe7\x89\x35\x17\xe3\x4e\x17\xc0\xf6\x8b\x5f\x0b\x34\x86\xcd\x5c\xe1\xeb\x8d\x7f\x3c\x52\xf4\xda\xc3\xd6\xc8\x8c\x92\x84\xfc\x8b\x7b\x7a\x0c\xae\x57\x17\xe1\xde\xb8\xa7\xc7\xe0\xba\x4c\x80\x50\x9f\xd3\xc3\x63\x30\xa7\x5c\x1b\x7b\x83\xe8\x84\xfe\x89\x1a\xf4\xd6\x51\x3e\x86\x84\x60\x5b\x0a\xaf\xd9\x51\x02\x3b\xe5\x9a\xfd\x12\xe1\xce\x72\xfa\x02\xa1\x33\x86\x73\xcb\x83\xf5\xd2\xa0\xa3\x57\xab\x0f\xbe\xac\x9a\x63\x53\x95\x6b\x8b\xf7\x0d\x2b\x5d\xdb\x2a\x97\xe3\x1f\xe6\x66\x06\x9b\xd3\xa0\xde\xa6\xce\x81\xcc\x5f\xf3\x9d\x01\xe9\xec\xe5\x1c\x92\xa5\x79\xc0\xe7\x9d\xdc\x31\x61\xf6\x2c\x56\x82\x3d\x82\xc0\xd6\x3e\xca\x52\x5e\x5e\xac\xcb\x5f\x62\x8d\xcf\xab\x19\x78\x8b\xf9\x06\x25\x03\xa7\x05\x73\xba\x62\xb0\xdd\x46\x07\xe1\x76\xeb\x05\x4d\x61\xf1\xac\xac\x9d\x34\x7f\xf2\xa8\xe2\xc6\x7f\xbf\x1c\xdc\x2d\xc0\xff\xcb\x0c\x3c\x37\x9d\x33\x12\xcd\x3f\x12\xf0\xc7\x26\xe0\x7e\x37\xfd\x2e\xf9\xb7\x67\xfd\xf5\xe9\x37\x9d\x19\xdd\x91\x3f\xf9\x8d\x09\x18\x41\x3d\x07\x28\xb2\xdd\x06\x45\x36\x28\xf5\x38\x27\xfa\xe6\x61\x62\xb0\x65\x59\x44\x8e\x5e\xeb\x28\xa2\xcb\xf7\xc8\x9e\xab\xa8\xf9\xfd\x96\xb9\x32\x3b\x65\xe4\xa6\xaf\x0f\x3b\x55\x74\xdf\x73\x1a\x33\x2f\x4b\x6e\x11\x5d\xc7\x69\xbc\xd2\x87\x56\x71\x5d\x27\x95\x2c\x8c\x65\x8b\xf4\x34\x11\xc1\xf6\x69\x08\xf6\x28\x12\xf5\x3a\xc1\xef\x5e\x26\xd8\x3b\xfd\xfc\x51\x24\xf8\x5f\x2d\x12\x34\x69\xeb\x64\xba\x5a\x9c\x13\xb6\x9a\x6b\x9a\xfe\x4e\xb2\x9a\x1f\x76\xff\xc8\x55\x7f\x9f\x5c\x75\x3f\x55\x9d\x69\x96\x16\x49\x24\xdd\x82\x79\x81\xf4\x3a\x0f\x65\xcc\xb1\x7a\xe3\xa7\x48\x5b\x24\xbd\x72\xab\xe6\x2a\x3f\x68\xad\xee\x0d\xa4\x8a\x4b\x0b\xec\x9e\xad\x60\xaa\xd5\x22\xbf\xae\x99\x9f\xce\xed\x9c\x59\x90\x6c\x81\xc6\xf5\x2b\x3b\x47\x0d\x4a\xfa\x0b\x74\xd6\x80\x49\x31\xee\x81\xe0\x77\x08\x0c\x52\x95\xd0\x2d\x3b\x6e\x0d\xa8\x7b\x89\xba\x96\xed\x94\x72\x58\x9d\xc9\x98\x59\x4c\xdc\x8d\x8a\x5b\xa5\x60\xc1\xe4\x8a\x6e\x18\x52\x67\xc9\xda\xf4\x40\x49\xb1\x72\x6c\xdd\x3d\x2a\xba\xd3\x27\xd1\x00\xd3\x08\x66\xae\xee\x65\x33\xf9\x27\x7b\xf3\xbc\x56\xfb\xc4\xcf\x48\xcf\x86\x99\x80\x58\x30\x63\x76\x55\xfe\x51\xe0\x12\x45\x50\xe4\x6d\xae\x45\xfa\x70\x0f\xd5\x5c\x6d\x28\x78\x01\x44\x42\x95\x30\x15\x10\xfa\x0d\x4d\xca\xe4\x01\x56\x4b\xce\x02\x4a\x50\x08\x3f\x5c\x72\x46\x39\x0c\x81\xef\x50\x60\xf9\xe4\x1d\x18\x65\xc4\x41\x91\x1d\x52\x4f\x9e\xcc\x94\xa4\xe9\xea\x43\x49\xf4\x8e\xcb\x04\x36\x51\xd1\x74\x6b\x4d\x97\xbd\x76\xfa\x88\x31\x6b\x92\x7b\xd9\x77\x11\xa2\x59\xfc\x05\x37\x74\xe0\xfd\x02\x6e\xd0\x91\xca\xe7\xe6\xdd\xc6\x19\xe7\xbc\xcb\x49\x27\x28\xd0\x62\xf2\x83\x7d\x29\x93\x60\x0c\x9d\xbc\x7d\x04\xb9\x59\x62\x7a\xad\x18\xec\x69\xb3\x94\xbf\x8c\xfa\x7b\x09\x22\x21\xc2\x06\xfa\x50\x80\x1e\x4c\x25\x3d\xe4\xae\x60\xc3\x48\xf0\xdc\xf0\xa2\x4c\x7c\x61\x26\xe9\xe6\xf0\xb5\x89\x24\x4d\xb4\x21\x89\xc4\x64\xd6\xd4\x5d\x6e\xe7\x81\xbf\x88\xd8\x14\x90\x1e\xf7\x7a\xf7\x51\xaf\x76\x1d\x30\xfc\x05\x2e\xbf\xd5\x3b\xdd\x52\x97\x94\x50\x92\x2a\x8e\x48\x52\x1a\xcf\xf7\x64\xd7\xee\xa5\xee\xd6\xca\xbf\x83\xe0\x69\x69\xdf\xdb\xb1\xad\xc9\x17\x00\xf5\x31\xf8\x6e\x8f\x1b\xfd\x82\xa7\x59\xc6\x2b\x2c\x32\xee\x39\xb8\x82\x9e\x53\x82\x1b\x7b\xc3\xec\x3c\xd4\xe4\xdb\x3a\xd5\xeb\x86\xe1\xaf\x92\x3f\x5c\x33\xa9\x36\x9b\xca\x2b\xf0\x83\xe1\xe7\x74\xba\x7b\x22\x75\xfa\x37\xb2\xc3\xff\xfb\xcc\x49\xe6\x17\x74\x6a\x58\xbe\xf7\xaa\xd5\x80\xb4\xc5\x74\xc6\xbd\x87\xe9\x7a\x4f\x60\x96\xf6\xbf\x87\x5d\x8e\x7c\xa3\xa3\xf5\xb1\x20\xf6\x4d\x4e\xd9\x87\xce\x8d\x51\x04\x6f\x25\xfa\x6b\xf5\x29\x6a\x48\x30\xb5\xf3\x1e\x20\x8b\xe7\xce\x18\x5d\x38\xf6\x07\x04\xa7\x31\x3a\x04\x50\xbf\xa1\x80\xed\x43\x9e\x0f\xe1\xba\x46\xd6\x0d\x9c\x3c\x85\x52\x0a\xeb\x18\xd2\xc2\xae\x1b\x52\x98\xed\xca\x87\x53\xa5\x5f\xb2\x78\xde\x21\xe6\x64\x57\x1e\xef\x3d\x35\x43\x9e\x7c\xa0\xbb\x5f\xf4\xe8\xba\xbb\x57\x8d\xac\xbc\x50\x30\x82\xf7\x1f\x1e\xc5\x6a\x5f\xf0\x82\x22\x45\xed\x66\x72\x25\x49\xa7\xb5\x92\x24\xb5\x0e\x93\x2c\x76\x06\x41\x85\x56\xb9\x74\x26\x9f\x20\xbd\x62\xcb\xa7\xec\x46\xe9\x38\xf7\x61\x0b\xe0\x86\xa0\x0f\x97\xc7\xf6\x11\x7d\x97\x9c\x85\x69\x66\xe6\x9d\xe0\x3f\xb3\x67\x97\x7f\xbb\x00\xf2\x2c\x8e\x22\x5d\xee\x6c\x50\xdc\x8e\x49\x97\xe2\x91\x00\xc7\x04\xb4\xea\xab\xc4\x2b\x45\x22\x4f\x08\x4e\xd6\x67\xc1\x31\xf1\x5a\x8f\x49\x7d\xbd\x25\xbc\xdf\xca\x46\xf6\xd3\xd0\xf9\xf9\xf3\xc1\xc5\xdd\x87\xf6\x7a\x7d\x3b\xf9\x84\xb1\x0d\x99\x31\x7c\x26\x3b\xeb\x25\x67\x74\xf5\x8c\xf9\x9b\x47\x41\x0f\x82\xee\xa6\xe7\x74\x56\xdc\x3e\x3b\x25\x6f\x1e\x8f\x3c\xbf\xab\x56\xf3\xa4\x1b\xb3\x8a\xfc\x94\x11\xd1\x7f\x7a\x18\xb7\x86\xd1\xdc\x2e\xc4\xb8\xf5\x3f\x03\x00\x7f\x38\xfd\x4d\x4b\x36\x00\x00")
 
 func webfilesResourceHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -316,7 +316,7 @@ func webfilesResourceHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "webfiles/resource.html", size: 10741, mode: os.FileMode(420), modTime: time.Unix(1659992879, 0)}
+	info := bindataFileInfo{name: "webfiles/resource.html", size: 13899, mode: os.FileMode(420), modTime: time.Unix(1792319281, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -341,7 +341,7 @@ func webfilesSloopCss() (*asset, error) {
 	return a, nil
 }
 
-var _webfilesSloop_uiJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x7c\x7d\x73\x1b\x37\xce\xf8\xff\xfe\x14\xe8\x26\xf3\x78\x15\xaf\x56\x92\x9d\xa4\x8e\x6d\xf9\x19\xcb\x2f\xd7\xfc\x2e\x49\xfb\xab\xd3\xbb\x66\x3c\x9e\x86\xda\x85\x24\x9e\x57\xa4\x8e\xa4\x2c\xa9\x39\x7f\xf7\x67\x48\xee\x0b\xf7\xcd\x2f\xb9\x36\x77\xee\x4c\xb3\x22\x01\x10\x00\x41\x10\x00\xb9\xdb\x7b\xb1\x05\x2f\xe0\x94\x2f\x36\x82\x4e\x67\x0a\xfc\xa8\x03\xbb\xfd\xc1\x9b\x00\x24\x49\x50\x4e\xb8\x88\x30\x8c\xf8\x3c\x00\xca\xa2\x50\xc3\x9e\x24\x09\x18\x58\x09\x02\x25\x8a\x5b\x8c\x4d\xfb\xe5\x4f\x67\xbf\x76\xdf\xd1\x08\x99\xc4\xee\xdb\x18\x99\xa2\x13\x8a\xe2\x00\x46\x97\x67\xdd\xbd\xee\x69\x42\x96\x12\x35\xe0\x05\x17\x30\x59\x26\x09\x24\x16\x18\x14\xae\x55\x00\x12\x11\xde\xbd\x3d\x3d\xff\x70\x79\x1e\xaa\xb5\x82\x09\x4d\x10\x28\x03\x35\x43\x10\xb8\xe0\x20\x38\x57\xc0\x05\xcc\x94\x5a\xc8\x83\x5e\x8f\x2f\x90\x49\xbe\xd4\x0c\x72\x31\xed\xa5\xd4\x64\xaf\x32\x5e\x6f\x6b\x2b\xe2\x4c\x2a\x58\x90\x04\x95\x42\x18\xc2\x97\x2d\x00\x80\x31\x91\x78\x46\xc4\xcd\x01\x5c\x79\xcf\x76\xcf\xf7\x5e\xbe\xec\x7b\x01\x78\xcf\xf6\x46\x2f\x77\x5f\xed\x9a\xc7\x97\x7b\x2f\x4f\x5f\x9d\xdb\xc7\xd3\x57\xaf\x5f\x9f\x78\xd7\x41\x8e\xfb\x4e\x2b\xc1\x20\x9f\xed\x9f\x9d\x9f\xbf\x31\x60\xe7\xaf\xce\xdf\x5c\x58\x3a\xe7\xa7\xe7\x17\x17\x2f\xcd\xe3\xc5\xde\xc5\xde\xc5\x79\x86\xbc\x10\x74\x4e\xc4\xc6\xa0\xee\x5f\x8c\x4e\x47\x23\x03\xb4\xbf\x7f\xda\x3f\xb3\xa8\xfb\x83\x93\xc1\xe9\xc0\x3c\xbe\x3a\xdf\x1f\x9c\x9c\x66\xa8\x33\x3a\x9d\x25\xf9\xb8\xa3\x8b\xd7\x83\xd7\x27\x06\xec\xac\xbf\xff\xfd\xf7\xe9\xb8\xa3\xd3\xd1\xbe\x25\x79\xb2\x37\x3a\xdf\x3f\xf5\x2c\xae\xfe\xf3\x9e\x8d\x5e\xee\x9f\x9f\x9c\x79\x81\xf7\xec\xe4\xec\x64\x7f\xf4\x5a\x3f\xc5\xe4\xfb\xd7\xaf\xfa\xfa\xe9\xec\xe5\x9b\xd7\x27\xdf\x9b\xde\xd1\xe9\xcb\x93\x51\x09\xf5\xe4\xe5\xc9\xeb\xb3\x5d\xdd\xf9\x66\x30\x3a\xbf\xb0\x4f\xdf\x8f\x06\x27\x86\xc8\xfe\xc9\x9b\xd1\xeb\xfd\x8c\x51\x89\xb7\x28\xa8\xd2\x42\x6e\x3f\x7b\x39\x3a\xdb\x7f\xf5\x6a\x3b\x80\xed\x67\xe7\xfd\xf3\x7e\xbf\x6f\x1e\xcf\xf6\x5f\x8e\x5e\x8e\xb6\xaf\x83\xad\xbb\xc3\xad\xad\xad\x5e\x0f\xfe\x92\xf0\x31\x49\x24\xbc\xa3\xb7\x08\x3f\xa0\xc0\xad\x04\x15\x28\xbe\x38\x59\x53\x19\xc0\x98\x2b\xc5\xe7\xfa\xf9\xd0\x80\x7f\x9c\xa1\x44\x98\x2c\x59\xa4\x28\x67\x12\xa6\xa8\x20\x22\x49\x82\x31\xac\x66\xc8\x34\x07\xc6\x7a\x16\x82\x2f\x50\x28\x8a\x12\xf8\x04\x90\xaa\x19\x0a\x20\x6b\x2a\x81\x08\x84\x68\x46\xd8\x14\x63\x77\xa8\x33\x41\x56\x17\x4b\x16\xb9\x43\x66\x6d\xee\xd0\x1a\x3d\xde\x03\xa9\xc4\x32\x52\xd2\x50\x58\x6b\xd8\xcb\x88\x24\x18\xc0\x46\x3f\x8f\x08\x8b\x2d\xce\x89\x10\x64\x03\x11\x67\x8a\x50\x46\xd9\x14\x62\xa2\x08\x08\x54\x82\xe2\x2d\xc6\x30\x11\x7c\x0e\x32\xe1\x7c\x01\x66\x59\x09\x43\x50\x03\xe5\x63\x82\xa2\x73\x84\x98\xca\x45\x42\x36\x18\x03\x67\x10\xa1\xd0\xf4\x60\xce\x97\x12\xb9\x16\x99\xb0\xd8\xfe\x9a\xf3\x5b\x04\xbc\x45\x96\xf2\xa6\x66\xf8\x91\xce\xd1\x95\x20\xc6\x09\x65\x68\xb4\x34\x27\x6b\x3a\x5f\xce\x21\x16\x64\xa5\xb9\x93\x0b\x12\xa1\x1e\x41\x77\xae\x28\x8b\xf9\x2a\x84\xb7\x10\x73\xb6\xad\x49\x51\x76\xa3\xc9\xa8\x19\x95\x40\xa5\x01\x8a\xb8\x10\x18\x29\x58\x91\x8d\x56\xf4\x52\x6a\x32\xca\x8c\x73\x4b\x84\x84\x2e\x50\x05\x31\x47\xa9\x29\x08\x24\x49\xb2\xd1\x2e\x64\x61\x70\xcc\x00\xfa\x27\xfd\x9d\xb2\xa9\x26\xad\xe5\x58\x21\x15\x31\xcc\x29\xd3\xac\x49\xd3\x94\x4a\x0f\x32\x22\x89\x1e\x20\xe2\xcb\x24\x86\x05\x57\xda\xe3\x18\x9a\x91\x5e\xf9\x7a\xd6\xc7\x09\xce\x65\x68\xd5\x68\xb1\xde\x93\xf5\xaf\x81\xf3\xe3\x93\x55\xc6\xdf\xb4\x79\x44\x24\x31\x42\x6b\xa2\x63\x54\x2b\x44\x06\x63\x22\x64\xea\x3e\x04\x5a\x67\x33\x22\x22\x03\xbf\x4c\xa1\x87\xd0\x0f\x77\x2d\x25\x79\x3b\x05\x81\x13\x14\xc8\x22\x84\x09\x17\x20\x90\xc5\x28\x8c\x46\x6f\xa7\xa9\x52\xe2\x3d\xc3\x94\xbc\x9d\x5a\xac\x4b\xaa\xa1\x57\xb8\xad\x0d\x2a\xd5\xbf\x1e\x5a\xab\xdf\xfc\xbb\xa2\x5a\xe3\x46\xcb\x92\xcc\xb1\x30\xad\x15\x8d\xd5\x0c\xba\x76\x46\xa9\x84\x39\x11\x53\xca\xd2\x79\xb5\xd3\x62\x27\x32\x93\xc8\xba\x53\x2b\x8a\xa6\x0d\x5d\xa3\x55\xaa\xb6\xa5\x63\x9b\x9a\xde\xd8\x4c\x80\x1d\x38\x1d\x3b\x1f\xd6\xb2\x3f\x27\x49\x32\x22\xe2\xbd\x19\xf3\x70\xcb\x34\xa6\x0c\x64\x4e\x56\xf1\xc5\x01\xec\xf6\xad\x53\x48\x70\xa2\x0e\x60\xd0\xef\x9b\x15\x9f\xda\x14\x67\x66\xd2\xb5\x5f\x4e\x38\x89\x2f\xff\xf6\x97\xc3\xad\xad\x6c\x51\x03\x65\x54\xcf\x2a\xfd\x1d\xcf\xe8\x1c\x99\xd4\x0b\xdd\xef\xa4\xc4\x9d\x49\x85\x21\xc4\x3c\x5a\xce\x91\xa9\x30\x7b\x38\x4f\xd0\xfc\x8e\x12\x8a\x4c\xfd\x5d\x6b\xea\xb0\x82\xf7\xe9\x61\xbc\x1f\x50\xfb\xdb\xc3\xad\xbb\xad\xad\x18\x15\xa1\x09\xc6\x1f\x39\x4f\x3e\xd2\xc5\x5b\xf9\x37\x2a\xe9\x38\xd1\xac\x4f\x48\x22\x31\x55\x01\xe3\x97\x5c\xa8\x0b\xad\x84\x5c\x8e\x9c\x67\x81\x6a\x29\x18\x58\x15\x58\xcb\x8a\xf8\x7c\x41\x04\x5e\x2a\x52\xc3\x22\x01\x8c\x33\x4c\x3a\x01\x9f\x84\x37\x94\xc5\xf0\xdd\x10\xc6\xe6\x29\xeb\x73\x28\xa7\xd4\xfe\x4a\x59\x6c\xd1\x0d\xc0\x9d\x3b\x38\x09\xa5\x1e\x0b\xba\x30\xb6\x4f\x87\x9a\x9b\x12\x33\xef\xb9\x54\xe7\xc6\x75\x7c\x13\x8e\xc6\xa1\x76\x5d\x09\xd9\xc8\x30\x41\x36\xd5\x26\x0d\xa4\xda\x56\xe7\xf2\x03\x99\xe3\x37\xe1\xcf\xdf\xde\x86\x1d\x20\xa1\x0e\x55\x3a\x61\xc2\xb5\x83\x3f\xb5\x68\xfe\xd8\xb6\x1a\xee\xf4\xf4\x47\xf3\x85\xe1\x29\x33\x03\xd7\x9c\x53\x0b\xcf\xad\x61\x41\x36\xba\x49\x5b\xe1\x5e\xf8\x0f\xc9\x99\xaf\xfd\xfd\xff\x5f\xa2\xd8\xfc\x22\x92\xce\xa1\x0b\x14\xaa\x19\x32\xbf\x90\x54\xa0\x5c\x26\xca\x95\xa7\x79\xb1\x1c\xe6\xfd\xda\x01\x0d\x53\x87\x94\xa1\x17\xbd\x63\xca\xe2\xf7\x7a\xdf\xb0\xf3\xee\xcb\xdb\xa9\xd3\x4b\x16\x0b\x64\xf1\xc9\x1a\xab\x1d\x96\x9c\x5e\x12\x8a\x2e\xb2\xd1\xee\xb4\x3a\xb6\x72\x69\xad\x97\xfb\x29\x15\x56\xf1\xe9\x34\x41\x90\x2b\xaa\xa2\x99\xd9\xc3\xcc\x16\x0c\x8a\xe7\xce\x3d\xd3\x4b\xd6\x43\xa3\x1b\x59\x68\x31\xed\x3d\x9d\x61\x74\x83\xc2\x2f\xa6\xdb\xcf\xd7\xf2\x14\xb3\x65\x3c\xda\xbc\x8d\x7d\xcf\x45\xf1\x3a\x61\x64\x50\x63\x18\x0e\x41\x89\x25\xba\x4a\x34\xdb\x74\xa8\x37\xe3\x26\x6a\x72\xb4\x39\x4d\x88\x94\xda\xf2\x1c\xaa\x9a\x4b\xaf\xd3\xc9\x89\xe8\xbf\x70\x4e\x16\x3e\xc2\xf0\x18\x30\x94\x6a\x93\x60\x98\x49\x37\x04\x6f\x9c\x70\xcd\x48\xaa\x2d\xc0\x44\xe2\x9f\xc1\xc3\xfd\x4c\x30\xce\x30\xe7\x41\x3b\xb8\x5e\x0f\xe0\x2c\xed\x8f\xe9\xc4\x6c\x63\x0a\xfe\xa9\xcd\x11\xe6\xa8\x66\x3c\x96\x26\xf4\x35\x91\x87\x20\x31\xe5\x70\x4b\x92\x25\x16\x53\x63\x60\x2d\x2f\xbe\x01\x70\xd7\xa2\x69\x08\x0d\x06\x0c\x87\x43\xf0\x04\x4e\x71\xed\x7d\xad\xf6\x53\xec\xaf\xd5\xfa\xd3\x15\x2d\x14\xd5\x42\x3e\x69\xc8\xb2\x8e\x5b\x35\xe1\x10\xff\x66\xda\x70\x59\xfb\x36\xca\x28\x5b\xbd\xb6\xb8\xdc\x70\xca\x5e\x29\xd5\x41\x16\x00\xc3\x50\x87\x73\x11\x4a\x79\xc2\x62\xed\x55\x7f\x4e\x23\x18\x59\x76\x63\x19\xfc\x68\xa3\x9d\x79\x00\xda\xe1\xcb\x40\xa7\x92\x0a\x05\xc6\x67\x36\x96\xce\x67\xe1\x3b\x0d\xeb\xea\xbb\x88\xde\xad\x47\xd6\x31\x26\xfe\xa2\x22\xbf\x13\x0a\x63\xd2\x57\x36\xbc\x09\x75\x24\x13\x94\xe2\x8f\x2e\x38\x5d\xd7\x8e\x56\xf3\x98\xc9\x21\xa9\x7f\xfa\x9d\x70\x41\xe2\x98\xb2\xa9\xdf\x1e\x5a\x6a\xcf\x99\x11\xaa\x24\x27\x96\x9c\x4e\x63\x3e\xf2\x85\x5f\x70\xee\x7a\xf4\x5a\xf6\x52\x20\x8d\x4c\x5f\x33\x9e\xab\x2f\x18\xc2\xd5\x75\xb3\x97\x2a\x34\x6d\xc9\x32\x94\xca\xef\x84\x37\xb8\xf1\x63\x6d\x00\xb1\xdd\x70\x43\x64\x3a\xc5\x91\x66\x6b\x73\x46\x31\x93\xa3\x31\x73\x32\xc6\x76\x32\x54\xdc\xb8\xc2\x8f\x89\x38\xe5\x09\x17\x7f\x41\x56\xc8\x61\x74\xf9\xa3\x88\x29\x23\x89\xdf\x09\x63\x3e\x27\x94\xf9\x86\x6e\x36\x61\x69\xd2\x1f\xe6\x89\xb3\xbb\x1d\xa6\x39\x6a\x0b\xe1\x77\x94\x21\x11\x05\xdd\xab\x7e\x00\x83\x00\x76\xaf\xab\xb4\x33\x3a\x2e\xbf\xed\x96\x54\x5e\x2d\x19\xed\x78\x2f\x9c\x53\xbb\xfb\x07\x90\xaa\xc0\x84\x66\x9d\x40\xa3\xcf\xc9\xba\xdc\x87\x2c\xee\x5c\x57\x68\x3d\xd9\x44\x1f\x61\xa3\x8d\xdc\xc6\x7b\xe9\x58\x9a\xa5\x34\x38\xab\xba\x81\x32\x33\x8a\x2f\x02\x70\xc1\xe1\x05\xf8\x7b\xfd\x4e\xa7\x60\x4a\xf1\x45\x55\xa0\xa7\xad\x8f\x72\x3a\x62\x92\xb2\x01\xbc\x28\x64\x0b\xc7\x59\xbe\x64\x02\x92\x76\x6b\x0f\x23\xce\x22\xa2\x42\xb2\x58\x24\x1b\xff\xea\x3a\x68\x31\x51\xe3\xbe\x65\xa7\x65\xe1\x84\x13\x2e\xce\x49\x34\xcb\xa0\x23\x6d\x65\x56\xbf\xe6\xd1\xaf\x98\xb4\x9f\x2e\x97\x4e\xee\x1e\xb7\x9e\xbe\xea\x9f\xba\xe2\x73\xaf\x29\x6f\xa7\x26\x41\x82\xa1\x63\xba\xe9\x24\x76\xae\x06\xd7\xb0\x03\xfe\x2e\xbc\x70\x2d\xc8\xf1\xb9\xf2\x76\x6a\xd3\x24\x18\x3a\xfa\x6e\xc5\x56\x7c\x91\x8d\xdd\xeb\x81\x48\x0b\x15\x6b\x2a\x55\x96\x26\xe7\x29\xb4\xcd\xf9\x05\x46\x02\x89\x42\xa0\x2a\x84\x2c\xd6\xd3\x61\xa8\xe3\x8d\x6c\x70\xab\xad\x17\x13\x8c\x94\xef\x3d\x8b\xf7\x7e\x9b\xa1\x28\x6d\x71\xf2\x76\x9a\xf6\x9f\x24\x89\xbf\xfd\x62\xbb\x13\xda\xe1\xfd\xd2\x16\x7d\x0f\xad\x9c\x54\x68\x23\x62\xdf\x93\xb7\xd3\x52\xb3\x52\xc2\xf7\x6e\x29\xae\x46\x7c\xed\x05\xf0\xb9\x0f\x7d\x78\xfe\x25\x53\xf0\x9d\x7d\xb6\xea\xba\xfb\xec\x20\x46\x7a\x77\x45\x4b\xb0\xab\x53\x71\x64\xca\x0b\x6c\x7c\xaa\xed\xd5\x40\x6a\xbe\xb4\x10\xd9\xe0\xd3\x4c\xba\x5e\x0f\x4e\xad\x8e\x74\xa2\x3e\x15\x64\x31\x33\x15\x0d\x81\x0b\x81\x12\x99\x22\x66\x9b\xe5\x13\x40\x12\xcd\xf2\x12\x80\x25\x2a\xf8\x72\xa1\x5d\xf1\xb4\xe0\xa6\xd0\x92\x57\x12\x4f\x2f\x05\xdf\xb5\x73\xa7\x0f\x99\xd2\xe1\x78\x5d\x45\x0d\x0a\x52\x82\x30\x39\xe1\x62\xee\x69\xc7\x10\x00\xed\xe8\x65\xf2\xd9\x34\x27\x44\xa1\xaf\x95\x96\xdb\x92\x4f\x3b\xb0\x53\x59\xe1\x77\x1d\x57\x7b\x5a\x2a\xdf\x5a\xc9\xcf\x85\xbb\xc8\xcc\x2c\x8f\x67\x4c\x7c\x7a\x69\x64\xe3\xc2\xf7\xc6\x3c\xde\x78\x9d\xb0\x50\x80\x79\x38\x74\x53\x3f\x79\x3b\xd5\x81\x4a\xe6\xe4\x75\x62\x87\x2b\x78\x4f\x16\xfe\xd5\x95\xf7\x81\x8b\x39\x49\xbc\xa0\x7f\x1d\x5c\x79\x7f\x27\x82\x51\x36\xf5\x82\x81\xfe\x75\x2e\x04\x17\x5e\xb0\x7b\x6d\x3c\x6d\x91\xbb\xdc\x1f\xc7\x38\x81\x8f\x36\xa1\x1f\x17\xb6\xb4\xa9\xb3\x36\xdd\x1f\xea\xc6\xdf\xb8\x6d\x3d\x74\x22\x99\xb4\x5b\xf0\x95\xec\x54\xb6\x68\x18\xc2\x97\xbb\xf6\x1d\xbc\xa0\xad\x91\x0b\xff\xf6\xa5\xe4\x8b\xb3\xa4\xb6\x5c\xab\x38\x2c\xc1\xa4\x09\x9d\xef\x30\x1e\x4a\x2e\x4a\x29\x6a\x4e\x8f\x48\x04\xcf\xec\x70\x8a\xce\xd1\x3b\xa8\x41\x3c\x76\xd4\xec\x6f\x2c\x90\xdc\xd4\xbb\xec\x40\x8c\x3c\x76\x0c\x5b\x52\xf8\x8a\x21\xe6\x5c\x2a\x5b\x6c\x7d\xdc\x40\x6e\x85\xe5\x49\xc3\xc5\x38\x21\xcb\x44\xb5\x0c\xc2\x99\xe4\x09\x86\x09\x9f\xfa\xde\x2f\xec\x86\xf1\x15\x03\x3d\x09\x07\xe0\xc1\x0e\xd4\xa6\xe6\xd1\x23\xdf\x6d\x95\x7e\x5a\x93\xc9\xcb\x7c\xee\x5f\x18\x86\x71\x50\x6b\x35\x53\x7d\x90\x45\x35\xbf\xc5\xda\x53\xbd\x80\x41\xbf\xdf\xaf\xc3\x22\x8b\x0f\xc0\x6f\x00\xd5\x4e\xc0\x8f\xc3\x78\x29\xac\x37\x4b\x5b\xeb\x14\xb2\xca\x91\x1e\x30\xaf\x22\xe5\x99\x49\x9d\x67\xb0\x1e\x14\xb3\x52\xf6\x8f\x16\x27\x2d\xed\xa7\xe5\xd4\x18\x28\x6b\xc3\x5c\xdc\x4c\x7b\xa6\x76\xdf\xd3\x1e\x86\xa2\xec\xa9\xcd\x02\x65\x38\xe5\x8d\x18\x66\xd3\x5c\x24\x54\x7d\xc4\xb5\xd6\x22\x9a\x1a\x52\x68\x9a\x7c\x0f\xbc\x4e\x2b\xd6\x8a\x0b\xa9\x2e\x0b\x67\x94\x06\x87\x39\xb1\xc0\x1c\xa7\xb5\x4b\x09\x8e\x67\x4b\xa9\xe8\x24\xcf\x77\xc7\x3f\xf0\xf4\xa6\xdd\xcc\xc3\x9d\x1b\x72\x55\x99\x4b\x55\xdd\x68\x16\xd9\x5f\x18\x86\x58\x9f\xb0\xec\x2f\x35\x13\x1f\x1b\x26\xbf\x1d\xcb\x1a\x4c\x13\x8e\x36\x18\x7c\x84\xc1\xe4\xe3\xe7\xe7\x56\x25\x45\xb7\x23\x08\x24\x92\xb3\x83\x74\x06\xdb\xe1\x22\xbe\x64\xea\xa0\x98\xf4\xab\xdd\xeb\x66\xe0\xbb\xe6\x25\x99\xce\x59\xaa\xe1\x1a\xc8\x5d\x79\xb6\x2a\x44\x52\x64\xbb\x68\xb7\x0a\x1c\xe3\x03\x7c\xe3\x98\x4a\x15\x3d\x03\xad\x37\x87\x86\x44\xbd\x56\x2d\x2d\x17\xb6\xb3\x4a\xa9\x4d\xfd\xaa\x95\x52\xd3\x5a\x22\x57\xa9\x2b\x66\xfb\x1f\x65\x58\x89\x74\x74\x53\x3d\x8c\xd8\x0c\xbc\xa0\x21\xe4\xec\x5f\xd7\x21\x77\x1b\x21\x07\x75\x48\xa9\x04\xbf\x41\x2f\x00\x4f\x4c\xc7\xc4\xef\x07\xe6\xbf\xf0\x55\xc7\x1d\xde\xd4\x36\x7c\x6f\xc1\xa9\x0e\x7a\xba\xa9\xe7\x0f\x8a\xaa\x8a\x1b\xbd\x5b\x51\x9e\x1e\x17\xdd\x1b\x13\x39\xc2\x96\x43\xa1\x88\x24\x89\x5f\xc9\x1b\xda\x85\xcc\xb2\xd8\xfc\x48\xbb\xac\x92\x3c\x2a\x4d\x09\x3a\x11\x29\x94\x12\x8e\x3f\x57\xc6\x41\x93\x8c\xf5\x6c\xe7\xdf\x17\xb3\xa0\xe9\x48\x5a\x2f\x54\xe5\xf5\xee\xfc\x9c\xcb\xfc\x2e\x67\x0d\x36\xba\xac\xab\x24\xa6\xb7\x5e\xb3\x8a\x0d\x91\x6c\xe0\xd2\xb0\x4d\xd5\xf9\x74\x6c\xbd\x4a\x38\xf3\xbd\xfc\xd0\xd7\x0b\x1a\x0e\x9e\x20\xf5\xd1\x57\xeb\x00\x36\xd7\xe9\xce\xa1\x31\x7c\x35\xa3\xd2\xf5\xea\x3a\xa0\x74\x92\x40\xca\x6e\x51\x28\x7f\xdd\x81\x23\x37\x37\x4c\x6b\x01\xda\xfc\xe0\x5f\xff\x6a\xc1\x38\x6e\xc4\x18\x5c\x77\xaa\x31\x61\x29\x6e\xc9\x8f\x63\xc7\x44\x00\x5f\x2a\x9d\xb5\x8c\xf9\x92\xc5\x12\xd6\x8e\xe2\xd2\x70\x56\xb3\xbb\x81\xa3\x46\x2f\x60\x38\xdb\xc0\x71\xf3\xc2\xff\x5a\x26\x14\x5f\xd4\xd9\x28\x93\xd2\xde\xaa\xc1\xda\x1d\x3b\x7f\xfe\x65\x7d\x07\xfd\xce\xe7\x4a\x08\x96\x1e\xd2\x97\xf3\xf0\x5c\xa1\x65\x58\x5b\xc3\x6c\x39\x94\x6c\x8a\xba\xed\x1d\x07\x63\x64\xbf\x5a\x0b\x30\x7e\x2b\x5c\x90\x29\xfe\x5a\xdf\x77\x1c\xf0\x4f\x55\xf0\x4f\x75\xf0\x05\x97\xa6\x24\x9c\xad\x8d\x6c\xa4\x20\x27\xd2\xa9\xc6\x94\xe5\xa7\xbb\xbc\x2e\xe3\x66\xe9\x5e\x98\x25\xab\x5e\xa7\xb0\x73\xbd\x11\x96\xec\xbc\x74\xb2\xf7\x24\xcd\x14\x2b\xd6\xac\x84\x74\xda\x26\x34\x49\xbc\x20\x2b\xdc\x84\x31\x11\xe6\xac\xa9\x3a\x5d\x56\xb2\x6c\x3b\xe0\x0b\x12\x51\xb5\xf1\x02\x18\x74\x6a\xc2\x15\xcc\x27\x48\x2a\xab\xf4\xcf\xe2\xfe\x91\xc5\xa6\x07\xc5\xe9\xdf\x27\x4e\xcd\xe7\x7c\xbd\x34\x19\x03\x33\x35\x4f\xfc\x29\x2a\x27\x97\x3f\xb5\x25\x11\xbf\x66\x77\xcd\xb1\xa6\xa2\x2a\x41\x1d\xff\xb7\xc7\x65\x5a\x05\x07\x69\x99\xba\x19\x42\xe7\x8d\xe6\xfe\x84\x06\xcb\x7f\x34\xc3\xea\x3c\xf6\x20\x5b\xbf\xf5\x00\xad\xd4\xd2\x69\x51\x67\x94\xd0\xe8\xa6\x5d\x95\x72\xc6\x57\x67\x8e\x26\xf5\x2a\x8b\x83\x7c\x61\x06\x90\xba\x72\x48\x17\x13\xd8\xe4\xe4\x2d\x53\x4b\xaa\xe8\x2d\x26\x1b\xd8\x8e\xb7\x35\x99\x65\x12\xc3\xd8\xd6\x8a\xb6\x67\x48\xd4\x9c\x2c\xb6\x01\xed\x49\x0f\x74\x61\xbc\x54\xe6\xf2\xca\x6a\x46\x94\xb9\x47\x65\xc3\xdc\x8c\xa0\x46\x33\x23\x9a\x6d\x49\x66\x37\x7f\x92\x8d\x41\xd4\x43\xa4\x79\x54\x7e\xd3\x24\x25\x1d\xc2\x07\xae\x40\x2e\x05\xc2\x6a\xb6\x81\x2e\xbc\x4d\xaf\x12\xa5\x84\xe3\xbd\x94\xa2\x8d\xa7\x74\xfe\xa5\xdd\x75\xb2\x81\x84\xde\x68\x76\x89\x0a\x1b\x1c\x44\x2a\xc1\x9f\xe4\x1f\xb4\x1b\xd4\xf1\x2b\x53\xa7\x59\x0d\xb7\xe2\x14\x0e\x6b\xf0\x69\x9c\xfe\x96\xc5\xb8\x86\xa1\x46\x97\xf8\x96\xd9\x35\xaa\xf3\xad\x13\xa5\x04\x1d\x2f\x15\xfa\x1e\xd5\x30\x5e\x75\x25\xda\x0b\x5b\x54\xfe\x98\xa7\x54\x45\x26\x7b\xe5\x52\xbf\xae\xe4\x63\x65\x7f\x10\x5a\xc6\xd3\x33\xbc\x4e\x1e\x9a\xb8\x3e\xb5\xe4\x31\x1c\x41\x2b\x1c\x7d\x9d\xa3\x71\x64\xb0\xf7\x24\x3a\xae\x2b\xad\x89\x9c\xd6\x3d\x1b\x33\x48\x8d\x7e\x00\x55\x82\xf5\xc5\x78\xff\xb2\x7e\xec\x92\x7e\xc0\x7f\xa4\x89\xaa\xcb\x8d\x69\x6a\xa9\x66\xb8\x70\x58\x65\xeb\xae\xa2\x88\xc7\xce\xfb\xd3\x67\xa7\xe9\xc8\xab\x34\x45\xf9\x59\x56\xa7\x6d\xc7\x6b\x63\x27\x34\x0a\x83\x21\x34\x98\xb8\xe9\xf2\x9a\xf7\x9a\x7a\xfd\xe8\xbe\xbd\x34\x07\xca\x36\x88\x1f\xec\xd2\xcf\x36\x87\xd4\x7e\x5c\xa6\xbf\xe5\xf6\xfb\xe4\xe5\x96\x7a\x92\xa6\xa5\xf0\x4d\x5d\xc8\xa3\x4d\xe9\x09\x16\xf4\x6f\x86\x16\x7f\xf0\x5e\xd8\xb0\x6d\x54\xae\xce\xfc\x69\x9b\xc7\xfa\x27\xae\xd3\xe3\x9d\x66\xc5\xae\xab\x0b\xc3\x94\xf9\xd2\xc3\xb8\x16\x1c\xd3\xdd\x84\x37\xcb\x8e\xe1\x5a\x10\x6d\x7f\x13\xa6\x86\xb2\x9a\x78\xa4\xb1\xa5\xc5\xb4\x32\xa5\x5b\x22\xd2\x4b\x5b\x23\xce\x93\x7a\xba\xd2\xcc\x15\x8d\xbd\x6a\xad\xc8\x63\x3c\x4a\xe7\x65\x38\x84\x7e\xe3\x19\x42\x3e\x4e\x71\x01\xd3\xed\x6f\x4c\xcc\x6a\x88\x3a\xd5\xbe\xb7\xd4\xfd\xe0\xb6\x74\xff\x46\x91\x85\x85\x99\x76\x03\x68\xe1\xe7\xc0\xe5\xeb\xde\xfd\xa1\xcd\x8e\x02\x6b\x69\x5d\x78\x55\xb6\x93\x20\x35\xa7\x1d\x18\xf4\x1f\xb5\xa9\xa7\x56\x12\x64\xe6\xd4\x80\xf8\xc7\x78\x6f\xab\x92\xff\x98\xf3\xfe\xaf\x5d\x9c\x4f\x99\xee\x9d\x96\xe9\xee\x0e\xfa\x2d\xf3\xd9\x6d\x9b\xcd\x47\x39\xe7\x4a\x3d\xac\xbe\x07\xc7\xee\x29\x26\x49\x92\x9f\x4d\xee\x60\xae\xfa\x54\x8f\x39\x42\x81\xf1\x32\x42\xdf\x17\x01\x24\x01\xd0\x00\x48\xa7\x7c\x76\x51\x3d\x29\x49\x9c\x43\x8a\xc3\x32\x54\xba\xf1\xa4\x80\x45\xa5\x7d\x70\xdd\x0c\x78\xca\x63\x53\x64\x76\x8f\x41\x5c\xac\x16\xfa\x59\x12\x50\xbd\xfe\x73\xe5\xd2\xbd\xae\x95\xd3\x3f\x1f\x29\x71\x5c\x4f\x1c\x8f\x54\x7c\x0c\x47\xe3\x63\x78\xfe\xa5\x18\xbb\x7f\x7d\x07\x47\xbd\xf1\x31\x1c\xf5\x54\xfc\x58\xa4\xdd\x12\x52\xdd\xcb\x64\x58\x60\x26\x79\xe8\x99\xc0\xe3\xe0\xf9\x97\x92\x5c\x77\xde\x71\xd1\xa2\xc9\xde\xdd\xcb\x47\x4f\x89\xe3\xcf\xb0\x03\xc2\xda\x46\x00\x9e\xe7\xde\x2a\x51\xc4\xde\x8e\xff\x7c\x64\x9e\x8e\x41\xeb\xc0\xf0\x61\x6d\xc2\x72\xaa\x7f\xeb\x9c\x59\xc2\x25\xa2\xd3\x96\x1d\xbe\xa4\x2d\x7a\x2c\x78\xfe\xa5\x30\x28\x2d\xae\xa5\xfb\xb9\x74\x62\xff\xf9\x03\xd1\x2e\xf7\x68\x7c\xfc\xfc\x4b\x6c\xc3\x52\x23\xc5\xd1\x58\xf4\x0a\x21\xfe\x6a\xb2\x84\x14\x48\xa7\x0a\x0d\x30\x1f\x8a\x5c\x21\x05\xcc\x13\x86\x0c\x1a\x1c\xf0\xe7\x5f\x0c\x3b\x77\x4e\xc3\x84\x8b\x39\x51\x67\x44\x99\xaa\x40\x76\xa6\xd9\xb9\x83\x6e\x53\x27\xb2\xb8\x73\xf7\xb9\xba\xbc\x1a\x6a\x20\x71\xe5\xd4\xe5\xf3\x51\x4c\x6f\x81\xc6\x43\x4f\x51\xb6\xe9\x66\x85\xe4\xe3\xfb\x34\xf1\x19\x76\x72\x46\x3f\xdf\xa3\x8d\x12\xdc\x23\x34\x52\xc1\x30\x2d\x0d\xb2\xea\x7d\xb1\x73\x77\xd4\x8b\xe9\xad\x9e\xbf\x8a\xcc\xe5\x8d\x21\x76\x6f\x11\xc7\xa1\xdd\x27\x1b\x6e\xf2\xff\xdb\x6a\xf8\xa9\x7c\xef\x9c\xa8\xa6\x59\xb2\x9c\x43\xca\xba\xb5\xfc\x6a\xa8\xf1\x47\x31\xf4\x81\x57\xef\xc2\x3f\x9e\xa7\x92\x4e\xab\x18\x15\x03\x62\xb8\x02\xdd\xe9\xc7\x9d\x50\xf1\x5f\x3e\x9e\x5e\x2a\x41\xd9\xd4\x2f\x1f\x42\xd4\xee\xd7\x14\x74\xec\x7b\x19\x98\x94\x4e\x40\x9c\x10\xdc\xf6\xcb\x75\xa9\xb0\x9d\xaf\x07\xc7\x67\xac\x60\x08\xef\x89\x9a\x99\x63\xed\x12\xa8\x5e\x1d\xd0\x6d\x42\x0f\x8a\xf8\xc4\x8e\x43\xe5\x3b\x32\xc6\xe4\xe7\x74\xbf\xf5\xe5\x1a\x8e\x4b\x77\x21\x7b\xb0\x0b\xff\xab\xd9\xd9\x81\x15\x1c\x95\xba\x0e\x74\x73\x17\x56\x70\x0c\xfd\x8c\x31\x4c\xf2\x29\xc9\x4f\x71\x04\x46\xaa\x7e\xb6\xa5\xb7\x65\xb9\xae\x35\xe7\x3b\x70\xe3\x75\x44\xe8\xda\xfb\x71\xe5\x0b\x4e\x9d\x1a\x95\x7c\x7f\xaf\xf5\xa4\xd9\x59\x5b\x99\xb7\x00\xcf\x0f\x9b\xf2\x8c\xb4\x7c\xa0\x67\x5e\x60\x82\x21\xf4\x8b\x0b\x7a\x3f\x09\xca\x8a\xb3\xfd\xb4\xd6\x66\xca\x83\xe6\x2a\x19\x1f\xff\x03\x23\x7b\xaa\xec\x5c\xb6\xc8\x6e\x3f\x16\x61\x5a\xda\xe5\x2e\x5b\x3b\x59\x69\xc7\xe5\xaf\x65\xdb\xe0\x6e\x59\xc5\xd9\x8b\x4b\x48\x7f\x6f\xc6\xa9\x9b\x4a\x95\x5a\x29\x60\xf4\x0b\x16\x8e\xf4\xec\x99\x63\x23\xa7\x71\x27\x1f\xae\x03\xc7\xc6\x9a\x76\x60\xd5\xa9\x9d\x1f\xb1\x9d\x9d\x72\x58\x55\x3a\x50\xca\xd2\xf0\xf2\x59\x92\x7d\x45\x30\x2b\x0e\x38\x81\x6f\xe3\xa9\x92\xde\x53\x6d\x78\xe3\x79\x0d\xc9\x95\xad\xb0\xb5\x94\xce\xf2\xf8\xeb\xbe\x64\xc7\x31\xf4\x87\x0c\xbe\x62\x81\xda\xf0\x73\x8d\xb5\x42\x6d\x5a\x97\xc0\x0b\xe8\x87\x83\x57\xad\x88\x42\xd3\x7f\xdd\xde\xbd\xb9\xb7\xfb\x81\xe5\xa7\xc7\x6e\x47\xce\x56\x5d\x6e\x72\x1a\xfc\xfb\x76\x56\x1f\x55\x74\xe3\xb5\x72\x49\x2b\xbd\xc6\x33\xec\x33\x22\x6e\xae\xf6\x1a\x2e\xe9\x94\x90\xba\x19\xef\xde\x60\xb1\x6e\x9f\x3b\x5b\x9f\xb3\x57\x87\xda\x81\x9a\xcf\x31\xfb\xf0\xfc\x4b\xf7\x9e\x9b\x99\x15\x2a\xb6\x7a\x10\xe8\xb5\xd2\x00\x93\x7b\xa7\xac\xa2\x9f\x1d\x85\x67\x10\x77\xe5\x7a\x8e\xb5\xfa\xac\x54\x40\x14\x7c\x37\x04\xb6\x4c\x92\xd2\x1d\x48\xa7\xbf\xc1\x2d\xe9\x9d\x53\x2a\x32\x5f\x54\xd7\x4d\xaf\x07\x24\x8e\x61\x9c\x90\xe8\xc6\xbc\x81\x06\x73\x22\x6e\xf4\xfe\x6b\xef\x08\x98\x45\x4c\x58\x0c\x5d\x18\xf4\x06\xfd\xec\xe7\x1f\xb8\x9c\x1c\xf7\x95\x73\xf9\xc2\x5c\x60\xba\x77\x7d\xbd\x81\x17\xe0\x37\x1b\x7a\x4f\x6f\x94\x5f\xbb\x4a\x7a\xd0\x6e\xf3\x99\x9d\xed\x3e\xb4\x2a\xbc\xd5\x8c\x2a\x6c\x97\x3b\xb3\x8f\x62\x5a\xee\xb1\x92\x72\x01\xaf\x6a\x2b\x55\xca\xb1\xbd\x9e\x93\x95\x95\x0a\x9b\x2a\xdf\xb8\x77\x63\xcc\xb8\xcd\xa4\xf2\xee\xaf\xb0\x28\x81\x71\xd9\x9e\x14\x5f\x94\x8c\xe9\xd5\x7f\x87\x2d\x7d\x13\x73\x10\x18\xff\xe7\x8c\x21\x33\x85\xac\xb3\xcd\x24\x30\xc9\x35\xad\x5d\xa4\x1b\xf5\xe9\xdf\xd9\xc6\xdb\x14\x0b\x96\x22\xd1\xff\xb5\xa1\xe5\x2b\x1b\x63\xea\xd0\x73\xc7\x55\x62\xf5\x24\xae\x7a\x63\xa9\xdf\x78\x63\x29\x8b\x1e\xba\x63\x22\xba\x89\x1e\xac\x26\x7c\x56\xd7\xd1\x5c\x76\x09\x8b\x66\x5c\xd4\x59\xf3\x90\xc5\x1e\x1c\xa4\xb7\xb2\xbd\x4e\x39\x27\xc3\x5b\x92\xfc\xbf\xcb\x0b\xc1\xe7\x3f\xa8\x79\xe2\xcf\xd4\x3c\x71\xcb\x3c\x0c\x57\xe9\xa1\x87\xfb\x46\xbd\xcd\x17\xd2\x0e\x7f\x3b\xa6\xb7\xdb\xa9\x62\x0b\xf8\x90\x32\x86\xe2\x87\x8f\xef\xdf\xc1\x10\x34\x59\xe7\xb5\x92\x48\xd0\x85\x92\xf6\xca\x7d\x06\x5e\x7a\x23\xf1\x23\x99\xda\xf7\x11\x2d\x68\x16\x40\xe9\xa0\xca\xd7\x14\xa8\x89\x64\x81\xea\xb0\xce\x12\xcb\xde\x25\x87\x9d\x1d\xea\xae\x4f\x2d\x9f\x9f\xc2\x5c\xd1\xeb\x82\xab\xc6\xb7\x15\xab\x57\x5d\xd6\x01\x6c\x5c\x75\x38\x97\x6c\xd6\x87\xd5\xd6\x4f\x30\x84\x8d\xb3\x81\x35\xe4\x26\x2e\x67\x95\xda\x9c\x48\x17\xa5\x5f\x7e\xb5\x2b\x1b\xb1\x03\x3b\xe0\x2d\x4a\x65\xcb\x0a\x81\x04\x27\x1a\xdf\xf8\xb4\xe6\xe4\xb5\x19\x21\x97\xe9\xa1\x01\x32\x0e\x9d\x11\x0a\x61\x37\x25\x61\x3f\x3d\x20\xac\xdd\x69\xcb\xd2\x7e\x2a\xa4\xfd\xf4\xb0\xb4\x8a\x2f\xee\x17\xb6\xd7\x83\xb7\x0a\x12\xce\x6f\x64\xf6\x51\x92\x29\xe7\x93\x8d\xe6\x76\xc3\x97\xf6\x83\x27\x21\xec\xf6\x17\x6b\xa0\x12\xc8\x58\xc7\xef\xe6\xbb\x1a\xf4\x77\xd4\x8e\xda\x7c\x3c\x85\x2f\xa5\xf9\x58\x0a\x81\x41\x7f\xbf\x6f\x3e\x4e\x82\xf9\xb7\x4a\xee\xe7\x2d\xb7\x8a\x1d\xd8\xed\x3f\x28\x4f\xae\x91\x46\xed\x3e\xa6\xf0\x9d\x11\xcc\x1d\x08\x9d\x32\x2e\xb0\x5b\xbb\xca\x6a\x8e\x54\x1e\x30\x91\x07\x89\x14\x8e\xa8\xbc\x82\x5a\x8e\xef\xd2\xb3\x3b\x7b\x8c\xda\xb2\xa2\x6a\x77\xd6\x2a\x6b\xab\x76\x49\xed\xb1\x9a\xd1\x74\x9c\x77\x0d\xb5\xa3\x83\x61\x4b\x05\xae\xb4\xb1\x3c\xf9\x50\xe8\x8f\xba\xa2\xd0\x76\xe3\xa8\x28\x41\x36\x58\x92\x39\x7c\xa9\xc8\xe9\x80\x3d\xe9\x1a\xdf\x43\x9f\x44\x69\xb6\x9e\xe6\x7b\xa8\xe9\xa4\x3b\xb9\xf9\x43\x13\xff\x98\xc9\x87\xbc\x64\x90\x89\xfc\x33\xfe\x73\x89\x52\xfd\x44\xcc\x21\x4e\x51\x08\x29\xe0\x9f\x87\xe4\x1f\x64\xed\x97\xa7\x75\x29\x92\x83\x26\x1a\xe5\x79\x89\x89\x22\x07\x4d\x67\x8e\x09\x8d\x6e\x7e\xb3\x33\xd6\x74\xb3\x53\xef\x6b\xa6\x32\xd7\xf0\x72\x02\x33\xd5\xc2\x36\x5b\x7a\xac\xb5\xb4\xdb\xdc\x5d\xf9\xa7\x5c\x46\x11\x4a\x79\x00\xf7\x7d\x6b\xe4\x11\x06\x50\x3f\x59\x85\x06\x2b\x2c\x7f\x84\x24\xfb\xab\x84\x1b\xad\x70\x8f\x30\xd6\x96\x85\x71\xe7\x6e\xec\xcf\xf3\x2f\x1e\x74\x42\x81\x24\xde\xe4\xe1\x7c\x7e\x7f\xba\xd7\xbb\x34\x9f\x37\x5a\x03\x49\x12\xbe\xc2\x18\x6c\x91\x53\xbb\x79\xa2\xb0\x67\x3e\xcd\xa5\x38\x30\xbe\x32\xf0\xd6\xe6\x18\x5f\xa5\x6f\x0c\x9a\xea\x6a\xa9\x48\xb9\x54\xd1\x87\x72\x37\xe3\x2b\x6d\x07\xbf\x7c\x3c\xbd\x58\x26\xc9\x27\xf3\xfa\x79\x00\x45\xeb\x7b\xce\x74\xe8\xed\x36\x59\xb2\x6e\xcb\x0f\x7c\x29\x64\x05\x8f\xb2\xa5\xc2\x4a\xe3\x25\x46\x9c\xc5\xb2\xb8\x87\xd5\xeb\x65\xdf\xfe\x58\x4a\x14\x85\x78\xc8\x62\xfb\xe1\x31\xce\x60\x49\x81\x4c\x14\x0a\x6b\xcf\x20\x97\xe3\x39\x55\x30\x5e\x2a\xc5\x19\x98\x52\xd6\x44\xa0\x9c\xd9\xef\xae\x91\x29\x3a\xaa\xd3\x3f\xb3\x97\xd7\xb4\xa2\x7e\xf9\x78\x9a\x93\x9d\x50\x21\x95\xf9\x3a\x0e\x65\xd3\xdc\xa7\x6b\x36\xb4\x7c\x30\x4c\x75\x95\xdf\x68\x34\xdf\x6f\xd1\x7e\xbd\xce\xe4\xcc\x44\xea\x98\x7f\xba\x6a\x0f\xa4\x15\x34\xdf\x0a\x24\x4a\x49\x39\xbb\x54\x5c\x90\x29\x6a\x6d\xbc\x55\x38\xf7\xb7\x25\xaa\xcb\x94\xdc\x39\x8b\xf5\x62\xdc\xee\xc0\x77\x43\x9b\xfe\xe5\x76\xf3\x3f\xff\x03\xdf\x99\xd9\x0a\xcd\x25\x89\x27\x51\xeb\xc0\x51\x31\xd9\x99\x74\xc5\xca\x87\x2e\xec\x99\x8c\xac\x58\x66\x8e\x0a\x72\xc4\xf6\x11\xab\xc3\xb9\xef\x34\xe7\xc3\x49\x54\x99\x3d\xb8\x2c\xe4\x36\x02\x5d\xa8\xb2\xf6\x3b\x67\xf8\xe3\x64\x22\x51\xe5\xd7\xc1\xca\xe4\x92\x84\xa6\x5a\xf6\x4d\x5c\x92\x7e\x67\xab\xe5\x6b\x3c\x75\x4e\xb3\x4f\xa1\x14\x64\x15\x7f\x7b\xf9\x63\x76\x08\x11\xca\x84\x46\xe8\xf7\x03\xe8\x0e\x32\x6b\x7d\xee\x6f\x3f\x63\x7c\xb5\xdd\x09\x8d\x29\x3a\x0b\xb6\x5a\x5f\x16\x28\x51\x7d\x68\x5c\x88\x60\x5f\x65\x34\xdd\xf7\x49\xf2\x95\xd2\xe4\xa4\xef\x93\x26\x77\xbb\xe5\x69\xb5\xef\xa5\xb7\xcc\x6c\x2b\x96\x6c\x33\x86\xe0\x31\xcc\xb8\xd7\xa5\xf4\xff\xfe\x2f\x00\x00\xff\xff\x79\x05\xf5\x67\x1e\x54\x00\x00")
+var _webfilesSloop_uiJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x7d\xff\x73\x1b\x37\xee\xe8\xef\xfa\x2b\xd0\x4d\xa6\xde\x8d\xa5\x95\x6c\x27\xa9\x6b\x47\xb9\xb1\x6c\xe7\x9a\xf7\x69\xda\xbe\x3a\xbd\x26\xe3\xf1\xc4\x94\x96\x96\xf6\xbc\x5a\xea\x43\x52\x96\x54\x9f\xfe\xf7\x37\xe0\x97\x5d\xee\x37\x59\xce\x25\xb9\x7b\x56\x67\x22\x91\x20\x08\x80\x00\x48\x82\x20\xdb\x7d\xd6\x82\x67\x70\xca\x66\x2b\x1e\x8f\x27\x12\xfc\x51\x00\xfb\xbd\xbd\x1f\xdb\x20\x48\x42\xc5\x0d\xe3\x23\x1a\x8e\xd8\xb4\x0d\x71\x3a\x0a\x11\xf6\x24\x49\x40\xc1\x0a\xe0\x54\x50\x7e\x47\x23\x55\x7e\xf1\xdb\xd9\x87\xce\xcf\xf1\x88\xa6\x82\x76\xde\x46\x34\x95\xf1\x4d\x4c\xf9\x11\x0c\x2e\xce\x3a\x07\x9d\xd3\x84\xcc\x05\x45\xc0\x37\x8c\xc3\xcd\x3c\x49\x20\xd1\xc0\x20\xe9\x52\xb6\x41\x50\x0a\x3f\xbf\x3d\x3d\xff\xe5\xe2\x3c\x94\x4b\x09\x37\x71\x42\x21\x4e\x41\x4e\x28\x70\x3a\x63\xc0\x19\x93\xc0\x38\x4c\xa4\x9c\x89\xa3\x6e\x97\xcd\x68\x2a\xd8\x1c\x09\x64\x7c\xdc\x35\xd8\x44\xb7\xd4\x5f\xb7\xd5\x1a\xb1\x54\x48\x98\x91\x84\x4a\x49\xa1\x0f\xf7\x2d\x00\x80\x21\x11\xf4\x8c\xf0\xdb\x23\xb8\xf4\x9e\xec\x9f\x1f\x3c\x7f\xde\xf3\xda\xe0\x3d\x39\x18\x3c\xdf\x7f\xb1\xaf\xbe\x3e\x3f\x78\x7e\xfa\xe2\x5c\x7f\x3d\x7d\xf1\xf2\xe5\x89\x77\xd5\xce\xda\xfe\x8c\x42\x50\x8d\xcf\x0e\xcf\xce\xcf\x7f\x54\x60\xe7\x2f\xce\x7f\x7c\xa3\xf1\x9c\x9f\x9e\xbf\x79\xf3\x5c\x7d\x7d\x73\xf0\xe6\xe0\xcd\xb9\x6d\x3c\xe3\xf1\x94\xf0\x95\x6a\x7a\xf8\x66\x70\x3a\x18\x28\xa0\xc3\xc3\xd3\xde\x99\x6e\x7a\xb8\x77\xb2\x77\xba\xa7\xbe\xbe\x38\x3f\xdc\x3b\x39\xb5\x4d\x27\xf1\x78\x92\x64\xfd\x0e\xde\xbc\xdc\x7b\x79\xa2\xc0\xce\x7a\x87\x3f\xfc\x60\xfa\x1d\x9c\x0e\x0e\x35\xca\x93\x83\xc1\xf9\xe1\xa9\xa7\xdb\xe2\xc7\x7b\x32\x78\x7e\x78\x7e\x72\xe6\xb5\xbd\x27\x27\x67\x27\x87\x83\x97\xf8\x2d\x22\x3f\xbc\x7c\xd1\xc3\x6f\x67\xcf\x7f\x7c\x79\xf2\x03\x7e\x3b\x19\x9c\x3e\x3f\x19\x14\x9a\x9e\x3c\x3f\x79\x79\xb6\x8f\x95\x3f\xee\x0d\xce\xdf\xe8\x6f\x3f\x0c\xf6\x4e\x14\x92\xc3\x93\x1f\x07\x2f\x0f\x2d\xa1\x82\xde\x51\x1e\x4b\x64\x72\xe7\xc9\xf3\xc1\xd9\xe1\x8b\x17\x3b\x6d\xd8\x79\x72\xde\x3b\xef\xf5\x7a\xea\xeb\xd9\xe1\xf3\xc1\xf3\xc1\x8e\x69\xd0\xed\xc2\xff\xd0\x15\x8d\x60\xb8\x52\x43\x6e\x11\x00\xbb\x01\x02\x13\x4a\x12\x39\x01\x41\xc7\x53\x9a\x1a\x65\x99\xdd\x8e\xbb\x22\x61\x6c\xd6\xbd\x9d\x0f\x29\x5d\x4a\x4e\x46\x92\xf1\xae\x86\x0d\xc7\x4c\xe1\xd5\xbf\x8e\xcc\x98\xe7\x25\xab\x23\xc8\x09\xcb\xea\x66\x9c\x8d\x39\x15\x22\x4e\xc7\x58\xaf\xc7\xc1\xa9\x5f\x10\x9e\x9a\x3a\xcb\x49\x56\x47\x39\x67\xfc\x28\x67\x2c\xaf\x99\xa7\xb7\x29\x5b\xa4\x58\xa7\xd5\xc8\xb4\x5a\xb7\x5b\xeb\xe3\x56\xab\xd5\xed\xc2\xdf\x13\x36\x24\x89\x80\x9f\xe3\x3b\x0a\x3f\x51\x4e\x5b\x09\x95\x20\xd9\xec\x64\x19\x8b\x36\x0c\x99\x94\x6c\x8a\xdf\x8f\x15\xf8\xfb\x09\x15\x14\x6e\xe6\xe9\x48\xc6\x2c\x15\x30\xa6\x12\x46\x24\x49\x68\x04\x8b\x09\x4d\x51\x76\x4a\x8a\x33\xce\x66\x94\xcb\x98\x0a\x94\x23\x8d\xe5\x84\x72\x20\xcb\x58\x00\xe1\x14\x46\x13\x92\x8e\x69\xe4\x76\x75\xc6\xc9\xe2\xcd\x3c\x1d\xb9\x5d\xda\x32\xb7\x6b\x6c\x1e\x1d\x80\x90\x7c\x3e\x92\x42\x61\x58\x22\xec\xc5\x88\x24\xb4\x0d\x2b\xfc\x3e\x20\x69\xa4\xdb\x9c\x70\x4e\x56\x30\x62\xa9\x24\x31\xca\x0f\x22\x22\x09\x70\x2a\x79\x4c\xef\x68\x04\x37\x9c\x4d\x41\x8d\x25\x28\x8f\xc2\x15\x42\x04\xca\xfa\x04\x19\x4f\x29\x44\xb1\x98\x25\x04\xd5\x84\xa5\x30\xa2\x1c\xf1\xc1\x94\xcd\x05\x65\xc8\x32\x49\x23\xfd\x6b\xca\xee\x28\xd0\x3b\x9a\x1a\xda\xe4\x84\xbe\x8f\xa7\xd4\xe5\x20\xa2\x37\x71\x4a\x95\x94\xa6\x64\x19\x4f\xe7\x53\x88\x38\x59\x20\x75\x62\x46\x46\x14\x98\xf6\x3d\x8b\x38\x8d\xd8\x22\x84\xb7\x10\xb1\x74\x47\x82\x9c\xc4\xe9\x2d\xa2\x91\x93\x58\x40\x2c\x14\xd0\x88\x71\x4e\x47\x12\x16\x64\x85\x82\x9e\xa3\x02\x61\x85\xa0\x70\x47\xb8\x80\x0e\xc4\x12\x22\x46\x05\x62\xe0\x94\x24\xc9\x0a\xbd\xe7\x4c\xb5\x51\x1d\xe0\xcf\xf8\xaf\x38\x1d\x23\x6a\xe4\x63\x41\x63\x1e\xc1\x34\x4e\x91\x34\xa1\x8a\x0c\xf7\x20\x46\x24\xc1\x0e\x46\x6c\x9e\x44\x30\x63\x12\x9d\xad\xc2\x39\x42\xa7\x07\x33\xce\x86\x09\x9d\x8a\x50\xf1\x6e\x5a\xbd\x23\xcb\x0f\x6d\x8b\xe2\x1d\x59\x7e\xd4\xc2\xf8\x07\xaa\xc7\x88\x24\x8a\x69\x44\x3a\xa4\x72\x41\x69\x0a\x43\xc2\x85\xf1\x9c\x9c\x6a\x3f\x3b\x20\xdc\x82\x5f\x18\xe8\x3e\xf4\xc2\x7d\x8d\x49\xdc\x8d\x81\xd3\x1b\xca\x69\x3a\xa2\x70\xc3\x38\x70\x9a\x46\x94\x23\x52\xac\xd3\x42\x89\x0e\x14\x51\xe2\x6e\xac\x5b\x5d\xc4\x08\xbd\xa0\x3b\x9c\x66\xf2\xc7\xae\x51\xfc\xea\xdf\x45\x8c\x12\x47\x61\x82\x20\x53\x9a\xab\xd6\x22\x8e\xe4\x04\x3a\x88\xe4\x3d\x0e\xc5\x94\xf0\x71\x9c\x9a\x71\xd5\xc3\x82\x3c\xd1\x8c\x23\x2c\xb1\xac\x20\x7b\xd0\x51\x52\x8d\xe5\x8e\x70\x74\x13\xf1\x0d\xb1\xdc\x74\x6c\xfa\xce\xba\xd5\xe4\x4f\x49\x92\x0c\x08\x7f\xa7\xfa\x3c\x6e\xa9\x42\x43\x80\x9d\x5f\x24\x9b\x1d\xc1\x7e\x4f\x9b\x7a\x42\x6f\xe4\x11\xec\xf5\x7a\xca\xe2\x8d\x4e\xb1\x54\x0d\x3a\x4e\x49\x09\x23\xd1\xc5\x3f\xfe\x7e\xdc\x6a\x59\xa3\x86\x38\x8d\x71\x54\xe3\xbf\xe8\x59\x3c\xa5\xa9\x40\x43\xf7\x03\x83\xdc\x19\x54\xe8\x43\xc4\x46\x73\x74\x8c\xa1\xfd\x72\x9e\x50\xfc\x27\x1c\x25\x31\x4d\xe5\x9f\x28\xa9\xe3\x52\xbb\x8f\x0f\xb7\xfb\x89\xe2\x54\x73\xdc\x5a\xb7\x5a\x11\x95\x24\x4e\x68\xf4\x9e\xb1\xe4\x7d\x3c\x7b\x2b\xfe\x11\x8b\x78\x98\x20\xe9\x37\x24\x11\xd4\x88\x20\x65\x17\x8c\xcb\x37\x29\xf4\x33\xe7\x04\x19\xcd\x9c\xca\x39\x4f\x41\x8b\x40\x6b\xd6\x88\x4d\x67\x84\xd3\x0b\x49\x2a\xad\x48\x1b\x86\xb6\x65\x7c\x03\x3e\x09\x6f\xe3\x34\x82\xef\xfa\x30\x54\xdf\x6c\x9d\x83\xd9\x60\xfb\x9f\x38\x8d\x74\x73\xd5\xed\xda\xed\x9c\x84\x02\xfb\x82\x0e\x0c\xf5\xb7\x63\xa4\xa6\x40\xcc\x3b\x26\xe4\xb9\x72\x1d\xdf\x84\xa2\x61\x88\xae\x2b\x21\x2b\x11\x26\x34\x1d\xa3\x4a\x03\x29\x97\x55\xa9\xfc\x85\x4c\xe9\x37\xa1\xcf\xdf\xd9\x81\x5d\x20\x21\xae\xd2\x82\x30\x61\xe8\xe0\x4f\x35\x0d\xfe\x50\x97\x2a\xea\x70\xf8\x47\xd3\x99\xa2\xc9\xaa\x81\xab\xce\x46\xc3\x33\x6d\x98\x91\x15\x16\xa1\x16\x1e\x84\xff\x14\x2c\xf5\xd1\xdf\xff\xdf\x39\xe5\xab\x3f\x78\x12\x1c\xbb\x40\xa1\x9c\xd0\xd4\xcf\x39\xe5\x54\xcc\x13\xe9\xf2\x53\x6f\x2c\xc7\x59\x3d\x3a\xa0\xbe\x71\x48\xb6\x79\x5e\x3b\x8c\xd3\xe8\x1d\xce\x1b\x7a\xdc\x7d\x71\x37\x76\x6a\xc9\x6c\x46\xd3\xe8\x64\x49\xcb\x15\x1a\x1d\x9a\x84\x8c\x67\xb6\xb7\x75\x80\x06\x93\x71\xab\xbd\xdc\x6f\x86\x59\xc9\xc6\xe3\x84\x82\x58\xc4\x72\x34\x41\x17\xa7\xa7\x60\x90\xcc\x5a\xa6\x65\x39\xab\x89\x47\xb7\x22\x97\xa2\xa9\x3d\x9d\xd0\xd1\x2d\xe5\x99\x30\xe3\x1b\x3f\xb3\xe5\x31\xb5\x66\x3c\x58\xbd\x8d\x7c\xcf\x6d\xe2\x05\xe1\x08\xff\xa5\x11\xf4\xfb\x20\xf9\x9c\xba\x42\x54\xd3\x74\x88\x93\x71\x1d\x36\x31\x58\x9d\x26\x44\x08\xd4\x3c\x07\x2b\xd2\xef\x05\x41\x86\x04\x3f\xe1\x94\xcc\x7c\x0a\xfd\xd7\x40\x43\x21\x57\x09\x0d\x2d\x77\x7d\xf0\x86\x09\x43\x42\x8c\xb4\x80\x26\x82\x7e\x0d\x1a\x36\x13\x91\xb2\x94\x66\x34\xa0\x83\xeb\x76\x01\xce\x4c\x7d\x14\xdf\xa8\x69\x4c\xc2\xff\xa2\x3a\xc2\x94\xca\x09\x8b\x84\x5a\xf5\xab\x95\x07\x27\x51\xcc\xe0\x8e\x24\x73\x9a\x0f\x8d\x82\xd5\xb4\xf8\x0a\x20\x1f\x1c\xd0\x05\xa1\x6a\x01\xfd\x7e\x1f\x3c\x4e\xc7\x74\xe9\x7d\xae\xf4\x4d\xeb\xcf\x95\xfa\xe3\x05\xcd\x65\x8c\xa6\xf7\xa8\x2e\x8b\x32\x6e\x94\x84\x83\xfc\x9b\x49\xc3\x25\xed\xdb\x08\xa3\xa8\xf5\xa8\x71\x99\xe2\x14\xbd\x92\x91\x81\x5d\x00\x43\x1f\x97\x73\x23\x2a\xc4\x49\x1a\x5d\x30\x2e\x7f\x37\x2b\x18\x51\x74\x63\x16\x7e\xb0\xc2\xe9\xaf\x0d\x38\x45\x8a\x36\xee\xa2\x25\xe5\x34\x3a\xd3\x6b\xe9\x6c\x14\xbe\x43\x58\x57\xfb\xf2\xd5\xbb\xf6\xc8\xb8\xc6\xa4\x7f\xc8\x91\x1f\x84\x5c\xa9\xf4\xa5\x5e\xde\x84\xb8\x92\x71\xd7\x91\x1f\xa0\x63\x96\x5e\xaa\xea\xca\xd0\x83\xff\x65\x6b\x26\x07\x25\xae\xdc\xfc\x20\x9c\x91\x28\x8a\xd3\xb1\xdf\xbc\xb4\x0c\x8e\x5b\x19\xa2\xd2\xe6\x44\xa3\xc3\x6d\xcc\x7b\x36\xf3\x73\xca\x9d\xae\xab\xbb\x97\xbc\xd1\x40\xd5\xd5\xb7\x73\xe5\x05\x7d\xb8\xbc\xaa\xf7\x52\xb9\xa4\x35\xda\x94\x0a\xe9\x07\xe1\x2d\x5d\xf9\x11\xaa\x5c\xa4\x27\xdc\x90\xa6\xb8\xc5\x11\x6a\x6a\x73\x7a\xc1\x4a\x01\x7d\x07\x8d\xd2\x56\xdb\x94\xae\x5c\xe6\x87\x84\x9f\xb2\x84\xf1\xbf\xd3\x34\xe7\x43\xc9\xf2\x57\x1e\xc5\x29\x49\xfc\x20\x8c\xd8\x94\xc4\xa9\xaf\xf0\xda\x01\x33\xf1\x8e\x30\x8b\x19\x38\x04\xd8\xdd\x75\x03\xe2\x9f\xe3\x94\x12\x9e\xe3\xbd\xec\xb5\x61\xaf\x0d\xfb\x57\x65\xdc\x16\x8f\x4b\x6f\x2e\x57\x07\xa3\xd2\xa4\x0c\x04\xff\xcb\x70\x47\x07\xe1\x34\xd6\xb3\x7f\x1b\x8c\x08\xd4\xd2\x2c\x68\x63\xf3\x29\x59\x16\xeb\x68\x1a\x05\x57\x25\xcb\x7b\xb4\x8a\x6e\xa1\xa3\xb5\xd4\x46\x07\x46\x02\x48\x92\x59\x9c\x05\x1b\x89\x91\x6c\xd6\x06\x17\x1c\x9e\x81\x7f\xd0\x0b\x82\x9c\x28\xc9\x66\x65\x86\x1e\x67\x1f\xc5\xed\x88\xda\x94\xed\xc1\xb3\x9c\xb7\x70\x68\xf7\x4b\xb8\x4a\x69\x35\x6b\x7b\x38\x62\xe9\x88\xc8\x90\xcc\x66\xc9\xca\xbf\xbc\x6a\x37\xa8\xa8\x72\xdf\x22\x08\x8e\x6b\x51\x85\x37\x8c\x9f\x93\xd1\xc4\x42\x8f\x50\xcb\xb4\x7c\xd5\x57\xbf\xa4\xd2\xbe\x31\x17\x8b\x6f\xdd\xb2\x1b\xa8\x47\x58\xfd\x63\x2d\x3e\xf3\x9a\xe2\x6e\xac\x36\x48\xd0\x77\x54\xd7\x0c\x62\x70\xb9\x77\x05\xbb\xe0\xef\xc3\x33\x57\x83\x82\x63\xb7\xb5\xde\x26\x41\xdf\x91\x77\x63\x6b\xc9\x66\xb6\xef\x6e\x17\xb8\x09\x54\x2c\x63\x21\xed\x36\x39\xdb\x42\xeb\x3d\x3f\xa7\x23\x4e\x89\xa4\x10\xcb\x10\x8c\xfb\x56\xcb\x50\xc7\x1b\x61\x33\x25\x5d\x41\x13\x3a\x92\xbe\xf7\x24\x3a\xf8\x34\xa1\xbc\x30\xc5\x89\xbb\xb1\xa9\x3f\x49\x12\x7f\xe7\xd9\x4e\x10\xea\xee\xb3\x85\x6b\xeb\x01\x5c\x19\x2a\x54\x0f\x9a\x46\xbe\x27\xee\xc6\x85\x62\x29\xb9\xef\xdd\xc5\x74\x31\x60\x4b\xaf\x0d\xd7\x3d\xe8\xc1\xd3\x7b\x2b\xe0\xb5\xfe\xae\xc5\xb5\xbe\x76\x1a\x8e\x70\x76\xa5\x1a\x61\x07\xb7\xe2\x34\x95\x5e\x5b\xaf\x4f\x51\x5f\x15\x24\xf2\x88\x4c\xd8\xce\xc7\x96\xbb\x6e\x17\x4e\xb5\x8c\x70\x87\x3f\xe6\x64\x36\xc1\x79\x04\xa3\xc6\x18\xa1\x4e\x25\x51\xd3\x2c\x06\xc0\xc8\x68\x92\x85\x00\x34\x52\xce\xe6\x33\x74\xc5\xe3\x9c\x9a\x5c\x4a\x5e\x81\x3d\x34\x05\xdf\xd5\x73\xa7\x8e\xa6\x12\x97\xe3\x55\x11\xd5\x08\x48\x72\x92\x62\x64\x7d\xea\xa1\x63\x68\x43\x1c\xa0\x99\x5c\xab\xe2\x84\x48\xea\xa3\xd0\x32\x5d\xf2\xe3\x00\x76\x4b\x16\xbe\x0e\x5c\xe9\x51\x34\x35\xad\x25\x76\x71\x30\x20\xdc\xaa\x59\xb6\x9e\x51\xeb\xd3\x0b\xc5\x1b\xe3\xbe\x37\x64\xd1\xca\x0b\xc2\x5c\x00\xea\xcb\xb1\xbb\xf5\x13\x77\x63\x5c\xa8\x58\x27\x8f\x1b\x3b\xba\x80\x77\x64\xe6\x5f\x5e\x7a\xbf\x30\x3e\x25\x89\xd7\xee\x5d\xb5\x2f\xbd\x3f\x75\x5c\xd4\x6b\xef\xe1\xaf\x73\xce\x19\xf7\xda\xfb\x57\xb8\x18\xc8\xd7\x39\x0f\xac\x63\x8c\x42\xe3\x42\x06\x55\xe8\xd7\x19\x8e\x1a\x0e\x8d\xae\x0f\xb1\xf0\x13\xd3\xa5\x86\x37\x5c\x4f\x7e\x67\xaa\x39\x5b\x08\x77\x41\x63\x16\x4f\xf7\xeb\xe6\x19\x3c\xc7\x8d\x8d\x73\xff\x96\x43\xe1\xc7\x6e\x6a\x8b\xb1\x8a\xdc\xb0\xf0\x63\x36\x74\xbe\x43\x78\x28\x18\xcf\x98\x72\x3f\x23\x22\x28\x78\x6a\x86\xc3\x98\xa6\x77\x54\x81\xd8\xb6\x57\xfb\x37\xe4\x94\xdc\x56\xab\x74\x47\x29\xd9\xb6\x0f\x5c\xde\x7e\x56\x17\x53\x26\xa4\x0e\xb6\x6e\xd7\x91\x1b\x61\x79\x54\x77\x11\xbd\x21\xf3\x44\x36\x74\xc2\x52\xc1\x12\x1a\x26\x6c\xec\x7b\x7f\xe8\x90\x3b\xe0\x20\x1c\x81\x07\xbb\xae\x4e\xe9\xa1\xd9\xba\xe7\x75\xab\xf0\x53\xeb\x5b\x76\x8c\xe4\x7e\xc2\x30\x8c\xda\xad\x52\x21\xa8\xa1\x3e\xb2\xab\x9a\x4f\x11\x7a\xaa\x67\x18\x0b\x34\xb1\x41\xf7\x43\xd3\xe8\x08\xfc\x1a\x50\x74\x02\x7e\x14\x46\x73\xae\xbd\x99\x29\xad\x62\xb0\x91\x23\xec\x30\x8b\x22\x65\x3b\x93\x2a\xcd\xc6\x83\x52\x1b\xca\xfe\x55\xb7\x31\xa1\x7d\x13\x4e\x8d\x20\x4e\x9b\x5a\xe6\xe7\x30\xe8\x61\x62\x2a\xba\x72\x35\xa3\xc2\x1e\xc0\x94\xff\xd0\xbe\xc5\x2c\x89\xe5\x7b\xba\x94\xd0\x07\xaa\x62\x48\xa1\x2a\xf2\x3d\x70\xdc\xa5\xfb\xc1\x56\x0b\xc6\x85\xbc\xc8\x9d\x91\x59\x1c\x66\xc8\xda\xea\x24\xb1\x99\x4b\xd7\xb3\x19\x2c\xb8\xa5\xf4\xdd\xfe\x8f\x3c\x9c\xb4\xeb\x69\x58\x5b\x97\x5a\xfe\x43\xe2\x8c\xa8\x6b\xd5\xc2\x7e\xc2\x30\xa4\xed\x56\x43\xa5\x55\x13\x9f\xd6\x0c\x7e\x73\x2b\xad\x30\x75\x6d\x50\x61\xe8\x16\x0a\x63\x3f\x56\x26\x47\x45\x41\x37\x37\xe0\x94\x08\x96\x1e\x99\x11\x6c\x86\x1b\xb1\x79\x2a\x8f\xf2\x41\xbf\xdc\x37\x67\x7e\xe5\xcf\xfa\xb8\xb5\x61\xcc\x8c\x84\x2b\x20\xeb\x1a\x9e\xba\x5d\xb8\x98\x91\x54\xe0\x61\x0c\x2a\x35\x67\x0b\x3c\x18\xe3\xb4\x78\x08\xb0\x20\x02\x8f\x98\x59\x4a\xb3\x13\x46\x49\x24\x6d\x03\x4b\x22\x2a\xf0\x0c\x9a\x0b\x59\x41\x6e\x4e\x21\x85\x36\x54\xf3\x03\xfe\xf5\x2f\xb8\xbc\x0a\xf2\x18\xc0\xfd\x26\x5e\xfe\x7f\xd4\x91\xf5\xf1\xb6\xa2\x3f\xd5\x87\x29\x14\x4f\x71\xf0\xf4\x76\xc6\x22\x90\x13\x82\x27\x5f\x8a\x04\x3c\xbe\xe3\x20\x29\x9f\xc6\x29\x91\x34\xd2\xe7\xb9\x38\x32\xbf\x6b\x00\xeb\x7c\xe2\xf4\x31\xde\xc5\x60\xd7\xe3\x62\x7f\x7c\x9b\x71\xc1\x89\x5c\x19\x42\x3c\xc5\x7e\xa7\x33\x23\xca\x47\x48\xb2\xb5\x01\xc2\x50\xa7\x67\x9e\xac\x66\x1d\xa8\x89\xcc\x57\x4b\x05\x67\x3a\x33\xd0\xb8\x00\xaa\x89\x36\x55\x42\xfe\x86\xe9\x52\xb8\x5f\x6d\xc8\x2a\xe1\x7e\x55\x5a\x40\x57\x0a\x8e\x1b\x64\x09\x1e\xad\x16\x97\xeb\x58\x54\x5d\x0b\xaf\x30\xcb\xa1\xba\x6f\xea\x5d\x55\x21\xf7\x6b\x21\xf7\xaa\x90\x42\x72\x76\x4b\x31\x01\x82\x8f\x87\xc4\xef\xb5\xd5\x27\x7c\x11\xb8\xdd\xab\x90\xa0\xef\xcd\x58\x8c\x2b\xf7\x8e\x59\xbe\xb4\xf3\xd0\xa0\xbb\x05\xd5\x3b\x8f\xc7\x2f\xee\x37\x2e\xec\x1d\x66\x8b\xeb\x79\x3c\xc8\xf7\x4b\x9b\xdf\x66\x26\x6d\x28\x26\x4b\x49\x29\x8a\x24\xdb\x5a\x19\x84\xce\xb6\xaa\xb8\x6b\xfe\xba\x3c\xee\xd5\xf1\x58\xdd\xb2\xff\xfb\x6c\xe6\x38\x1d\x4e\x5d\x85\x2d\x1d\xda\x18\x85\x95\xfa\x77\x71\xeb\xab\xb7\x48\x55\x91\x44\xf1\x9d\x57\xd7\xb7\x41\x62\x3b\x2e\xd8\x49\xdd\x11\x93\xe9\x1b\xad\x84\xa5\xbe\x97\x65\x2e\x78\x6d\xe7\x54\xcf\xdd\x42\xe0\x42\xe3\x72\xd9\x86\xd5\x95\x59\xfe\x60\x0b\x5f\x4e\x62\x61\xc7\xd3\xee\x8a\x9c\x48\x46\x9c\xde\x51\x2e\xfd\x65\x00\xaf\xdc\x00\x87\x09\x68\xa1\xad\xa1\x8f\xac\x6f\xf1\xba\xb6\xc5\xde\x55\xe0\x52\x55\x59\x7c\xdb\x48\x95\x3a\x64\x67\x73\x89\xb3\xc0\x90\xcd\x31\xe6\xb9\x74\x04\x67\xf6\x64\x48\xee\x0a\x5e\xd5\x7a\x01\x45\xd9\x0a\x5e\xd7\x1b\xfe\xe7\x12\x21\xd9\xac\x4a\x46\x11\x15\x7a\xab\x1a\x6d\x77\xf4\xfc\xe9\xfd\x72\x0d\xbd\xe0\xba\xb4\x8f\x30\x99\x26\xc5\x60\x52\x26\xd0\x22\x2c\x72\xfe\x5d\xd3\xc9\x7a\x99\x39\x3b\xfe\x46\xc9\x3e\x68\x0d\x50\x7e\x2b\x9c\x91\x31\xfd\x70\xbc\x09\xfc\x63\x19\xfc\x63\x15\x7c\xc6\x84\x3a\xd7\xb0\xb6\x61\x7b\x6a\x67\x48\x4a\xbc\xae\xb3\x5f\x66\x93\x9d\x05\x17\xdd\x50\x93\x17\xda\xf5\x96\x17\xe4\x7a\x8e\xab\xb9\x82\x9e\x17\x8e\xa7\x1f\x25\x99\xdc\x62\x95\x25\x98\x61\xbb\x89\x93\xc4\x6b\xdb\xe8\x63\x18\x11\xae\x0e\x4c\xcb\xc3\xa5\x39\xb3\xd3\x01\xc3\xd8\xaa\x5c\x79\x6d\xd8\x0b\x2a\xcc\xe5\xc4\x27\x94\xdc\xd1\x6f\x42\xfd\x96\x11\xd3\x07\xd9\xe9\x6d\x62\x67\xca\xbe\x18\x37\x96\x80\x89\x9c\x26\xfe\x98\x66\x51\x9e\x01\x46\x7c\x55\x5c\xcf\x2f\xc0\xe3\x7f\x45\x0c\xf6\x4f\xc6\x32\xa1\xb8\x89\x6d\xde\x5c\xa0\x08\x8e\xcc\x59\x4b\x3d\x04\x06\x3f\x54\x12\x10\x82\x65\x3f\xea\x61\xf5\x1a\xce\xd8\x6f\x05\x22\xd7\x75\xfc\x04\x0d\xe2\x1c\x25\xf1\xe8\xb6\x59\x94\x62\xc2\x16\x67\x8e\x24\xd1\xca\xa2\x76\x66\x98\x6d\x30\xae\xdc\x1a\x93\x59\x4f\xbf\x4d\xe5\x3c\x96\xf1\x1d\x4d\x56\xb0\x13\xed\x80\x98\xa8\xc4\xaf\xa1\x5e\x33\xef\x4c\x28\x91\x53\x32\xdb\x01\xaa\x8f\x2b\xa1\x03\xc3\xb9\x54\x19\x58\x8b\x09\xc1\xc8\x0c\x37\x7b\x35\x8b\x10\x9b\x29\xcf\xa1\xa6\x25\xcc\x7e\x52\xe9\x6b\xc9\x4a\x35\xc4\x2e\x62\x51\xdc\x29\x19\xd4\x21\xfc\xc2\x24\x88\x39\xa7\xb0\x98\xac\xa0\x03\x6f\x4d\x3e\x9c\x41\x1c\x1d\x18\x8c\x0a\xbb\xc0\x75\x3c\xba\xeb\x64\x05\x49\x7c\x8b\xe4\x12\x19\xd6\x38\x08\xc3\xc1\x57\xf2\x0f\xe8\x06\x71\xfd\x9a\xca\x53\x7b\x10\x51\x72\x0a\xc7\xad\x86\xed\xfc\xdb\x34\xa2\x4b\x3c\x82\x25\x5c\xd0\xb7\xa9\xf6\x30\x18\x34\x38\x91\x92\xc7\xc3\xb9\xa4\xbe\x17\x23\x8c\x57\xb6\x44\x44\x82\xc0\x36\x9c\xd2\x77\xc2\x31\x97\x2e\xf6\xab\xe3\xd6\x06\x7f\x10\x6a\xc2\xcd\xb1\x77\x60\xab\x0a\x3e\xb5\xe0\x31\x1c\x46\x83\xe3\x0d\x88\xb7\x75\x34\x0e\x0f\x6a\x9b\x1f\x04\xae\x2b\x2d\x74\x80\x2c\x9b\xe0\x7d\x6d\x18\x04\x9b\x1f\xb9\x42\x69\x30\xed\xcd\x66\xbd\xad\x49\x3f\xe0\x3f\xcc\x4e\xda\xa5\x46\x15\x55\x21\xd5\xee\xd9\x85\xa3\x65\xb2\xd6\x25\x41\x6c\x3b\xee\x8f\x1f\x1d\x1b\xa3\x69\x1c\x22\x0b\x50\x1c\x26\xdb\x09\x7e\x9a\xc8\x09\x95\xc0\xa0\x0f\x35\x2a\xae\xaa\xbc\xfa\xb9\xa6\x50\xe6\x6e\xad\x6a\xe7\x52\xfb\xc9\x26\x88\x9f\xb4\xe9\xdb\xc9\xc1\xe8\x8f\x4b\xf4\xb7\x9c\x7e\x1f\x6d\x6e\x66\x79\x51\x67\x0a\xdf\xd4\x85\x34\xb3\x54\x22\xf8\x11\x1a\x54\x3b\xdc\xdb\x2f\x2d\xbe\xf0\x5c\x58\x3f\x6d\x24\x72\x72\xa1\x23\x70\x5f\x71\xf2\x30\x31\x3e\x35\x00\x36\xde\x77\xb9\xcd\x78\x7e\x96\x8d\xeb\x48\xe4\xa9\xb1\x77\xd5\xdb\x16\x66\xfd\x65\xac\xd1\xc8\xd2\x5a\x63\x51\x14\x5b\x7a\x56\x13\x2d\x33\xa4\x57\xaa\xd7\xdf\xce\xb6\xbf\xd9\xd8\x6d\x1e\xb2\xda\x81\xda\xca\x8e\x6a\xb5\xde\x44\x36\xbf\xa2\xbe\x9b\x1e\x94\xbe\x9b\xef\x5f\x50\x66\x3a\x3c\xd7\x51\xe9\x28\x18\xa4\xdb\x9f\x2d\xbf\xce\xd4\x62\x82\xc9\x56\x99\x0d\x27\xdf\x4e\xfd\xb6\x63\x7f\xaf\x91\xfd\xcf\x57\x91\x52\x62\xec\x57\x53\x94\xe5\x6f\x0c\x0f\xe8\x77\xeb\x39\xad\xf0\x85\xf6\xb8\x30\xa9\x36\x0d\x6d\x54\x75\x5d\xbb\x89\x4d\xb2\x69\x68\xa8\xeb\xeb\x5a\x22\x49\x3a\x2d\x77\xcb\x59\xd8\xc4\xee\x8b\x98\xee\x08\x37\x29\xd9\x03\xc6\x92\x62\x1d\xca\xad\x9e\xaa\x38\xf2\xca\x39\xf3\x5e\xca\x46\x66\x5c\xfa\x7d\xe8\x95\xc5\x8a\x9f\xbc\x9f\xfc\x7a\x85\xad\x6b\x8c\x58\x55\x1a\x62\xf6\x4c\xa9\xdd\x23\xd7\xeb\x9b\xfd\xbc\xdd\x2f\x5b\xe9\xb6\xa1\x02\xa2\xe9\x39\x72\xe9\xda\xb8\x70\xae\x17\x22\xa6\x12\x29\x4d\xeb\xc0\x0b\x5b\x66\xcd\x47\xfd\x0b\xbb\xb0\xd7\x0b\x8e\xb7\x40\x65\xb4\xa4\x6d\xd5\xa9\xa6\xe1\x97\xf1\x3d\x5a\x24\xff\xb1\x55\xed\x7f\xad\x71\x16\x9a\x3e\x30\xdc\xbb\x0d\xc3\xdd\xd9\xeb\x95\x10\xdb\xf1\xec\xec\xf5\xfe\x3d\x57\xea\x46\xec\xab\x9b\x93\x6c\x3c\x50\xbc\x24\x49\x7e\x57\x41\x15\xf4\x80\x51\x39\x89\x21\xe4\x34\x9a\x8f\xa8\xef\xf3\x36\x24\x6d\x88\xdb\x40\x82\xe2\xe9\x63\x39\x0f\x22\x71\x52\x10\x72\x1e\x14\x94\x59\x49\x18\xc0\xfc\x1c\x7d\xef\xaa\x1e\xf0\x94\x45\xea\xf4\xcd\xfc\x44\x37\xe7\xbb\xad\x82\xa6\x66\x3a\x3a\x62\x8f\x5a\x6c\xf9\x65\x0e\x10\xd1\xab\xca\x39\xe3\xf5\x2b\xc9\x5f\x67\x85\xd9\xe7\x95\x8c\x5e\xc3\xab\xe1\x6b\x4c\xfa\xcb\xfa\xee\x5d\xad\xe1\x55\x77\xf8\x1a\x5e\x75\x65\xb4\x6d\xa3\xfd\x42\x23\x68\x6c\x05\x6a\x90\xfb\x9e\xda\x91\x1d\x3d\xbd\xcf\xc9\x4e\x18\x5f\x7b\xaf\xf3\x12\xa4\x65\xbd\x91\x8e\xae\xe4\xaf\xaf\x61\x17\xb8\xb9\xa8\x0b\x5e\xa6\xbd\x38\x24\x92\xe8\xbb\x6f\xd7\xaf\xd4\xb7\xd7\x80\x32\x50\x74\x68\x9d\xd0\x94\xe2\x6f\xbc\x76\x2a\xe0\x82\x52\xa7\xcc\xa6\x56\x98\x12\xec\x0b\x9e\xde\xe7\x0a\x85\xec\x6a\xbc\xd7\x85\x7c\xbc\x6b\xcc\xd0\x3a\x42\xa1\x3e\xbd\x8f\xf4\x7e\x5d\x71\xf1\x6a\xc8\xbb\x39\x13\x98\x95\x9e\x01\x61\x0c\xa5\x06\xe6\x97\x3c\x88\x62\x00\xb3\x48\x8a\x85\x06\x07\xfc\xe9\xbd\x22\x27\x8f\x81\x3e\xbd\xc7\x03\x11\x22\xcf\x88\x54\xe1\x52\x9b\xb1\x14\xac\xa1\x53\x57\x89\x39\xdb\xeb\xeb\xc2\x81\x58\x7d\x70\x38\x33\xb1\x4c\xb9\xa2\xf8\x0e\xe2\xa8\xef\xc9\x38\x5d\x75\x8c\x39\x7b\xaf\x37\x49\xe2\x1a\x76\x33\x42\xaf\x37\x48\xa3\x00\xb7\x85\x44\x4a\x2d\x54\x49\x0d\xaf\x38\x2f\x06\xeb\x57\xdd\x28\xbe\x7b\x7d\x7d\x5c\xe6\xb9\x38\x31\x64\xec\xa2\x77\x8f\x42\x3d\x4f\xda\xb2\x2f\x29\x86\xdf\x8a\xb7\xca\x88\xac\x1b\x25\x4d\x39\x18\xd2\xeb\xf3\x26\xbf\x14\x41\xbf\x30\x98\x7d\x2e\x4d\x65\x99\x96\x76\xad\x99\x50\xd1\x52\xa7\x54\x08\xa2\x96\x7e\x9e\x77\xec\x88\xda\x94\xbb\xb2\xce\x41\xaf\x9f\xde\x67\x10\x6b\x4d\xbb\x09\x44\x7c\x51\xdd\xfc\xc9\xbc\x4d\x50\x75\x5d\xee\x86\x32\xca\xb7\x92\xca\x89\x29\x53\x93\x5a\xae\xe0\x3f\xbd\xcf\xeb\xd7\x41\xa5\x0f\xcb\xd3\x16\x7a\xfb\xb0\x01\x9b\x41\xa8\xe8\x74\x69\xa3\x55\x90\x3f\x9e\x40\xb8\x1b\x49\x1a\xc1\xdf\xe0\xda\xb4\xa0\x91\x66\xc0\xd4\x7d\x52\x99\x62\xeb\x2c\x91\x27\xb8\x86\x23\xf0\xde\x67\x79\x42\x66\x00\x71\x58\xe9\x32\xc6\x49\xf3\xfa\x1c\xff\x1d\xb1\x28\x17\x38\xd6\x7c\xc2\x12\x25\x20\x33\x6e\x7a\xcc\x45\x3c\x4e\x49\xe2\x0e\x39\x02\xc3\x6e\x1f\xae\xdb\xa0\x2b\x2d\x16\xfd\xcb\x45\xb1\xce\xfa\xfe\x1a\x2a\x85\x78\x6f\xe2\x34\x16\x13\x1a\x55\x10\xdb\x8a\x4f\xa4\x90\x50\xec\xc0\x5f\xbf\xb1\xdf\xeb\xad\xc8\xc1\x60\x76\x37\xc1\xe3\xf5\xfa\x37\x96\x7b\xd2\x19\x6b\x72\xa4\x59\xf2\x57\x06\x6b\xee\xd6\x53\xae\x5a\x60\x6b\xbc\xad\xfb\x29\x2b\x86\xbf\x81\x07\x3e\x96\x05\x1e\x0e\xb8\xb7\xae\x62\x7d\x7a\x8f\x8a\xb4\xae\xb3\x15\xa3\x2d\xc6\x58\xac\x8d\xe8\x03\x2f\xcc\x1f\xb0\xb9\xc0\x5e\x03\xc1\x4f\xef\x51\x0b\xaa\x7d\xda\xb1\xdb\xad\x0a\x3c\x2f\xd2\xd6\x84\xd3\xfb\x46\xff\xe5\x5a\x4e\xb7\x0b\x27\xf6\xbd\x01\xf5\x4c\x07\xd1\xea\x4c\x23\x18\x25\x94\xa4\xc9\x0a\x53\x71\x19\xfe\xbb\x60\x5c\x4e\x80\x40\x4a\xe7\x92\x93\x04\xaf\xa0\xdc\x52\x9e\xdb\x5e\x89\x75\xa3\x1b\x5a\x1b\x33\x3b\x50\xf7\x4d\x7b\xf0\xfd\xf7\xe0\x67\x62\xc1\x22\x0f\x77\xa4\x09\x45\xbb\x42\x31\x15\xeb\xbc\x42\x72\x85\x51\x8c\x6a\x0e\x4e\xef\xaa\x46\x7f\x2c\x98\x79\x88\x85\xe2\x6d\x80\xa2\xcf\x28\xc6\xcb\x8c\x73\x83\xfb\x66\x24\xd9\x12\xf4\x0a\x49\x2d\x75\x60\x9e\x57\x29\x76\x51\x1e\x88\x12\xfa\x94\x2e\x00\x2b\xfd\x28\x08\x25\xfb\xe3\xfd\xe9\x85\xc4\x87\x2a\xfc\x62\xd2\x4e\xe5\x52\x45\x8e\x07\x73\x4d\x24\xd0\xa4\x90\x31\xe4\x84\xac\x75\xbd\x58\x16\x12\x41\x32\x2f\xeb\x2c\x25\x17\xd0\x87\x77\x44\x4e\x54\x2e\x73\x01\x14\x17\x4d\xd0\xa9\x6b\xde\xce\xb7\xad\xba\x9f\x58\xfc\x4c\x86\x34\xf9\xdd\x6c\xc3\x7c\xb1\x84\xd7\x85\x0b\x70\x5d\xd8\x87\xbf\x81\x58\xc2\x2e\x2c\xe0\x55\xa1\xea\x08\x8b\x3b\xb0\x80\xd7\xd0\xb3\x6b\x5c\x9a\x54\xb3\x9e\xf0\x84\xd8\x49\x9b\x31\x3b\x30\xdc\xad\x89\x65\xa5\x38\xdb\x98\xd5\xde\x41\x83\x8e\xbe\x14\x55\xbc\xd5\x12\x54\xb0\x64\xdb\xbe\x4a\x8d\x09\xbc\x36\xa5\x45\xd4\x24\x67\x65\x27\x38\x79\x5a\x98\x39\xb4\xfe\x8d\xc7\x29\x86\x89\xb2\x24\x60\x93\x34\xcc\x86\xff\xc4\xf7\x59\x88\x00\x82\x07\xd9\xf1\x0c\x48\xc2\xf4\xbb\x2e\x26\x6f\xce\xa6\x17\x0f\x09\x6f\xc3\x1c\xf3\xca\x2c\x1a\xdc\x36\xba\x7a\xa0\x57\x28\x35\x37\xc5\x5c\xa9\x74\xe1\xd0\xbc\xd2\x91\x85\xaa\xb3\xab\x74\x99\x4e\xda\x00\x33\xbe\xc8\x15\xd1\xa5\xd5\xc7\x4a\x5f\x17\x1f\x1a\x14\xcb\xd4\x67\xaa\x24\xdc\xb4\xa4\x02\x8a\x3f\x33\x0c\x71\x5a\x87\x01\x15\xb4\x6d\x94\x0a\xaf\x33\x9a\xf2\x0b\x27\x15\x09\x7d\x91\x29\xfe\x13\x5e\xd5\xc4\xbb\xb4\x3d\x1e\xb7\x6a\x2e\x5b\x38\x4a\xb8\x49\x11\x1d\xad\x50\xca\x68\x89\xa8\x85\x58\x3d\x52\x25\x73\xae\xf4\xd8\xd5\x22\xcd\x94\xfd\x61\x50\xab\xd1\x06\xf2\xcf\x5a\xa0\xad\x4e\x15\xea\x1a\x2a\x8d\xf0\xac\x66\x14\x21\x32\x43\x28\x9e\x9a\xd9\x5c\xc5\x42\x34\x19\x57\x23\x78\xf9\xa8\x57\x36\x12\x73\xea\x68\x35\x5c\xe5\x98\xe0\xf5\x33\x63\x2b\x46\x79\x0d\x54\x9d\xf2\x9a\xaa\xaa\xd6\x9a\x0a\xa5\xb5\x8e\xaa\x99\x62\xa3\xac\xc7\xf5\x8d\xfe\xac\x6f\x53\xf5\x9f\x65\x6c\x19\x3a\x54\x53\x5b\x7b\xf1\x01\x5e\x81\x58\x06\x38\xd3\xb8\x85\xbb\x59\x77\x98\x17\xe9\x1b\xb5\xaf\x24\x21\xa6\xbb\xbb\x39\x99\x95\xac\x44\x7b\x96\x5b\x4c\x48\x44\x31\xe6\x27\xcc\xc7\xad\x8d\x81\x5e\x1c\x1c\x8c\x36\x39\xcb\x44\xfb\x41\x36\x74\x28\x2a\x68\xc8\xbf\xc8\x62\x55\x9b\x6e\x38\x95\x0c\xef\x21\xe3\x73\x14\x10\x0d\x30\x93\x58\x23\x54\xb3\x11\x3e\x83\x5e\xb8\xf7\xa2\xb1\x21\x47\xfc\x2f\x9b\xab\x57\x1b\xab\x1f\x98\x93\xb0\xef\xe6\xc6\xd6\x70\xad\x0e\x28\xf0\x1f\x9a\x49\x2d\x9f\xea\x5a\xc3\x2d\x4c\x57\x06\x99\x63\xd5\x8d\xf8\x6a\x13\xa1\xcf\x08\xbf\xbd\x3c\xb8\x7a\xa0\x51\xe9\xac\xa9\x09\x58\xed\xd6\x31\x77\x19\xb3\x7c\x1a\x81\xea\x93\x61\x31\xe9\xbb\x53\x74\x9d\x85\x7c\xef\x12\x16\xeb\xa7\xd2\xdd\xdd\x1a\x18\xd7\x53\xa1\x97\x29\xf8\xa8\x9a\xb3\xaf\xcc\x3b\x11\xb3\x40\xc6\xa5\x38\xce\xc3\x92\xcd\x9c\x19\x3a\x77\x56\xf9\xe2\xdb\x2c\xa0\x9d\x99\xda\x94\x5c\xc4\x7f\xd1\xc6\x79\xfa\x19\xec\xdb\x79\xda\x80\xd7\xb9\x3a\x53\xd5\x38\x4f\x9b\xfa\x92\xc3\x33\xa5\x7a\xdf\x90\x5b\x37\x5a\x76\xd6\x00\xdd\x13\x7a\xa7\xac\xe0\x75\x36\x09\xff\xdb\x93\xeb\x8c\xc8\x49\x49\x47\xcc\xa0\x45\x5a\x9d\xc5\x6a\x3a\x64\xf8\xe6\x04\xde\xf2\xf3\xb3\x82\xb3\x98\x4c\x19\x5e\x19\xc1\x07\xcd\x2c\xa9\x28\xc5\xfa\x99\xaa\x31\xa9\xda\xf2\xb4\xde\x42\xa3\x8a\xa6\x66\x5a\x2a\x03\xb3\x04\xd4\x77\xde\x6c\x4b\xbd\xab\x0d\x0d\x36\xd9\x51\x49\xb1\x37\x4e\xc0\x86\xb6\xfa\xa9\x17\x07\x3a\x0a\xed\x19\x21\x91\xf0\x5d\x1f\xd2\x79\x52\x88\x5a\xb8\xf5\x35\x8a\x97\x5d\x42\x2a\xab\x03\xbe\x2c\x18\x45\x30\x4c\xc8\xe8\x16\x64\x3c\xba\x55\xf6\x82\xd6\x92\x2f\x67\x51\xcf\xa1\x03\x7b\xdd\xbd\x9e\xfd\xf9\x05\xe7\x06\x47\xd1\x33\x2a\x9f\xa9\x70\x44\x63\x33\xf4\xe9\x3f\xe2\x9b\x19\xf5\xb6\xd8\x85\xbd\x0d\x8d\x1f\x70\xf9\x5d\x68\x76\xe0\x76\xb0\xf7\x1f\x72\xf1\xde\x62\x12\x4b\xea\x35\x82\x59\x9d\xc8\x87\x65\x83\xcb\x2b\x9e\xdc\x97\x1d\x5f\x19\x33\x9a\xa4\x73\x9e\x9c\xc1\xd9\xdc\xe1\x75\xab\x12\x5c\x8e\x9a\x54\x2a\xab\xfe\x0c\x8d\xe2\x34\x2a\xea\x93\xf1\xbc\x99\x32\xbd\xf8\xef\xd0\xa5\x6f\xa2\x0e\x9c\x46\xff\x39\x65\xb0\xaa\x60\x2b\x9b\x54\x82\x26\x99\xa4\x71\x59\xe8\x10\xac\x56\x87\x76\x15\xd9\xaa\x91\x7b\x21\xd6\xa0\x62\x0a\x1d\x78\x01\x47\x66\x0a\x82\x5d\x78\x51\x69\x66\x44\x53\x17\x3f\x0a\x5a\x55\xbe\xed\x52\xb8\x33\x24\xbc\x93\x60\x60\xa3\xc2\xbc\x3d\xd0\x45\x62\x3b\x24\x1d\x4d\x18\xaf\x92\xe6\xd1\x34\x52\x11\x45\x93\x30\x55\x8c\x10\xd1\x3b\x92\xfc\x9f\x8b\x37\x9c\x4d\x7f\xc2\x5c\x54\x3c\xb9\xb7\xea\x8d\x4b\xec\x94\x2e\x4c\x1a\xa8\xfb\x50\xa6\x8e\x08\x99\x0a\x7f\x27\x8a\xef\x76\x8c\x60\x73\xf8\x30\x4e\x53\xca\x7f\x7a\xff\xee\x67\xe8\x03\xa2\x75\x5e\x8b\x19\xf1\x78\x26\xf1\xc4\xd8\x01\x2f\x3c\x34\xf6\x9e\x8c\xf1\xfc\xc0\xf7\x34\xa8\xdd\x0d\xe0\xda\xc5\x47\x0c\xb1\xda\x96\x41\x8c\x7b\x14\x05\x91\x3d\x11\x09\xbb\xbb\xb1\x6b\x9f\xc8\x9f\x6f\x60\x2e\xe3\xab\x9c\xaa\xe0\xb8\xe6\x18\xa5\x7c\xf9\x07\x6f\x99\xb9\xe2\x30\x51\x60\xb5\x5a\x39\x2e\x97\xe2\xed\xa2\x95\x33\x81\xd5\x44\x9f\x5c\xca\x4a\x87\xf2\xdc\x18\xa5\xef\x36\xe9\x58\xa8\x0f\xf8\xf2\x81\x57\x4c\x92\x2a\x21\xc0\x27\x79\xbc\xb6\x9e\x26\x5b\xb5\xfb\xa6\xfa\x06\xa6\xf4\xc3\x83\x1d\x58\x0a\x9d\x1e\x72\x66\x57\x05\x66\x3f\x3e\xc0\xac\x9e\x69\x8b\xdc\x7e\xcc\xb9\xfd\xf8\x30\xb7\x78\x7b\x6d\x23\xb3\x78\x63\x44\x42\xc2\xd8\x2d\x3e\xd1\xae\xde\x05\x1e\x33\x76\xb3\xc2\xa1\x59\xb1\xb9\x7e\xc7\x38\x84\xfd\xde\x6c\x89\x91\x66\x32\xc4\xcd\x28\x2e\x92\x71\xe9\x66\x17\xcc\x2a\x25\x05\xdf\x3a\x24\xb0\xd7\x3b\xec\xa9\x37\x87\x69\xf6\x04\xf1\x66\xda\x4c\xe1\x47\xd8\x85\xfd\xde\x83\xfc\x64\x12\xa9\x95\xee\x36\x19\x2f\x16\x61\xe6\x40\xe2\x71\xca\x38\xed\x54\x2e\xf7\xaa\xa7\x6a\x1b\xa4\xb6\x35\x92\xdc\x11\x15\x2d\xa8\x21\xa1\xd9\x64\x33\xeb\xc4\xf2\x06\x8b\xaa\xdc\xe2\x2b\xd9\x56\xe5\xda\xde\xb6\x92\x31\xd9\x9c\x36\x7c\x8d\x8e\x0e\xfa\x0d\x47\xef\xad\xcd\x77\xb2\x1e\xc8\xfa\xfd\x52\x97\x36\x9a\xee\x60\xe5\xb9\x07\x35\x9a\x84\x4e\xd6\x7d\x2a\x0d\xf9\x74\xc0\x1e\x75\xb1\xf1\xa1\x97\x8e\xeb\xb5\xa7\xfe\x66\xae\x19\xf4\xe3\x56\x01\x6e\xd3\xc0\x97\x60\x6a\x07\xbf\xb0\x85\x54\x2c\xff\x4e\xff\x77\x4e\x85\xfc\x8d\xa8\xec\xad\x6c\xfe\x74\xa2\x43\x4f\x43\xf2\x4f\xb2\x2c\x25\x74\xcf\x79\x72\x54\x87\xa3\x38\x2e\xf8\x8e\x80\xfb\x7e\xbe\xfd\x53\xf9\xfd\x9f\xf4\x88\xd5\xdd\x75\xc5\xb4\x23\x75\xf6\x52\xf3\x48\x04\xea\x43\xb3\x2e\x6d\xab\x2d\xcd\x3a\xb7\x2e\xfe\x14\xf3\x11\xbe\xa0\x79\xe4\xe4\xd6\x15\x1f\x9d\x72\xff\x36\x28\x40\x35\xa5\xb2\x4e\x0b\xf3\x47\x39\xdd\xbf\xd2\x72\xa3\x11\x6e\x0b\x65\x6d\x30\x8c\x7c\xd5\xbf\x6e\xb5\x9e\x66\x0f\x99\xe2\x3b\x6f\x24\x5a\x65\xcb\xf9\xec\xce\x7b\xb7\x7b\x81\x09\x12\x64\x09\x24\x49\xd8\x82\x46\xa0\x8f\xb1\xd0\xcd\x13\x49\xbb\x38\xb4\xf8\x32\x71\xca\x16\x4e\x80\x24\x65\x0b\xf3\x10\x98\x3a\x3f\x33\x7d\x6a\x7d\x9c\xcb\xd1\x2f\xc5\xea\x94\x2d\x50\x0f\xfe\x78\x7f\xfa\x66\x9e\x24\x1f\xd5\xab\x92\x6d\xc8\x4b\xdf\xb1\x54\x4e\x8a\x45\x1a\xad\x5b\xf2\x13\x9b\x73\x51\x2c\x7a\x17\xa7\x73\x49\x4b\x85\x17\x74\xc4\xd2\x48\xe4\x37\xd3\xba\x5d\xfb\xa4\xef\x5c\x50\x9e\xb3\x47\x53\xdc\xaf\x4c\xd5\x1b\xff\xf3\x18\xc8\x8d\xa4\x5c\xeb\x33\x88\xf9\x70\x1a\x4b\xbc\x07\x29\xf1\xcd\x38\x0c\x11\xdd\x70\x2a\x26\xea\x60\x07\x8d\xd0\x11\x1d\xfe\xb4\x6f\x52\xa1\xa0\xfe\x78\x7f\x9a\xa1\x55\x4f\xc7\x00\x2e\xdf\xf1\x4d\x79\x6b\xd6\x48\x06\xf2\x07\x7d\x23\xab\xec\x8e\xa7\x7a\x96\x19\x67\xbc\x2a\x91\x13\xb5\x52\xa7\xd9\x8b\xf4\x07\x20\x34\xa3\xd9\x54\x20\xf0\x7f\x59\xc1\xd2\x0b\xc9\x38\x19\x53\x94\xc6\x5b\x49\xa7\xfe\x8e\xc0\xdc\x18\x8d\xee\x3c\x8d\xd0\x18\x77\x02\xf8\xae\xaf\xb7\x7f\x99\xde\x7c\xff\x3d\x7c\xa7\x46\x0b\x6f\x3f\x09\xfa\x28\x6c\x01\xbc\xca\x07\xdb\x72\x97\x5b\x3e\x74\xe0\x40\xed\xee\x73\x33\x73\x44\x90\x35\x6c\xee\xb1\xdc\x9d\xd5\x70\x17\x53\x28\xa8\xb4\xfa\x90\x95\x8d\xf3\x32\x3c\xdb\x71\xcb\x91\xb4\xbf\x58\x4a\x7f\xbd\xb9\x11\x54\x66\x37\x69\x32\x10\x85\x2e\x49\x62\x23\x65\x1f\x85\x65\x60\x6a\x1e\x07\x56\x8f\x6c\x57\x29\xb5\x2f\x1c\xe7\x3d\x4b\xf6\xf6\xe2\x57\x7b\xcc\x1c\x0a\xfc\xff\xd5\xf8\xbd\x36\x74\xf6\xac\xb6\x3e\xf5\x77\x9e\xa4\x6c\xb1\x13\xe0\xb3\xfd\xa3\x5b\xc7\x60\xef\xab\x7e\x9f\xca\x92\xa5\x19\x0a\xcd\x43\x38\xaa\x7a\x13\x27\x9f\xc9\x4d\x86\x7a\x13\x37\x16\x7f\x69\x58\xf5\x73\x93\x0d\x23\xdb\xd8\x4a\x34\x29\x43\x7b\x1b\x62\x0c\x35\xeb\xe0\xb8\xd5\x5a\x07\xc7\xad\xff\x37\x00\x6f\xd4\x6a\x9d\xf0\x68\x00\x00")
 
 func webfilesSloop_uiJsBytes() ([]byte, error) {
 	return bindataRead(
@@ -356,7 +356,7 @@ func webfilesSloop_uiJs() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "webfiles/sloop_ui.js", size: 21534, mode: os.FileMode(420), modTime: time.Unix(1659992879, 0)}
+	info := bindataFileInfo{name: "webfiles/sloop_ui.js", size: 26864, mode: os.FileMode(420), modTime: time.Unix(1792320086, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -79,6 +79,24 @@ func viewKeyHandler(tables typed.Tables) http.HandlerFunc {
 					return err
 				}
 				valueFromTable = *wa
+			} else if (&typed.HealthKey{}).ValidateKey(key) == nil {
+				rh, err := tables.HealthTable().Get(txn, key)
+				if err != nil {
+					return err
+				}
+				valueFromTable = *rh
+			} else if (&typed.RestartKey{}).ValidateKey(key) == nil {
+				cr, err := tables.RestartTable().Get(txn, key)
+				if err != nil {
+					return err
+				}
+				valueFromTable = *cr
+			} else if (&typed.RolloutKey{}).ValidateKey(key) == nil {
+				wr, err := tables.RolloutTable().Get(txn, key)
+				if err != nil {
+					return err
+				}
+				valueFromTable = *wr
 			} else {
 				return fmt.Errorf("Invalid key: %v", key)
 			}
@@ -137,7 +155,7 @@ func listKeysHandler(tables typed.Tables) http.HandlerFunc {
 		var tablesToSearch []string
 
 		if table == "all" {
-			tablesToSearch = append(tablesToSearch, "watch", "eventcount", "ressum", "watchactivity")
+			tablesToSearch = append(tablesToSearch, "watch", "eventcount", "ressum", "watchactivity", "health", "restart", "rollout")
 		} else {
 			tablesToSearch = append(tablesToSearch, table)
 		}
@@ -187,6 +205,15 @@ func listKeysHandler(tables typed.Tables) http.HandlerFunc {
 					case "watchactivity":
 						key := &typed.WatchActivityKey{}
 						keys = append(keys, tables.WatchActivityTable().GetAllKeysForGivenPartitions(tables.Db(), key, maxRows, lookBack, keySearch)...)
+					case "health":
+						key := &typed.HealthKey{}
+						keys = append(keys, tables.HealthTable().GetAllKeysForGivenPartitions(tables.Db(), key, maxRows, lookBack, keySearch)...)
+					case "restart":
+						key := &typed.RestartKey{}
+						keys = append(keys, tables.RestartTable().GetAllKeysForGivenPartitions(tables.Db(), key, maxRows, lookBack, keySearch)...)
+					case "rollout":
+						key := &typed.RolloutKey{}
+						keys = append(keys, tables.RolloutTable().GetAllKeysForGivenPartitions(tables.Db(), key, maxRows, lookBack, keySearch)...)
 					}
 				}
 				count = len(keys)
@@ -17,9 +17,10 @@ type indexData struct {
 	DefaultKind      string
 	LeftBarLinks     []ComputedLink
 	CurrentContext   string
+	Contexts         []string
 }
 
-func indexHandler(config WebConfig) http.HandlerFunc {
+func indexHandler(config WebConfig, contexts []string) http.HandlerFunc {
 	return func(writer http.ResponseWriter, request *http.Request) {
 		indexTemplate, err := getTemplate(indexTemplateFile, _webfilesIndexHtml)
 		if err != nil {
@@ -31,6 +32,7 @@ func indexHandler(config WebConfig) http.HandlerFunc {
 		data.DefaultNamespace = config.DefaultNamespace
 		data.DefaultKind = config.DefaultResources
 		data.CurrentContext = config.CurrentContext
+		data.Contexts = contexts
 		data.LeftBarLinks, err = makeLeftBarLinks(config.LeftBarLinks)
 		if err != nil {
 			logWebError(err, "Could not make left bar links", request, writer)
@@ -13,6 +13,14 @@ const palette = {
         "#B48EAD","#ADA8B6","#da7650","#D496A7","#ABC4AB",
         "#A4A6D2","#91BEF2","#97B1A6","#8A9B68"],
     severity: ['#4BD855', '#E0E000', '#D84B4B'],
+    // Keyed by the severity of a health segment, see pkg/sloop/kubeextractor/health.go
+    health: {
+        healthy: '#4BD855',
+        progressing: '#81A1C1',
+        warning: '#E0E000',
+        error: '#D84B4B',
+        unknown: '#4C566A',
+    },
 };
 
 
@@ -237,6 +245,21 @@ function processAndSortResources(result) {
                         count: splitText[2],
                     };
                     return overlay
+                }),
+                // Spans of the row where the resource was in one health state, oldest first
+                segments: (d.segments || []).map(e => {
+                    return {
+                        ...e,
+                        start: (e.start_date * 1000),
+                        end: (e.start_date * 1000) + (e.duration * 1000),
+                    };
+                }),
+                // Containers of a pod that restarted or terminated, see the Restart struct in pkg/sloop/queries/types.go
+                restarts: (d.restarts || []).map(e => {
+                    return {
+                        ...e,
+                        time: e.timestamp * 1000,
+                    };
                 })
             };
             return result
@@ -363,6 +386,40 @@ function bindMouseEvents(svg) {
         showDetailedTooltip(d, d3.event, this);
     });
 
+    g.selectAll(".healthSegment").on("mouseover", function (d) {
+        if (!detailedToolTipIsVisible) {
+            let segment = d.segments[parseInt(this.getAttribute("index"))];
+            d3.select(this).attr("fill", d3.color(healthColor(segment.severity)).darker());
+            tooltip
+                .style("opacity", 1)
+                .html(getSegmentContent({
+                    title: d.text,
+                    ...segment
+                }));
+        }
+    }).on("mouseleave", function (d) {
+        if (!detailedToolTipIsVisible) {
+            let segment = d.segments[parseInt(this.getAttribute("index"))];
+            d3.select(this).attr("fill", healthColor(segment.severity));
+            tooltip.style("opacity", 0)
+        }
+    });
+
+    g.selectAll(".restart").on("mouseover", function (d) {
+        if (!detailedToolTipIsVisible) {
+            let restart = d.restarts[parseInt(this.getAttribute("index"))];
+            d3.select(this).attr("stroke-width", "2px");
+            tooltip
+                .style("opacity", 1)
+                .html(getRestartContent(restart));
+        }
+    }).on("mouseleave", function (d) {
+        if (!detailedToolTipIsVisible) {
+            d3.select(this).attr("stroke-width", "1px");
+            tooltip.style("opacity", 0)
+        }
+    });
+
     g.selectAll(".payloadChange").on("mouseover", function (d) {
         if (!detailedToolTipIsVisible) {
             let xPos = +d3.select(this).attr("x");
@@ -439,6 +496,52 @@ function getChangeContent(d) {
     }
 }
 
+function getSegmentContent(d) {
+    let message = "";
+    if (d.message) {
+        message = `${d.message}<br/>`;
+    }
+    return `<div id="tiny-tooltip">Name: <b>${d.title}</b><br/>` +
+        `Health: <b style="color:${healthColor(d.severity)}">${d.state}</b> (${d.severity})<br/>` +
+        message +
+        `<br/>${formatDateTime(d.start)} - ${formatDateTime(d.end)}</div>`;
+}
+
+function getRestartContent(d) {
+    let what = d.restarted ? `Restarted (${d.restart_count} restarts)` : "Terminated";
+    let exit = `Exit code: <b>${d.exit_code}</b>`;
+    if (d.signal) {
+        exit += `, signal <b>${d.signal}</b>`;
+    }
+    let message = "";
+    if (d.message) {
+        message = `${d.message}<br/>`;
+    }
+    let finished = "";
+    if (d.finished_at) {
+        finished = `Finished at ${formatDateTime(d.finished_at * 1000)}<br/>`;
+    }
+    return `<div id="tiny-tooltip">Pod: <b>${d.pod}</b><br/>` +
+        `Container: <b>${d.container}</b>${d.init_container ? " (init)" : ""}<br/>` +
+        `${what}: <b style="color:${restartColor(d)}">${d.reason || "Unknown"}</b><br/>` +
+        `${exit}<br/>` +
+        message +
+        finished +
+        `<br/>Seen at ${formatDateTime(d.time)}</div>`;
+}
+
+// A container that exited cleanly is only worth a neutral marker
+function restartColor(d) {
+    if (d.exit_code === 0 && (d.reason === "Completed" || d.reason === "")) {
+        return palette.baseLight[0];
+    }
+    return palette.health.error;
+}
+
+function healthColor(severity) {
+    return palette.health[severity] || palette.health.unknown;
+}
+
 function formatDateTime(d) {
     return new Date(d).toUTCString()
 }
@@ -458,6 +561,26 @@ function createResourceBar(d) {
         .attr("fill", barColorGenFunc(d.kind))
         .classed("resource", true);
 
+    // Print the health of the object as a strip along the bottom of the bar, under the heatmap
+    const segmentHeight = yAxisBand.bandwidth() / 8;
+    d.segments.forEach(function (segment, index) {
+        const segmentSX = Math.max(xAxisScale(segment.start), sx);
+        const segmentW = Math.min(xAxisScale(segment.end), sx + w) - segmentSX;
+        if (segmentW <= 0) {
+            return;
+        }
+
+        el
+            .append("rect")
+            .attr("x", segmentSX)
+            .attr("y", yAxisBand.bandwidth() - (2 * smallBarMargin) - segmentHeight)
+            .attr("height", segmentHeight)
+            .attr("width", segmentW)
+            .attr("fill", healthColor(segment.severity))
+            .attr("index", index)
+            .classed("healthSegment", true)
+    });
+
     let n = 0;
 
     // Print overlay heatmap for each object
@@ -492,6 +615,25 @@ function createResourceBar(d) {
         }
     });
 
+    // Print a marker at the top of the bar for each container restart
+    const restartSize = yAxisBand.bandwidth() * 2;
+    d.restarts.forEach(function (restart, index) {
+        const restartX = xAxisScale(restart.time);
+        if (restartX < sx || restartX > sx + w) {
+            return;
+        }
+
+        el
+            .append("path")
+            .attr("d", d3.symbol().type(d3.symbolDiamond).size(restartSize))
+            .attr("transform", `translate(${restartX} ${-smallBarMargin})`)
+            .attr("fill", restartColor(restart))
+            .attr("stroke", palette.baseDark[0])
+            .attr("stroke-width", "1px")
+            .attr("index", index)
+            .classed("restart", true)
+    });
+
     if (d.nochangeat != null) {
         d.nochangeat.forEach(function (timestamp) {
             // add black tick mark at bottom of band - 1/10 of band
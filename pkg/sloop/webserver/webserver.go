@@ -62,7 +62,16 @@ type WebConfig struct {
 	ConfigYaml       string
 	ResourceLinks    []ResourceLinkTemplate
 	LeftBarLinks     []LinkTemplate
-	CurrentContext   string
+	CurrentContext   string        // Set by Run to the context of each cluster it serves
+	HealthStatus     func() string // Optional.  Shown on /healthz below OK, which is still returned either way
+}
+
+// ClusterTables is the store for one of the clusters we serve.  Every url for it starts with /<Context>
+type ClusterTables struct {
+	Context string
+	Tables  typed.Tables
+	Ingest  http.Handler // Optional.  Takes pushed watch results at POST /<Context>/ingest
+	Audit   http.Handler // Optional.  Takes apiserver audit webhook batches at POST /<Context>/audit
 }
 
 var (
@@ -101,7 +110,7 @@ func webFileHandler(currentContext string) http.HandlerFunc {
 			logWebError(nil, "Not allowed", r, w)
 			return
 		}
-		data, err := readWebfile(fixedUrl, &afero.Afero{afero.NewOsFs()})
+		data, err := readWebfile(fixedUrl, &afero.Afero{Fs: afero.NewOsFs()})
 		if err != nil {
 			logWebError(err, "Error reading web file: "+fixedUrl, r, w)
 			return
@@ -166,10 +175,13 @@ func queryHandler(tables typed.Tables, maxLookBack time.Duration) http.HandlerFu
 	}
 }
 
-func healthHandler() http.HandlerFunc {
+func healthHandler(status func() string) http.HandlerFunc {
 	return func(writer http.ResponseWriter, request *http.Request) {
 		writer.WriteHeader(http.StatusOK)
 		writer.Write([]byte(http.StatusText(http.StatusOK)))
+		if status != nil {
+			writer.Write([]byte("\n" + status()))
+		}
 	}
 }
 
@@ -181,12 +193,19 @@ func redirectHandler(currentContext string) http.HandlerFunc {
 	}
 }
 
-// Registers paths for mux router
-func registerPaths(router *mux.Router, config WebConfig, tables typed.Tables) {
+// Registers paths for mux router.  contexts lists every cluster we serve, for the context switcher
+func registerPaths(router *mux.Router, config WebConfig, cluster ClusterTables, contexts []string) {
+	tables := cluster.Tables
 	router.PathPrefix("/webfiles/").HandlerFunc(webFileHandler(config.CurrentContext))
 	router.HandleFunc("/data/backup", backupHandler(tables.Db(), config.CurrentContext))
 	router.HandleFunc("/data", queryHandler(tables, config.MaxLookback))
 	router.HandleFunc("/resource", resourceHandler(config.ResourceLinks, config.CurrentContext))
+	if cluster.Ingest != nil {
+		router.Handle("/ingest", cluster.Ingest)
+	}
+	if cluster.Audit != nil {
+		router.Handle("/audit", cluster.Audit)
+	}
 	// Debug pages
 	router.HandleFunc("/debug/listkeys/", listKeysHandler(tables))
 	router.HandleFunc("/debug/histogram/", histogramHandler(tables))
@@ -200,23 +219,74 @@ func registerPaths(router *mux.Router, config WebConfig, tables typed.Tables) {
 	router.HandleFunc("/debug/vars", expvar.Handler().ServeHTTP)
 	router.HandleFunc("/debug/", debugHandler())
 
-	router.HandleFunc("/healthz", healthHandler())
+	router.HandleFunc("/healthz", healthHandler(config.HealthStatus))
 	router.Handle("/metrics", promhttp.HandlerFor(
 		prometheus.DefaultGatherer,
 		promhttp.HandlerOpts{
 			EnableOpenMetrics: true,
 		},
 	))
-	router.Handle("", indexHandler(config))
+	router.Handle("", indexHandler(config, contexts))
+}
+
+// The first cluster is the default.  / redirects to it, and so does any context we do not serve, which keeps links
+// from before a cluster was renamed or added working
+func newRouter(config WebConfig, clusters []ClusterTables) *mux.Router {
+	var contexts []string
+	for _, cluster := range clusters {
+		contexts = append(contexts, cluster.Context)
+	}
+
+	router := mux.NewRouter()
+	router.HandleFunc("/", redirectHandler(clusters[0].Context))
+	for _, cluster := range clusters {
+		// An empty context (in cluster without a display context) is only reachable through the fallback below
+		if cluster.Context == "" {
+			continue
+		}
+		clusterConfig := config
+		clusterConfig.CurrentContext = cluster.Context
+		subMux := router.PathPrefix(path.Join("/", cluster.Context)).Subrouter()
+		registerPaths(subMux, clusterConfig, cluster, contexts)
+	}
+	defaultConfig := config
+	defaultConfig.CurrentContext = clusters[0].Context
+	// Pushes to a context we do not serve are refused rather than stored in the default cluster
+	defaultCluster := clusters[0]
+	if defaultCluster.Context != "" {
+		defaultCluster.Ingest = nil
+		defaultCluster.Audit = nil
+	}
+	subMux := router.PathPrefix("/{clusterContext}").Subrouter()
+	registerPaths(subMux, defaultConfig, defaultCluster, contexts)
+	return router
 }
 
-func Run(config WebConfig, tables typed.Tables) error {
+func Run(config WebConfig, clusters []ClusterTables) error {
+	if len(clusters) == 0 {
+		return fmt.Errorf("no clusters to serve")
+	}
 	webFilesPath = config.WebFilesPath
 	server := &Server{}
-	server.mux = mux.NewRouter()
-	server.mux.HandleFunc("/", redirectHandler(config.CurrentContext))
-	subMux := server.mux.PathPrefix("/{clusterContext}").Subrouter()
-	registerPaths(subMux, config, tables)
+	server.mux = newRouter(config, clusters)
+	return serve(config, server)
+}
+
+// RunAgent serves only health and metrics, for agents that forward what they watch instead of storing it
+func RunAgent(config WebConfig) error {
+	router := mux.NewRouter()
+	router.HandleFunc("/healthz", healthHandler(config.HealthStatus))
+	router.Handle("/metrics", promhttp.HandlerFor(
+		prometheus.DefaultGatherer,
+		promhttp.HandlerOpts{
+			EnableOpenMetrics: true,
+		},
+	))
+	return serve(config, &Server{mux: router})
+}
+
+// Runs until we get SIGINT or SIGTERM
+func serve(config WebConfig, server *Server) error {
 	addr := fmt.Sprintf("%v:%v", config.BindAddress, config.Port)
 
 	h := &http.Server{
@@ -1,6 +1,7 @@
 package webserver
 
 import (
+	"github.com/salesforce/sloop/pkg/sloop/store/typed"
 	"github.com/stretchr/testify/assert"
 	"net/http"
 	"net/http/httptest"
@@ -32,3 +33,64 @@ func TestWebFileHandler(t *testing.T) {
 	assert.Equal(t, http.StatusOK, rr.Code)
 	assert.NotNil(t, rr.Body.String())
 }
+
+func TestHealthHandler_ShowsStatus(t *testing.T) {
+	req, err := http.NewRequest("GET", "/healthz", nil)
+	assert.Nil(t, err)
+
+	rr := httptest.NewRecorder()
+	healthHandler(nil).ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "OK", rr.Body.String())
+
+	rr = httptest.NewRecorder()
+	healthHandler(func() string { return "leader election: following" }).ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "OK\nleader election: following", rr.Body.String())
+}
+
+func TestNewRouter_ServesEachContext(t *testing.T) {
+	clusters := []ClusterTables{
+		{Context: "prod", Tables: typed.NewTableList(nil)},
+		{Context: "prod-eu", Tables: typed.NewTableList(nil)},
+	}
+	router := newRouter(WebConfig{DefaultLookback: "1h"}, clusters)
+
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, httptest.NewRequest("GET", "/prod-eu", nil))
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), "<title>Sloop - prod-eu</title>")
+	assert.Contains(t, rr.Body.String(), `<option value="prod-eu" selected>prod-eu</option>`)
+	assert.Contains(t, rr.Body.String(), `<option value="prod">prod</option>`)
+
+	rr = httptest.NewRecorder()
+	router.ServeHTTP(rr, httptest.NewRequest("GET", "/", nil))
+	assert.Equal(t, "/prod", rr.Result().Header["Location"][0])
+
+	// Contexts we do not serve still get the default cluster, like before there could be more than one
+	rr = httptest.NewRecorder()
+	router.ServeHTTP(rr, httptest.NewRequest("GET", "/old-name", nil))
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), "<title>Sloop - prod</title>")
+}
+
+func TestNewRouter_IngestOnlyForServedContexts(t *testing.T) {
+	ingested := ""
+	ingest := func(context string) http.Handler {
+		return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) { ingested = context })
+	}
+	clusters := []ClusterTables{
+		{Context: "prod", Tables: typed.NewTableList(nil), Ingest: ingest("prod")},
+		{Context: "prod-eu", Tables: typed.NewTableList(nil), Ingest: ingest("prod-eu")},
+	}
+	router := newRouter(WebConfig{DefaultLookback: "1h"}, clusters)
+
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("POST", "/prod-eu/ingest", nil))
+	assert.Equal(t, "prod-eu", ingested)
+
+	ingested = ""
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, httptest.NewRequest("POST", "/old-name/ingest", nil))
+	assert.Equal(t, "", ingested)
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+}